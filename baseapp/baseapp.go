@@ -4,8 +4,10 @@ import (
 	"fmt"
 	"io"
 	"runtime/debug"
+	"strconv"
 	"strings"
 
+	"github.com/golang/protobuf/proto"
 	"github.com/pkg/errors"
 
 	abci "github.com/tendermint/tendermint/abci/types"
@@ -27,6 +29,9 @@ import (
 // and to avoid affecting the Merkle root.
 var dbHeaderKey = []byte("header")
 
+// Key to store the consensus params in the main store.
+var mainConsensusParamsKey = []byte("consensus_params")
+
 // Enum mode for app.runTx
 type runTxMode uint8
 
@@ -42,13 +47,15 @@ const (
 // BaseApp reflects the ABCI application implementation.
 type BaseApp struct {
 	// initialized on creation
-	Logger     log.Logger
-	name       string               // application name from abci.Info
-	cdc        *wire.Codec          // Amino codec
-	db         dbm.DB               // common DB backend
-	cms        sdk.CommitMultiStore // Main (uncached) state
-	router     Router               // handle any kind of message
-	codespacer *sdk.Codespacer      // handle module codespacing
+	Logger      log.Logger
+	name        string               // application name from abci.Info
+	cdc         *wire.Codec          // Amino codec
+	db          dbm.DB               // common DB backend
+	cms         sdk.CommitMultiStore // Main (uncached) state
+	router      Router               // handle any kind of message
+	queryRouter QueryRouter          // route custom queries to the modules
+	codespacer  *sdk.Codespacer      // handle module codespacing
+	baseKey     sdk.StoreKey         // main KVStore in cms
 
 	// must be set
 	txDecoder   sdk.TxDecoder   // unmarshal []byte into sdk.Tx
@@ -61,6 +68,14 @@ type BaseApp struct {
 	addrPeerFilter   sdk.PeerFilter   // filter peers by address and port
 	pubkeyPeerFilter sdk.PeerFilter   // filter peers by public key
 
+	// consensus params from genesis, persisted in the main store
+	consensusParams *abci.ConsensusParams
+
+	// stream the committed writes of each block
+	listenKeys     map[sdk.StoreKey]struct{}
+	blockListeners []blockListenerEntry
+	writeCollector *writeCollector
+
 	//--------------------
 	// Volatile
 	// checkState is set on initialization and reset on Commit.
@@ -69,6 +84,7 @@ type BaseApp struct {
 	checkState       *state                  // for CheckTx
 	deliverState     *state                  // for DeliverTx
 	signedValidators []abci.SigningValidator // absent validators from begin block
+	lastBlockGasUsed sdk.Gas                 // gas used by the last committed block
 }
 
 var _ abci.Application = (*BaseApp)(nil)
@@ -83,14 +99,18 @@ var _ abci.Application = (*BaseApp)(nil)
 // Accepts variable number of option functions, which act on the BaseApp to set configuration choices
 func NewBaseApp(name string, cdc *wire.Codec, logger log.Logger, db dbm.DB, options ...func(*BaseApp)) *BaseApp {
 	app := &BaseApp{
-		Logger:     logger,
-		name:       name,
-		cdc:        cdc,
-		db:         db,
-		cms:        store.NewCommitMultiStore(db),
-		router:     NewRouter(),
-		codespacer: sdk.NewCodespacer(),
-		txDecoder:  defaultTxDecoder(cdc),
+		Logger:      logger,
+		name:        name,
+		cdc:         cdc,
+		db:          db,
+		cms:         store.NewCommitMultiStore(db),
+		router:      NewRouter(),
+		queryRouter: NewQueryRouter(),
+		codespacer:  sdk.NewCodespacer(),
+		txDecoder:   defaultTxDecoder(cdc),
+
+		listenKeys:     make(map[sdk.StoreKey]struct{}),
+		writeCollector: newWriteCollector(),
 	}
 
 	// Register the undefined & root codespaces, which should not be used by
@@ -125,6 +145,13 @@ func (app *BaseApp) MountStoresIAVL(keys ...*sdk.KVStoreKey) {
 	}
 }
 
+// Mount stores to the provided keys in the BaseApp multistore
+func (app *BaseApp) MountStoresTransient(keys ...*sdk.TransientStoreKey) {
+	for _, key := range keys {
+		app.MountStore(key, sdk.StoreTypeTransient)
+	}
+}
+
 // Mount a store to the provided key in the BaseApp multistore, using a specified DB
 func (app *BaseApp) MountStoreWithDB(key sdk.StoreKey, typ sdk.StoreType, db dbm.DB) {
 	app.cms.MountStoreWithDB(key, typ, db)
@@ -185,6 +212,9 @@ func (app *BaseApp) SetPubKeyPeerFilter(pf sdk.PeerFilter) {
 }
 func (app *BaseApp) Router() Router { return app.router }
 
+// QueryRouter returns the QueryRouter of a BaseApp.
+func (app *BaseApp) QueryRouter() QueryRouter { return app.queryRouter }
+
 // load latest application version
 func (app *BaseApp) LoadLatestVersion(mainKey sdk.StoreKey) error {
 	err := app.cms.LoadLatestVersion()
@@ -217,15 +247,51 @@ func (app *BaseApp) LastBlockHeight() int64 {
 func (app *BaseApp) initFromStore(mainKey sdk.StoreKey) error {
 
 	// main store should exist.
-	// TODO: we don't actually need the main store here
 	main := app.cms.GetKVStore(mainKey)
 	if main == nil {
 		return errors.New("baseapp expects MultiStore with 'main' KVStore")
 	}
+	app.baseKey = mainKey
+
+	// Load the consensus params from the main store. If they are not there yet
+	// they will be saved during InitChain.
+	consensusParamsBz := main.Get(mainConsensusParamsKey)
+	if consensusParamsBz != nil {
+		consensusParams := &abci.ConsensusParams{}
+		err := proto.Unmarshal(consensusParamsBz, consensusParams)
+		if err != nil {
+			return errors.Wrap(err, "failed to decode consensus params")
+		}
+		app.consensusParams = consensusParams
+	}
 
 	return nil
 }
 
+// storeConsensusParams persists the consensus params in the main store so they
+// survive a restart.
+func (app *BaseApp) storeConsensusParams(consensusParams *abci.ConsensusParams) {
+	consensusParamsBz, err := proto.Marshal(consensusParams)
+	if err != nil {
+		panic(err)
+	}
+	main := app.cms.GetKVStore(app.baseKey)
+	main.Set(mainConsensusParamsKey, consensusParamsBz)
+}
+
+// getMaximumBlockGas returns the maximum gas a block may consume, as set by
+// the consensus params. Zero means there is no limit.
+func (app *BaseApp) getMaximumBlockGas() sdk.Gas {
+	if app.consensusParams == nil || app.consensusParams.BlockSize == nil {
+		return 0
+	}
+	maxGas := app.consensusParams.BlockSize.MaxGas
+	if maxGas <= 0 {
+		return 0
+	}
+	return maxGas
+}
+
 // NewContext returns a new Context with the correct store, the given header, and nil txBytes.
 func (app *BaseApp) NewContext(isCheckTx bool, header abci.Header) sdk.Context {
 	if isCheckTx {
@@ -253,6 +319,9 @@ func (app *BaseApp) setCheckState(header abci.Header) {
 
 func (app *BaseApp) setDeliverState(header abci.Header) {
 	ms := app.cms.CacheMultiStore()
+	for key := range app.listenKeys {
+		ms.SetListeners(key, []sdk.WriteListener{app.writeCollector})
+	}
 	app.deliverState = &state{
 		ms:  ms,
 		ctx: sdk.NewContext(ms, header, false, app.Logger),
@@ -287,6 +356,11 @@ func (app *BaseApp) InitChain(req abci.RequestInitChain) (res abci.ResponseInitC
 	app.setDeliverState(abci.Header{ChainID: req.ChainId})
 	app.setCheckState(abci.Header{ChainID: req.ChainId})
 
+	if req.ConsensusParams != nil && app.baseKey != nil {
+		app.consensusParams = req.ConsensusParams
+		app.storeConsensusParams(req.ConsensusParams)
+	}
+
 	if app.initChainer == nil {
 		return
 	}
@@ -338,6 +412,8 @@ func (app *BaseApp) Query(req abci.RequestQuery) (res abci.ResponseQuery) {
 		return handleQueryStore(app, path, req)
 	case "p2p":
 		return handleQueryP2P(app, path, req)
+	case "custom":
+		return handleQueryCustom(app, path, req)
 	}
 
 	msg := "unknown query path"
@@ -361,6 +437,11 @@ func handleQueryApp(app *BaseApp, path []string, req abci.RequestQuery) (res abc
 				Code:  uint32(sdk.ABCICodeOK),
 				Value: []byte(version.GetVersion()),
 			}
+		case "blockgas":
+			return abci.ResponseQuery{
+				Code:  uint32(sdk.ABCICodeOK),
+				Value: []byte(strconv.FormatInt(app.lastBlockGasUsed, 10)),
+			}
 		default:
 			result = sdk.ErrUnknownRequest(fmt.Sprintf("Unknown query: %s", path)).Result()
 		}
@@ -370,7 +451,7 @@ func handleQueryApp(app *BaseApp, path []string, req abci.RequestQuery) (res abc
 			Value: value,
 		}
 	}
-	msg := "Expected second parameter to be either simulate or version, neither was present"
+	msg := "Expected second parameter to be one of simulate, version or blockgas, none was present"
 	return sdk.ErrUnknownRequest(msg).QueryResult()
 }
 
@@ -407,6 +488,64 @@ func handleQueryP2P(app *BaseApp, path []string, req abci.RequestQuery) (res abc
 	return sdk.ErrUnknownRequest(msg).QueryResult()
 }
 
+func handleQueryCustom(app *BaseApp, path []string, req abci.RequestQuery) (res abci.ResponseQuery) {
+	// "/custom" prefix for the queries of the modules, "/custom/<route>/<path>"
+	if len(path) < 2 || path[1] == "" {
+		msg := "No route for custom query specified"
+		return sdk.ErrUnknownRequest(msg).QueryResult()
+	}
+	querier := app.queryRouter.Route(path[1])
+	if querier == nil {
+		msg := fmt.Sprintf("No custom querier found for route %s", path[1])
+		return sdk.ErrUnknownRequest(msg).QueryResult()
+	}
+
+	var header abci.Header
+	if app.checkState != nil {
+		header = app.checkState.ctx.BlockHeader()
+	}
+
+	// the querier runs on a cache of the state committed at the requested
+	// height, the latest by default, which is discarded
+	height := app.LastBlockHeight()
+	cacheMS := app.cms.CacheMultiStore()
+	if req.Height != 0 && req.Height != height {
+		var err sdk.Error
+		cacheMS, err = app.cms.CacheMultiStoreWithVersion(req.Height)
+		if err != nil {
+			return err.QueryResult()
+		}
+		// only the height of a past block header is known to the app
+		height = req.Height
+		header = abci.Header{ChainID: header.ChainID, Height: height}
+	}
+
+	ctx := sdk.NewContext(cacheMS, header, true, app.Logger)
+
+	// a panicking querier fails the query instead of the node
+	defer func() {
+		if r := recover(); r != nil {
+			app.Logger.Error("custom query panicked", "path", req.Path, "err", r, "stack", string(debug.Stack()))
+			res = sdk.ErrInternal(fmt.Sprintf("custom query panicked: %v", r)).QueryResult()
+			res.Height = height
+		}
+	}()
+
+	resBytes, err := querier(ctx, path[2:], req)
+	if err != nil {
+		return abci.ResponseQuery{
+			Code:   uint32(err.ABCICode()),
+			Log:    err.ABCILog(),
+			Height: height,
+		}
+	}
+	return abci.ResponseQuery{
+		Code:   uint32(sdk.ABCICodeOK),
+		Value:  resBytes,
+		Height: height,
+	}
+}
+
 // BeginBlock implements the ABCI application interface.
 func (app *BaseApp) BeginBlock(req abci.RequestBeginBlock) (res abci.ResponseBeginBlock) {
 	if app.cms.TracingEnabled() {
@@ -427,8 +566,21 @@ func (app *BaseApp) BeginBlock(req abci.RequestBeginBlock) (res abci.ResponseBeg
 		app.deliverState.ctx = app.deliverState.ctx.WithBlockHeader(req.Header)
 	}
 
+	// Reset the block gas meter. Without a max gas consensus param blocks may
+	// consume an unbounded amount of gas.
+	var blockGasMeter sdk.GasMeter
+	if maxGas := app.getMaximumBlockGas(); maxGas > 0 {
+		blockGasMeter = sdk.NewGasMeter(maxGas)
+	} else {
+		blockGasMeter = sdk.NewInfiniteGasMeter()
+	}
+	app.deliverState.ctx = app.deliverState.ctx.WithBlockGasMeter(blockGasMeter)
+
+	app.writeCollector.beginBlock(req.Header.Height)
 	if app.beginBlocker != nil {
-		res = app.beginBlocker(app.deliverState.ctx, req)
+		ctx := app.deliverState.ctx.WithEventManager(sdk.NewEventManager())
+		res = app.beginBlocker(ctx, req)
+		res.Tags = append(res.Tags, ctx.EventManager().Events().ToTags()...)
 	}
 
 	// set the signed validators for addition to context in deliverTx
@@ -457,12 +609,14 @@ func (app *BaseApp) CheckTx(txBytes []byte) (res abci.ResponseCheckTx) {
 			[]byte(result.FeeDenom),
 			result.FeeAmount,
 		},
-		Tags: result.Tags,
+		Tags: append(result.Tags, result.Events.ToTags()...),
 	}
 }
 
 // Implements ABCI
 func (app *BaseApp) DeliverTx(txBytes []byte) (res abci.ResponseDeliverTx) {
+	app.writeCollector.deliverTx(txBytes)
+
 	// Decode the Tx.
 	var result sdk.Result
 	var tx, err = app.txDecoder(txBytes)
@@ -482,7 +636,7 @@ func (app *BaseApp) DeliverTx(txBytes []byte) (res abci.ResponseDeliverTx) {
 		Log:       result.Log,
 		GasWanted: result.GasWanted,
 		GasUsed:   result.GasUsed,
-		Tags:      result.Tags,
+		Tags:      append(result.Tags, result.Events.ToTags()...),
 	}
 }
 
@@ -522,12 +676,16 @@ func (app *BaseApp) getContextForAnte(mode runTxMode, txBytes []byte) (ctx sdk.C
 	return
 }
 
-// Iterates through msgs and executes them
+// Iterates through msgs and executes them. The result of every msg is kept
+// apart in the TxData encoded into the result Data.
 func (app *BaseApp) runMsgs(ctx sdk.Context, msgs []sdk.Msg) (result sdk.Result) {
 	// accumulate results
 	logs := make([]string, 0, len(msgs))
-	var data []byte   // NOTE: we just append them all (?!)
+	msgResults := make([]sdk.MsgResult, 0, len(msgs))
 	var tags sdk.Tags // also just append them all
+	events := sdk.EmptyEvents()
+	// the message events alone, returned when a msg fails
+	msgEvents := sdk.EmptyEvents()
 	var code sdk.ABCICodeType
 	for msgIdx, msg := range msgs {
 		// Match route.
@@ -537,19 +695,41 @@ func (app *BaseApp) runMsgs(ctx sdk.Context, msgs []sdk.Msg) (result sdk.Result)
 			return sdk.ErrUnknownRequest("Unrecognized Msg type: " + msgType).Result()
 		}
 
-		msgResult := handler(ctx, msg)
+		// Each msg collects its own events, prefixed by a message event so
+		// they can be told apart.
+		msgCtx := ctx.WithEventManager(sdk.NewEventManager())
+		gasBefore := ctx.GasMeter().GasConsumed()
+		msgResult := handler(msgCtx, msg)
 
 		// NOTE: GasWanted is determined by ante handler and
 		// GasUsed by the GasMeter
 
-		// Append Data and Tags
-		data = append(data, msgResult.Data...)
+		// Append Tags and Events
+		msgEvent := sdk.NewEvent(sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyMsgIndex, strconv.Itoa(msgIdx)),
+			sdk.NewAttribute(sdk.AttributeKeyModule, msgType),
+		)
+		msgEvents = msgEvents.AppendEvent(msgEvent)
+		events = events.AppendEvent(msgEvent)
+		events = events.AppendEvents(msgResult.Events)
+		events = events.AppendEvents(msgCtx.EventManager().Events())
 		tags = append(tags, msgResult.Tags...)
 
-		// Stop execution and return on first failed message.
+		msgResults = append(msgResults, sdk.MsgResult{
+			Index:   msgIdx,
+			Code:    msgResult.Code,
+			Data:    msgResult.Data,
+			GasUsed: ctx.GasMeter().GasConsumed() - gasBefore,
+		})
+
+		// Stop execution and return on first failed message. The state
+		// changes of all the msgs are then discarded, so are their tags and
+		// events, only the message events are kept.
 		if !msgResult.IsOK() {
 			logs = append(logs, fmt.Sprintf("Msg %d failed: %s", msgIdx, msgResult.Log))
 			code = msgResult.Code
+			tags = nil
+			events = msgEvents
 			break
 		}
 
@@ -560,11 +740,12 @@ func (app *BaseApp) runMsgs(ctx sdk.Context, msgs []sdk.Msg) (result sdk.Result)
 	// Set the final gas values.
 	result = sdk.Result{
 		Code:    code,
-		Data:    data,
+		Data:    app.cdc.MustMarshalBinary(sdk.TxData{MsgResults: msgResults}),
 		Log:     strings.Join(logs, "\n"),
 		GasUsed: ctx.GasMeter().GasConsumed(),
 		// TODO: FeeAmount/FeeDenom
-		Tags: tags,
+		Tags:   tags,
+		Events: events,
 	}
 
 	return result
@@ -589,6 +770,7 @@ func (app *BaseApp) runTx(mode runTxMode, txBytes []byte, tx sdk.Tx) (result sdk
 	// meter so we initialize upfront.
 	var gasWanted int64
 	ctx := app.getContextForAnte(mode, txBytes)
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
 
 	defer func() {
 		if r := recover(); r != nil {
@@ -604,8 +786,19 @@ func (app *BaseApp) runTx(mode runTxMode, txBytes []byte, tx sdk.Tx) (result sdk
 
 		result.GasWanted = gasWanted
 		result.GasUsed = ctx.GasMeter().GasConsumed()
+
+		// Every delivered tx is included in the block, so its gas counts
+		// against the block gas limit whether or not it succeeded.
+		if mode == runTxModeDeliver {
+			consumeBlockGas(ctx, result.GasUsed)
+		}
 	}()
 
+	// Once the block gas limit is reached no further txs may run.
+	if mode == runTxModeDeliver && ctx.BlockGasMeter().IsPastLimit() {
+		return sdk.ErrOutOfBlockGas("no block gas left to run tx").Result()
+	}
+
 	var msgs = tx.GetMsgs()
 
 	err := validateBasicTxMsgs(msgs)
@@ -623,7 +816,15 @@ func (app *BaseApp) runTx(mode runTxMode, txBytes []byte, tx sdk.Tx) (result sdk
 			ctx = newCtx
 		}
 
-		gasWanted = result.GasWanted
+		gasWanted = anteResult.GasWanted
+	}
+
+	// Reject the tx before running any msgs if its gas limit doesn't fit into
+	// what's left of the block gas.
+	if mode == runTxModeDeliver && !fitsBlockGas(ctx.BlockGasMeter(), gasWanted) {
+		log := fmt.Sprintf("tx gas wanted %d exceeds remaining block gas %d",
+			gasWanted, ctx.BlockGasMeter().Limit()-ctx.BlockGasMeter().GasConsumed())
+		return sdk.ErrOutOfBlockGas(log).Result()
 	}
 
 	// Keep the state in a transient CacheWrap in case processing the messages
@@ -639,6 +840,12 @@ func (app *BaseApp) runTx(mode runTxMode, txBytes []byte, tx sdk.Tx) (result sdk
 	result = app.runMsgs(ctx, msgs)
 	result.GasWanted = gasWanted
 
+	// The events of the ante handler are only emitted along with the events
+	// of the msgs when the tx succeeds.
+	if result.IsOK() {
+		result.Events = ctx.EventManager().Events().AppendEvents(result.Events)
+	}
+
 	// only update state if all messages pass and we're not in a simulation
 	if result.IsOK() && mode != runTxModeSimulate {
 		msCache.Write()
@@ -647,16 +854,45 @@ func (app *BaseApp) runTx(mode runTxMode, txBytes []byte, tx sdk.Tx) (result sdk
 	return
 }
 
+// fitsBlockGas returns whether a tx wanting the given gas can still run in
+// the block tracked by blockGasMeter.
+func fitsBlockGas(blockGasMeter sdk.GasMeter, gasWanted sdk.Gas) bool {
+	limit := blockGasMeter.Limit()
+	if limit == 0 {
+		return true
+	}
+	return blockGasMeter.GasConsumed()+gasWanted <= limit
+}
+
+// consumeBlockGas charges gas against the block gas meter. Going over the
+// limit doesn't panic, it leaves the meter past its limit so that the
+// remaining txs in the block are rejected.
+func consumeBlockGas(ctx sdk.Context, gas sdk.Gas) {
+	defer func() {
+		if r := recover(); r != nil {
+			if _, ok := r.(sdk.ErrorOutOfGas); !ok {
+				panic(r)
+			}
+		}
+	}()
+	ctx.BlockGasMeter().ConsumeGas(gas, "block gas meter")
+}
+
 // EndBlock implements the ABCI application interface.
 func (app *BaseApp) EndBlock(req abci.RequestEndBlock) (res abci.ResponseEndBlock) {
 	if app.deliverState.ms.TracingEnabled() {
 		app.deliverState.ms = app.deliverState.ms.ResetTraceContext().(sdk.CacheMultiStore)
 	}
 
+	app.writeCollector.endBlock()
 	if app.endBlocker != nil {
-		res = app.endBlocker(app.deliverState.ctx, req)
+		ctx := app.deliverState.ctx.WithEventManager(sdk.NewEventManager())
+		res = app.endBlocker(ctx, req)
+		res.Tags = append(res.Tags, ctx.EventManager().Events().ToTags()...)
 	}
 
+	blockGasUsed := app.deliverState.ctx.BlockGasMeter().GasConsumed()
+	res.Tags = append(res.Tags, sdk.MakeTag(sdk.TagBlockGasUsed, []byte(strconv.FormatInt(blockGasUsed, 10))))
 	return
 }
 
@@ -672,6 +908,9 @@ func (app *BaseApp) Commit() (res abci.ResponseCommit) {
 			app.db.SetSync(dbHeaderKey, headerBytes)
 	*/
 
+	// Keep the gas used by this block around for queries
+	app.lastBlockGasUsed = app.deliverState.ctx.BlockGasMeter().GasConsumed()
+
 	// Write the Deliver state and commit the MultiStore
 	app.deliverState.ms.Write()
 	commitID := app.cms.Commit()
@@ -680,6 +919,15 @@ func (app *BaseApp) Commit() (res abci.ResponseCommit) {
 		"commit", commitID,
 	)
 
+	// Stream the writes of the block now that they are committed
+	blockWrites := app.writeCollector.flush()
+	for _, entry := range app.blockListeners {
+		err := entry.listener.OnCommit(entry.filter(blockWrites))
+		if err != nil {
+			app.Logger.Error("failed to stream block writes", "height", blockWrites.Height, "err", err)
+		}
+	}
+
 	// Reset the Check state to the latest committed
 	// NOTE: safe because Tendermint holds a lock on the mempool for Commit.
 	// Use the header from this latest block.
@@ -564,6 +564,144 @@ func TestMultiMsgDeliverTx(t *testing.T) {
 	}
 }
 
+// Events emitted by the handlers are returned per msg, each preceded by a
+// message event, and the blocker events are returned as block tags.
+func TestMsgEvents(t *testing.T) {
+	app, capKey, _ := setupBaseApp(t)
+
+	app.SetAnteHandler(anteHandlerTxTest(t, capKey, []byte("ante-key")))
+	counterHandler := handlerMsgCounter(t, capKey, []byte("deliver-key"))
+	app.Router().AddRoute(typeMsgCounter, func(ctx sdk.Context, msg sdk.Msg) sdk.Result {
+		counter := msg.(*msgCounter).Counter
+		ctx.EventManager().EmitEvent(sdk.NewEvent("counter",
+			sdk.NewAttribute("value", fmt.Sprintf("%d", counter))))
+		return counterHandler(ctx, msg)
+	})
+	app.SetBeginBlocker(func(ctx sdk.Context, req abci.RequestBeginBlock) abci.ResponseBeginBlock {
+		ctx.EventManager().EmitEvent(sdk.NewEvent("begin", sdk.NewAttribute("height", "1")))
+		return abci.ResponseBeginBlock{}
+	})
+	app.SetEndBlocker(func(ctx sdk.Context, req abci.RequestEndBlock) abci.ResponseEndBlock {
+		ctx.EventManager().EmitEvent(sdk.NewEvent("end", sdk.NewAttribute("height", "1")))
+		return abci.ResponseEndBlock{}
+	})
+
+	resBegin := app.BeginBlock(abci.RequestBeginBlock{})
+	require.Equal(t, sdk.Events{sdk.NewEvent("begin", sdk.NewAttribute("height", "1"))},
+		sdk.EventsFromTags(resBegin.Tags))
+
+	tx := newTxCounter(0, 0, 1)
+	txBytes, err := app.cdc.MarshalBinary(tx)
+	require.NoError(t, err)
+	res := app.DeliverTx(txBytes)
+	require.True(t, res.IsOK(), fmt.Sprintf("%v", res))
+
+	expected := sdk.Events{
+		sdk.NewEvent(sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyMsgIndex, "0"),
+			sdk.NewAttribute(sdk.AttributeKeyModule, typeMsgCounter)),
+		sdk.NewEvent("counter", sdk.NewAttribute("value", "0")),
+		sdk.NewEvent(sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyMsgIndex, "1"),
+			sdk.NewAttribute(sdk.AttributeKeyModule, typeMsgCounter)),
+		sdk.NewEvent("counter", sdk.NewAttribute("value", "1")),
+	}
+	require.Equal(t, expected, sdk.EventsFromTags(res.Tags))
+
+	// the events of a tx don't leak into the next one
+	tx = newTxCounter(1, 2)
+	txBytes, err = app.cdc.MarshalBinary(tx)
+	require.NoError(t, err)
+	res = app.DeliverTx(txBytes)
+	require.True(t, res.IsOK(), fmt.Sprintf("%v", res))
+	require.Len(t, sdk.EventsFromTags(res.Tags), 2)
+
+	resEnd := app.EndBlock(abci.RequestEndBlock{})
+	require.Equal(t, sdk.Events{sdk.NewEvent("end", sdk.NewAttribute("height", "1"))},
+		sdk.EventsFromTags(resEnd.Tags))
+}
+
+// A failed tx only returns the message events of its msgs, the events of the
+// discarded state changes aren't emitted.
+func TestFailedMsgEvents(t *testing.T) {
+	app, _, _ := setupBaseApp(t)
+
+	// msgs with a counter over 1 fail after emitting an event
+	app.Router().AddRoute(typeMsgCounter, func(ctx sdk.Context, msg sdk.Msg) sdk.Result {
+		counter := msg.(*msgCounter).Counter
+		ctx.EventManager().EmitEvent(sdk.NewEvent("counter",
+			sdk.NewAttribute("value", fmt.Sprintf("%d", counter))))
+		if counter > 1 {
+			return sdk.ErrInternal("counter too large").Result()
+		}
+		return sdk.Result{Tags: sdk.NewTags("counter", []byte{byte(counter)})}
+	})
+
+	app.BeginBlock(abci.RequestBeginBlock{})
+	tx := newTxCounter(0, 1, 2, 1)
+	txBytes, err := app.cdc.MarshalBinary(tx)
+	require.NoError(t, err)
+	res := app.DeliverTx(txBytes)
+	require.False(t, res.IsOK())
+
+	expected := sdk.Events{
+		sdk.NewEvent(sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyMsgIndex, "0"),
+			sdk.NewAttribute(sdk.AttributeKeyModule, typeMsgCounter)),
+		sdk.NewEvent(sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyMsgIndex, "1"),
+			sdk.NewAttribute(sdk.AttributeKeyModule, typeMsgCounter)),
+	}
+	require.Equal(t, expected, sdk.EventsFromTags(res.Tags))
+}
+
+// The result of each msg is returned in the tx data, up to the first failed
+// msg.
+func TestMsgResults(t *testing.T) {
+	app, _, _ := setupBaseApp(t)
+
+	// msgs with a counter over 2 fail, each msg uses as much gas as its counter
+	app.Router().AddRoute(typeMsgCounter, func(ctx sdk.Context, msg sdk.Msg) sdk.Result {
+		counter := msg.(*msgCounter).Counter
+		ctx.GasMeter().ConsumeGas(counter, "counter")
+		if counter > 2 {
+			return sdk.ErrInternal("counter too large").Result()
+		}
+		return sdk.Result{
+			Data: []byte{byte(counter)},
+			Log:  fmt.Sprintf("counter %d", counter),
+			Tags: sdk.NewTags("counter", []byte{byte(counter)}),
+		}
+	})
+
+	app.BeginBlock(abci.RequestBeginBlock{})
+	tx := newTxCounter(0, 1, 2, 3, 1)
+	txBytes, err := app.cdc.MarshalBinary(tx)
+	require.NoError(t, err)
+	res := app.DeliverTx(txBytes)
+	require.False(t, res.IsOK())
+
+	var txData sdk.TxData
+	require.NoError(t, app.cdc.UnmarshalBinary(res.Data, &txData))
+	require.Len(t, txData.MsgResults, 3)
+	for i, msgResult := range txData.MsgResults {
+		require.Equal(t, i, msgResult.Index)
+		require.Equal(t, int64(i+1), msgResult.GasUsed)
+	}
+	for i, msgResult := range txData.MsgResults[:2] {
+		require.True(t, msgResult.Code.IsOK())
+		require.Equal(t, []byte{byte(i + 1)}, msgResult.Data)
+	}
+	require.Equal(t, sdk.ToABCICode(sdk.CodespaceRoot, sdk.CodeInternal), txData.MsgResults[2].Code)
+	require.Empty(t, txData.MsgResults[2].Data)
+
+	// the nondeterministic logs are only in the log of the tx, the data is
+	// made of the deterministic fields alone
+	require.Contains(t, res.Log, "Msg 1: counter 2")
+	require.Equal(t, app.cdc.MustMarshalBinary(txData), res.Data)
+	require.NotContains(t, string(res.Data), "counter")
+}
+
 // Interleave calls to Check and Deliver and ensure
 // that there is no cross-talk. Check sees results of the previous Check calls
 // and Deliver sees that of the previous Deliver calls, but they don't see eachother.
@@ -780,6 +918,79 @@ func TestTxGasLimits(t *testing.T) {
 	}
 }
 
+// Test that transactions exceeding the block gas limit fail
+func TestMaxBlockGasLimits(t *testing.T) {
+	app, capKey, _ := setupBaseApp(t)
+
+	gasGranted := int64(10)
+	app.SetAnteHandler(func(ctx sdk.Context, tx sdk.Tx) (newCtx sdk.Context, res sdk.Result, abort bool) {
+		newCtx = ctx.WithGasMeter(sdk.NewGasMeter(gasGranted))
+		count := tx.(*txTest).Counter
+		newCtx.GasMeter().ConsumeGas(count, "counter-ante")
+		res = sdk.Result{
+			GasWanted: gasGranted,
+		}
+		return
+	})
+	app.Router().AddRoute(typeMsgCounter, func(ctx sdk.Context, msg sdk.Msg) sdk.Result {
+		count := msg.(msgCounter).Counter
+		ctx.GasMeter().ConsumeGas(count, "counter-handler")
+		return sdk.Result{}
+	})
+
+	app.InitChain(abci.RequestInitChain{
+		ConsensusParams: &abci.ConsensusParams{
+			BlockSize: &abci.BlockSize{MaxGas: 25},
+		},
+	})
+
+	testCases := []struct {
+		tx            *txTest
+		numDelivered  int
+		blockGasUsed  int64
+		failedTxIndex int
+	}{
+		// each tx wants 10 gas, so only two fit into the block
+		{newTxCounter(0, 0), 3, 0, -1},
+		{newTxCounter(1, 1), 3, 6, -1},
+		// the ante handler still runs for the rejected tx
+		{newTxCounter(5, 5), 3, 25, 2},
+		{newTxCounter(3, 5), 2, 16, -1},
+	}
+
+	for i, tc := range testCases {
+		app.BeginBlock(abci.RequestBeginBlock{})
+
+		for j := 0; j < tc.numDelivered; j++ {
+			res := app.Deliver(tc.tx)
+			if j == tc.failedTxIndex {
+				require.Equal(t, sdk.ToABCICode(sdk.CodespaceRoot, sdk.CodeOutOfBlockGas), res.Code,
+					fmt.Sprintf("%d: %v, %v", i, tc, res))
+			} else {
+				require.True(t, res.IsOK(), fmt.Sprintf("%d: %v, %v", i, tc, res))
+			}
+		}
+
+		blockGasUsed := app.deliverState.ctx.BlockGasMeter().GasConsumed()
+		require.Equal(t, tc.blockGasUsed, blockGasUsed, fmt.Sprintf("%d: %v", i, tc))
+
+		resEndBlock := app.EndBlock(abci.RequestEndBlock{})
+		require.Equal(t, []byte(sdk.TagBlockGasUsed), resEndBlock.Tags[len(resEndBlock.Tags)-1].Key)
+		require.Equal(t, []byte(fmt.Sprintf("%d", tc.blockGasUsed)), resEndBlock.Tags[len(resEndBlock.Tags)-1].Value)
+		app.Commit()
+
+		res := app.Query(abci.RequestQuery{Path: "/app/blockgas"})
+		require.Equal(t, []byte(fmt.Sprintf("%d", tc.blockGasUsed)), res.Value)
+	}
+
+	// the consensus params survive a restart
+	newApp := newBaseApp(t.Name())
+	newApp.cms = app.cms
+	err := newApp.LoadLatestVersion(capKey)
+	require.Nil(t, err)
+	require.Equal(t, int64(25), newApp.getMaximumBlockGas())
+}
+
 //-------------------------------------------------------------------------------------------
 // Queries
 
@@ -833,6 +1044,89 @@ func TestQuery(t *testing.T) {
 	require.Equal(t, value, res.Value)
 }
 
+// Test that custom queries are routed to the querier of their route and
+// served on the state committed at the requested height.
+func TestCustomQuery(t *testing.T) {
+	app, capKey, _ := setupBaseApp(t)
+
+	key, value := []byte("hello"), []byte("goodbye")
+	app.Router().AddRoute(typeMsgCounter, func(ctx sdk.Context, msg sdk.Msg) sdk.Result {
+		store := ctx.KVStore(capKey)
+		store.Set(key, value)
+		return sdk.Result{}
+	})
+	app.QueryRouter().AddRoute("test", func(ctx sdk.Context, path []string, req abci.RequestQuery) ([]byte, sdk.Error) {
+		require.Equal(t, []string{"value"}, path)
+		store := ctx.KVStore(capKey)
+		res := store.Get(req.Data)
+		// writes of a querier are discarded
+		store.Set(req.Data, []byte("overwritten"))
+		return res, nil
+	})
+	app.InitChain(abci.RequestInitChain{})
+
+	query := abci.RequestQuery{
+		Path: "/custom/test/value",
+		Data: key,
+	}
+
+	// query is empty before anything is committed
+	res := app.Query(query)
+	require.Equal(t, uint32(sdk.ABCICodeOK), res.Code)
+	require.Equal(t, 0, len(res.Value))
+
+	app.BeginBlock(abci.RequestBeginBlock{})
+	resTx := app.Deliver(newTxCounter(0, 0))
+	require.True(t, resTx.IsOK(), fmt.Sprintf("%v", resTx))
+	app.Commit()
+
+	res = app.Query(query)
+	require.Equal(t, uint32(sdk.ABCICodeOK), res.Code)
+	require.Equal(t, value, res.Value)
+	require.Equal(t, int64(1), res.Height)
+
+	// the write of the previous query didn't persist
+	res = app.Query(query)
+	require.Equal(t, value, res.Value)
+
+	app.BeginBlock(abci.RequestBeginBlock{Header: abci.Header{Height: 2}})
+	newValue := []byte("farewell")
+	value = newValue
+	resTx = app.Deliver(newTxCounter(1, 0))
+	require.True(t, resTx.IsOK(), fmt.Sprintf("%v", resTx))
+	app.Commit()
+
+	res = app.Query(query)
+	require.Equal(t, newValue, res.Value)
+	require.Equal(t, int64(2), res.Height)
+
+	// a past height is served on the state committed then
+	query.Height = 1
+	res = app.Query(query)
+	require.Equal(t, uint32(sdk.ABCICodeOK), res.Code)
+	require.Equal(t, []byte("goodbye"), res.Value)
+	require.Equal(t, int64(1), res.Height)
+
+	// heights which aren't committed can't be queried
+	for _, height := range []int64{3, -1} {
+		query.Height = height
+		res = app.Query(query)
+		require.Equal(t, sdk.ToABCICode(sdk.CodespaceRoot, sdk.CodeInvalidHeight), sdk.ABCICodeType(res.Code))
+	}
+
+	// unknown route
+	res = app.Query(abci.RequestQuery{Path: "/custom/unknown/value"})
+	require.Equal(t, sdk.ToABCICode(sdk.CodespaceRoot, sdk.CodeUnknownRequest), sdk.ABCICodeType(res.Code))
+
+	// a panicking querier fails the query
+	app.QueryRouter().AddRoute("panic", func(ctx sdk.Context, path []string, req abci.RequestQuery) ([]byte, sdk.Error) {
+		panic("querier panic")
+	})
+	res = app.Query(abci.RequestQuery{Path: "/custom/panic/value"})
+	require.Equal(t, sdk.ToABCICode(sdk.CodespaceRoot, sdk.CodeInternal), sdk.ABCICodeType(res.Code))
+	require.Contains(t, res.Log, "querier panic")
+}
+
 // Test p2p filter queries
 func TestP2PQuery(t *testing.T) {
 	app, _, _ := setupBaseApp(t)
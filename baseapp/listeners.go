@@ -0,0 +1,189 @@
+package baseapp
+
+import (
+	"encoding/json"
+	"io"
+
+	"github.com/tendermint/tendermint/crypto/tmhash"
+	cmn "github.com/tendermint/tendermint/libs/common"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// StoreWrite is a set, or a delete if Delete is true, committed to the
+// KVStore named StoreKey.
+type StoreWrite struct {
+	StoreKey string `json:"store_key"`
+	Key      []byte `json:"key"`
+	Value    []byte `json:"value,omitempty"`
+	Delete   bool   `json:"delete,omitempty"`
+}
+
+// TxWrites are the StoreWrites committed by a single DeliverTx. A failed tx
+// may still have writes, namely those made by the AnteHandler.
+type TxWrites struct {
+	Index  int          `json:"index"`
+	Hash   cmn.HexBytes `json:"hash"`
+	Writes []StoreWrite `json:"writes"`
+}
+
+// BlockWrites holds all the StoreWrites committed by a block, grouped by the
+// ABCI call that made them. InitChain is only set for the first block.
+type BlockWrites struct {
+	Height     int64        `json:"height"`
+	InitChain  []StoreWrite `json:"init_chain,omitempty"`
+	BeginBlock []StoreWrite `json:"begin_block"`
+	Txs        []TxWrites   `json:"txs"`
+	EndBlock   []StoreWrite `json:"end_block"`
+}
+
+// BlockListener is notified of the writes of every block once the block has
+// been committed.
+type BlockListener interface {
+	OnCommit(writes BlockWrites) error
+}
+
+// AddBlockListener registers a BlockListener for the KVStores of the given
+// keys. The listener only receives the writes made to those stores.
+func (app *BaseApp) AddBlockListener(listener BlockListener, keys ...sdk.StoreKey) {
+	names := make(map[string]struct{}, len(keys))
+	for _, key := range keys {
+		names[key.Name()] = struct{}{}
+		app.listenKeys[key] = struct{}{}
+	}
+	app.blockListeners = append(app.blockListeners, blockListenerEntry{listener, names})
+}
+
+type blockListenerEntry struct {
+	listener BlockListener
+	names    map[string]struct{}
+}
+
+// filter returns the writes made to the stores the listener is registered for.
+func (e blockListenerEntry) filter(bw BlockWrites) BlockWrites {
+	filtered := BlockWrites{
+		Height:     bw.Height,
+		InitChain:  e.filterWrites(bw.InitChain),
+		BeginBlock: e.filterWrites(bw.BeginBlock),
+		Txs:        make([]TxWrites, len(bw.Txs)),
+		EndBlock:   e.filterWrites(bw.EndBlock),
+	}
+	for i, tx := range bw.Txs {
+		filtered.Txs[i] = TxWrites{tx.Index, tx.Hash, e.filterWrites(tx.Writes)}
+	}
+	return filtered
+}
+
+func (e blockListenerEntry) filterWrites(writes []StoreWrite) (filtered []StoreWrite) {
+	for _, write := range writes {
+		if _, ok := e.names[write.StoreKey]; ok {
+			filtered = append(filtered, write)
+		}
+	}
+	return
+}
+
+//______________________________________________________________________________
+
+type writePhase uint8
+
+const (
+	writePhaseInitChain writePhase = iota
+	writePhaseBeginBlock
+	writePhaseDeliverTx
+	writePhaseEndBlock
+)
+
+// writeCollector implements sdk.WriteListener. BaseApp registers it on the
+// deliverState to collect the writes of the current block.
+type writeCollector struct {
+	phase writePhase
+	block BlockWrites
+}
+
+var _ sdk.WriteListener = (*writeCollector)(nil)
+
+func newWriteCollector() *writeCollector {
+	return &writeCollector{}
+}
+
+// Implements sdk.WriteListener.
+func (wc *writeCollector) OnWrite(storeKey sdk.StoreKey, key []byte, value []byte, delete bool) {
+	write := StoreWrite{StoreKey: storeKey.Name(), Key: key, Value: value, Delete: delete}
+
+	switch wc.phase {
+	case writePhaseInitChain:
+		wc.block.InitChain = append(wc.block.InitChain, write)
+	case writePhaseBeginBlock:
+		wc.block.BeginBlock = append(wc.block.BeginBlock, write)
+	case writePhaseDeliverTx:
+		tx := &wc.block.Txs[len(wc.block.Txs)-1]
+		tx.Writes = append(tx.Writes, write)
+	case writePhaseEndBlock:
+		wc.block.EndBlock = append(wc.block.EndBlock, write)
+	}
+}
+
+func (wc *writeCollector) beginBlock(height int64) {
+	wc.phase = writePhaseBeginBlock
+	wc.block.Height = height
+}
+
+func (wc *writeCollector) deliverTx(txBytes []byte) {
+	wc.phase = writePhaseDeliverTx
+	wc.block.Txs = append(wc.block.Txs, TxWrites{
+		Index: len(wc.block.Txs),
+		Hash:  cmn.HexBytes(tmhash.Sum(txBytes)),
+	})
+}
+
+func (wc *writeCollector) endBlock() {
+	wc.phase = writePhaseEndBlock
+}
+
+// flush returns the collected writes and resets the collector for the next
+// block.
+func (wc *writeCollector) flush() BlockWrites {
+	bw := wc.block
+	wc.block = BlockWrites{}
+	wc.phase = writePhaseBeginBlock
+	return bw
+}
+
+//______________________________________________________________________________
+
+// NewWriterBlockListener returns a BlockListener that streams the writes of
+// each block to w as a line of JSON.
+func NewWriterBlockListener(w io.Writer) BlockListener {
+	return writerBlockListener{w}
+}
+
+type writerBlockListener struct {
+	w io.Writer
+}
+
+// Implements BlockListener.
+func (l writerBlockListener) OnCommit(writes BlockWrites) error {
+	bz, err := json.Marshal(writes)
+	if err != nil {
+		return err
+	}
+	_, err = l.w.Write(append(bz, '\n'))
+	return err
+}
+
+// NewChanBlockListener returns a BlockListener that sends the writes of each
+// block on ch. Sends block, so ch must be drained or Commit will stall.
+func NewChanBlockListener(ch chan<- BlockWrites) BlockListener {
+	return chanBlockListener{ch}
+}
+
+type chanBlockListener struct {
+	ch chan<- BlockWrites
+}
+
+// Implements BlockListener.
+func (l chanBlockListener) OnCommit(writes BlockWrites) error {
+	l.ch <- writes
+	return nil
+}
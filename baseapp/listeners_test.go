@@ -0,0 +1,83 @@
+package baseapp
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestBlockListener(t *testing.T) {
+	app, capKey1, capKey2 := setupBaseApp(t)
+
+	anteKey, deliverKey := []byte("ante-key"), []byte("deliver-key")
+	beginKey, endKey := []byte("begin-key"), []byte("end-key")
+	app.SetAnteHandler(func(ctx sdk.Context, tx sdk.Tx) (newCtx sdk.Context, res sdk.Result, abort bool) {
+		ctx.KVStore(capKey1).Set(anteKey, []byte{1})
+		ctx.KVStore(capKey2).Set(anteKey, []byte{2})
+		return
+	})
+	app.Router().AddRoute(typeMsgCounter, func(ctx sdk.Context, msg sdk.Msg) sdk.Result {
+		ctx.KVStore(capKey1).Set(deliverKey, []byte{1})
+		if msg.(*msgCounter).Counter > 0 {
+			return sdk.ErrInternal("fail").Result()
+		}
+		return sdk.Result{}
+	})
+	app.SetBeginBlocker(func(ctx sdk.Context, req abci.RequestBeginBlock) abci.ResponseBeginBlock {
+		ctx.KVStore(capKey1).Set(beginKey, []byte{1})
+		return abci.ResponseBeginBlock{}
+	})
+	app.SetEndBlocker(func(ctx sdk.Context, req abci.RequestEndBlock) abci.ResponseEndBlock {
+		ctx.KVStore(capKey1).Delete(beginKey)
+		return abci.ResponseEndBlock{}
+	})
+
+	ch := make(chan BlockWrites, 1)
+	var buf bytes.Buffer
+	app.AddBlockListener(NewChanBlockListener(ch), capKey1)
+	app.AddBlockListener(NewWriterBlockListener(&buf), capKey1, capKey2)
+
+	app.InitChain(abci.RequestInitChain{})
+	app.BeginBlock(abci.RequestBeginBlock{Header: abci.Header{Height: 1}})
+	for _, tx := range []*txTest{newTxCounter(0, 0), newTxCounter(1, 1)} {
+		txBytes, err := app.cdc.MarshalBinary(tx)
+		require.NoError(t, err)
+		app.DeliverTx(txBytes)
+	}
+	app.EndBlock(abci.RequestEndBlock{})
+
+	// nothing is streamed before the block is committed
+	require.Len(t, ch, 0)
+	require.Equal(t, 0, buf.Len())
+	app.Commit()
+
+	bw := <-ch
+	require.Equal(t, int64(1), bw.Height)
+	require.Equal(t, []StoreWrite{{StoreKey: "key1", Key: beginKey, Value: []byte{1}}}, bw.BeginBlock)
+	require.Equal(t, []StoreWrite{{StoreKey: "key1", Key: beginKey, Delete: true}}, bw.EndBlock)
+
+	// the failed tx only commits the writes of the ante handler
+	require.Len(t, bw.Txs, 2)
+	require.Equal(t, 0, bw.Txs[0].Index)
+	require.Equal(t, []StoreWrite{
+		{StoreKey: "key1", Key: anteKey, Value: []byte{1}},
+		{StoreKey: "key1", Key: deliverKey, Value: []byte{1}},
+	}, bw.Txs[0].Writes)
+	require.Equal(t, 1, bw.Txs[1].Index)
+	require.Equal(t, []StoreWrite{{StoreKey: "key1", Key: anteKey, Value: []byte{1}}}, bw.Txs[1].Writes)
+
+	// the writer listener also gets the writes of the second store
+	var streamed BlockWrites
+	err := json.Unmarshal(buf.Bytes(), &streamed)
+	require.NoError(t, err)
+	require.Equal(t, []StoreWrite{
+		{StoreKey: "key1", Key: anteKey, Value: []byte{1}},
+		{StoreKey: "key2", Key: anteKey, Value: []byte{2}},
+	}, streamed.Txs[1].Writes)
+}
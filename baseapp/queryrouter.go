@@ -0,0 +1,48 @@
+package baseapp
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// QueryRouter provides queriers for each custom query route.
+type QueryRouter interface {
+	AddRoute(r string, q sdk.Querier) (rtr QueryRouter)
+	Route(path string) (q sdk.Querier)
+}
+
+type queryRoute struct {
+	r string
+	q sdk.Querier
+}
+
+type queryRouter struct {
+	routes []queryRoute
+}
+
+// nolint
+// NewQueryRouter - create new query router
+func NewQueryRouter() *queryRouter {
+	return &queryRouter{
+		routes: make([]queryRoute, 0),
+	}
+}
+
+// AddRoute - register the querier of a custom query route
+func (rtr *queryRouter) AddRoute(r string, q sdk.Querier) QueryRouter {
+	if !isAlpha(r) {
+		panic("route expressions can only contain alphabet characters")
+	}
+	rtr.routes = append(rtr.routes, queryRoute{r, q})
+
+	return rtr
+}
+
+// Route - get the querier of a custom query route, nil if there is none
+func (rtr *queryRouter) Route(path string) (q sdk.Querier) {
+	for _, route := range rtr.routes {
+		if route.r == path {
+			return route.q
+		}
+	}
+	return nil
+}
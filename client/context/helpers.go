@@ -44,6 +44,28 @@ func (ctx CoreContext) BroadcastTx(tx []byte) (*ctypes.ResultBroadcastTxCommit,
 	return res, err
 }
 
+// BroadcastTxSync broadcasts the transaction bytes to Tendermint and returns
+// once they passed CheckTx, without waiting for them to be committed
+func (ctx CoreContext) BroadcastTxSync(tx []byte) (*ctypes.ResultBroadcastTx, error) {
+
+	node, err := ctx.GetNode()
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := node.BroadcastTxSync(tx)
+	if err != nil {
+		return res, err
+	}
+
+	if res.Code != uint32(0) {
+		return res, errors.Errorf("checkTx failed: (%d) %s",
+			res.Code,
+			res.Log)
+	}
+	return res, err
+}
+
 // Broadcast the transaction bytes to Tendermint
 func (ctx CoreContext) BroadcastTxAsync(tx []byte) (*ctypes.ResultBroadcastTx, error) {
 
@@ -60,31 +82,62 @@ func (ctx CoreContext) BroadcastTxAsync(tx []byte) (*ctypes.ResultBroadcastTx, e
 	return res, err
 }
 
+// ErrInvalidHeight is the cause of query errors returned when the node cannot
+// serve the requested height, either because it was pruned or because it
+// has not been committed yet. Check for it with errors.Cause.
+var ErrInvalidHeight = errors.New("requested height is not available")
+
 // Query information about the connected node
 func (ctx CoreContext) Query(path string) (res []byte, err error) {
-	return ctx.query(path, nil)
+	res, _, err = ctx.query(path, nil)
+	return res, err
+}
+
+// QueryWithData queries the given path with the given data, as used by the
+// custom queries of the modules, and also returns the height the node
+// actually served the query at
+func (ctx CoreContext) QueryWithData(path string, data []byte) (res []byte, height int64, err error) {
+	return ctx.query(path, data)
 }
 
 // QueryStore from Tendermint with the provided key and storename
 func (ctx CoreContext) QueryStore(key cmn.HexBytes, storeName string) (res []byte, err error) {
+	res, _, err = ctx.QueryStoreWithHeight(key, storeName)
+	return res, err
+}
+
+// QueryStoreWithHeight queries the store like QueryStore and also returns the
+// height the node actually served the query at
+func (ctx CoreContext) QueryStoreWithHeight(key cmn.HexBytes, storeName string) (res []byte, height int64, err error) {
 	return ctx.queryStore(key, storeName, "key")
 }
 
 // Query from Tendermint with the provided storename and subspace
 func (ctx CoreContext) QuerySubspace(cdc *wire.Codec, subspace []byte, storeName string) (res []sdk.KVPair, err error) {
-	resRaw, err := ctx.queryStore(subspace, storeName, "subspace")
+	res, _, err = ctx.QuerySubspaceWithHeight(cdc, subspace, storeName)
+	return res, err
+}
+
+// QuerySubspaceWithHeight queries the subspace like QuerySubspace and also
+// returns the height the node actually served the query at
+func (ctx CoreContext) QuerySubspaceWithHeight(cdc *wire.Codec, subspace []byte, storeName string) (res []sdk.KVPair, height int64, err error) {
+	resRaw, height, err := ctx.queryStore(subspace, storeName, "subspace")
 	if err != nil {
-		return res, err
+		return res, height, err
 	}
 	cdc.MustUnmarshalBinary(resRaw, &res)
 	return
 }
 
 // Query from Tendermint with the provided storename and path
-func (ctx CoreContext) query(path string, key common.HexBytes) (res []byte, err error) {
+func (ctx CoreContext) query(path string, key common.HexBytes) (res []byte, height int64, err error) {
+	if ctx.Height < 0 {
+		return res, height, errors.Wrapf(ErrInvalidHeight, "height %d cannot be negative", ctx.Height)
+	}
+
 	node, err := ctx.GetNode()
 	if err != nil {
-		return res, err
+		return res, height, err
 	}
 
 	opts := rpcclient.ABCIQueryOptions{
@@ -93,17 +146,20 @@ func (ctx CoreContext) query(path string, key common.HexBytes) (res []byte, err
 	}
 	result, err := node.ABCIQueryWithOptions(path, key, opts)
 	if err != nil {
-		return res, err
+		return res, height, err
 	}
 	resp := result.Response
+	if sdk.ABCICodeType(resp.Code) == sdk.ToABCICode(sdk.CodespaceRoot, sdk.CodeInvalidHeight) {
+		return res, resp.Height, errors.Wrap(ErrInvalidHeight, resp.Log)
+	}
 	if resp.Code != uint32(0) {
-		return res, errors.Errorf("query failed: (%d) %s", resp.Code, resp.Log)
+		return res, resp.Height, errors.Errorf("query failed: (%d) %s", resp.Code, resp.Log)
 	}
-	return resp.Value, nil
+	return resp.Value, resp.Height, nil
 }
 
 // Query from Tendermint with the provided storename and path
-func (ctx CoreContext) queryStore(key cmn.HexBytes, storeName, endPath string) (res []byte, err error) {
+func (ctx CoreContext) queryStore(key cmn.HexBytes, storeName, endPath string) (res []byte, height int64, err error) {
 	path := fmt.Sprintf("/store/%s/%s", storeName, endPath)
 	return ctx.query(path, key)
 }
@@ -129,35 +185,44 @@ func (ctx CoreContext) GetFromAddress() (from sdk.AccAddress, err error) {
 	return sdk.AccAddress(info.GetPubKey().Address()), nil
 }
 
-// sign and build the transaction from the msg
-func (ctx CoreContext) SignAndBuild(name, passphrase string, msgs []sdk.Msg, cdc *wire.Codec) ([]byte, error) {
-
-	// build the Sign Messsage from the Standard Message
+// BuildSignMsg builds the message to sign for a tx of the msgs, with the
+// chain ID, account number, sequence, memo, gas and fee of the context
+func (ctx CoreContext) BuildSignMsg(msgs []sdk.Msg) (auth.StdSignMsg, error) {
 	chainID := ctx.ChainID
 	if chainID == "" {
-		return nil, errors.Errorf("chain ID required but not specified")
+		return auth.StdSignMsg{}, errors.Errorf("chain ID required but not specified")
 	}
-	accnum := ctx.AccountNumber
-	sequence := ctx.Sequence
-	memo := ctx.Memo
 
 	fee := sdk.Coin{}
 	if ctx.Fee != "" {
 		parsedFee, err := sdk.ParseCoin(ctx.Fee)
 		if err != nil {
-			return nil, err
+			return auth.StdSignMsg{}, err
 		}
 		fee = parsedFee
 	}
 
-	signMsg := auth.StdSignMsg{
+	return auth.StdSignMsg{
 		ChainID:       chainID,
-		AccountNumber: accnum,
-		Sequence:      sequence,
+		AccountNumber: ctx.AccountNumber,
+		Sequence:      ctx.Sequence,
 		Msgs:          msgs,
-		Memo:          memo,
+		Memo:          ctx.Memo,
 		Fee:           auth.NewStdFee(ctx.Gas, fee), // TODO run simulate to estimate gas?
+	}, nil
+}
+
+// sign and build the transaction from the msg
+func (ctx CoreContext) SignAndBuild(name, passphrase string, msgs []sdk.Msg, cdc *wire.Codec) ([]byte, error) {
+
+	// build the Sign Messsage from the Standard Message
+	signMsg, err := ctx.BuildSignMsg(msgs)
+	if err != nil {
+		return nil, err
 	}
+	accnum := signMsg.AccountNumber
+	sequence := signMsg.Sequence
+	memo := signMsg.Memo
 
 	keybase, err := keys.GetKeyBase()
 	if err != nil {
@@ -0,0 +1,27 @@
+package client
+
+import (
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ErrorsCmd lists the catalog of errors registered by the modules linked into
+// the binary, to look up the codes returned by the node
+var ErrorsCmd = &cobra.Command{
+	Use:   "errors",
+	Short: "List the codes and descriptions of all the known errors",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		w := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+		fmt.Fprintln(w, "CODESPACE\tCODE\tABCI CODE\tDESCRIPTION")
+		for _, desc := range sdk.RegisteredErrors() {
+			fmt.Fprintf(w, "%d\t%d\t%d\t%s\n", desc.Codespace, desc.Code, desc.ABCICode(), desc.Description)
+		}
+		return w.Flush()
+	},
+}
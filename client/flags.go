@@ -1,6 +1,12 @@
 package client
 
-import "github.com/spf13/cobra"
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
 
 // nolint
 const (
@@ -19,6 +25,11 @@ const (
 	FlagAsync         = "async"
 	FlagJson          = "json"
 	FlagPrintResponse = "print-response"
+	FlagPage          = "page"
+	FlagLimit         = "limit"
+
+	FlagKeyringBackend = "keyring-backend"
+	FlagKeyringSigner  = "keyring-signer"
 )
 
 // LineBreak can be included in a command list to provide a blank line
@@ -38,6 +49,21 @@ func GetCommands(cmds ...*cobra.Command) []*cobra.Command {
 	return cmds
 }
 
+// AddPaginationFlags adds the page selection flags to a list query command
+func AddPaginationFlags(cmd *cobra.Command) {
+	cmd.Flags().Int(FlagPage, 1, "page of the results to query, starting at 1")
+	cmd.Flags().Int(FlagLimit, sdk.DefaultPageLimit, fmt.Sprintf("number of results per page, at most %d", sdk.MaxPageLimit))
+}
+
+// AddKeyringFlags adds the flags selecting the backend of the keybase as
+// persistent flags of the root command, so they apply to every command using
+// keys
+func AddKeyringFlags(cmd *cobra.Command) {
+	cmd.PersistentFlags().String(FlagKeyringBackend, KeyringBackendDB,
+		fmt.Sprintf("Backend of the keybase: %s, %s or %s", KeyringBackendDB, KeyringBackendFile, KeyringBackendRemote))
+	cmd.PersistentFlags().String(FlagKeyringSigner, "", "Unix socket of the signer holding the keys of the remote keybase")
+}
+
 // PostCommands adds common flags for commands to post tx
 func PostCommands(cmds ...*cobra.Command) []*cobra.Command {
 	for _, c := range cmds {
@@ -5,6 +5,16 @@ import (
 	dbm "github.com/tendermint/tendermint/libs/db"
 )
 
+// Backends of the keybase, selected with the keyring backend flag
+const (
+	// KeyringBackendDB stores the keys in a LevelDB database
+	KeyringBackendDB = "db"
+	// KeyringBackendFile stores every key in its own encrypted file
+	KeyringBackendFile = "file"
+	// KeyringBackendRemote forwards the signing to a signer process
+	KeyringBackendRemote = "remote"
+)
+
 // GetKeyBase initializes a keybase based on the given db.
 // The KeyBase manages all activity requiring access to a key.
 func GetKeyBase(db dbm.DB) keys.Keybase {
@@ -14,6 +14,7 @@ import (
 
 	ccrypto "github.com/cosmos/cosmos-sdk/crypto"
 	"github.com/cosmos/cosmos-sdk/crypto/keys"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/hd"
 
 	"github.com/tendermint/tendermint/libs/cli"
 )
@@ -32,8 +33,10 @@ func addKeyCommand() *cobra.Command {
 		Use:   "add <name>",
 		Short: "Create a new key, or import from seed",
 		Long: `Add a public/private key pair to the key store.
-If you select --seed/-s you can recover a key from the seed
-phrase, otherwise, a new key will be generated.`,
+If you select --recover you can recover a key from the seed
+phrase, otherwise, a new key will be generated. The key is derived
+along the BIP44 path 44'/118'/account'/0/index, selected with
+--account and --index.`,
 		RunE: runAddCmd,
 	}
 	cmd.Flags().StringP(flagType, "t", "secp256k1", "Type of private key (secp256k1|ed25519)")
@@ -41,8 +44,8 @@ phrase, otherwise, a new key will be generated.`,
 	cmd.Flags().Bool(flagRecover, false, "Provide seed phrase to recover existing key instead of creating")
 	cmd.Flags().Bool(flagNoBackup, false, "Don't print out seed phrase (if others are watching the terminal)")
 	cmd.Flags().Bool(flagDryRun, false, "Perform action, but don't add key to local keystore")
-	cmd.Flags().Uint32(flagAccount, 0, "Account number for HD derivation")
-	cmd.Flags().Uint32(flagIndex, 0, "Index number for HD derivation")
+	cmd.Flags().Uint32(flagAccount, 0, "Account number for HD derivation, with --recover or --ledger")
+	cmd.Flags().Uint32(flagIndex, 0, "Index number for HD derivation, with --recover or --ledger")
 	return cmd
 }
 
@@ -106,7 +109,9 @@ func runAddCmd(cmd *cobra.Command, args []string) error {
 		if err != nil {
 			return err
 		}
-		info, err := kb.CreateKey(name, seed, pass)
+		account := uint32(viper.GetInt(flagAccount))
+		index := uint32(viper.GetInt(flagIndex))
+		info, err := kb.Derive(name, seed, pass, *hd.NewFundraiserParams(account, index))
 		if err != nil {
 			return err
 		}
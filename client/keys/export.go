@@ -0,0 +1,50 @@
+package keys
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/spf13/cobra"
+)
+
+func exportKeyCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export <name>",
+		Short: "Export the private key of a local key in ASCII armored format",
+		Long: `Export the private key of a local key in ASCII armored format.
+The exported key is encrypted with a new passphrase, which is asked
+again when importing it with "keys import".`,
+		RunE: runExportCmd,
+		Args: cobra.ExactArgs(1),
+	}
+	return cmd
+}
+
+func runExportCmd(cmd *cobra.Command, args []string) error {
+	name := args[0]
+
+	kb, err := GetKeyBase()
+	if err != nil {
+		return err
+	}
+
+	buf := client.BufferStdin()
+	decryptPassword, err := client.GetPassword(
+		"Enter the passphrase of the key:", buf)
+	if err != nil {
+		return err
+	}
+	encryptPassword, err := client.GetCheckPassword(
+		"Enter a passphrase to encrypt the exported key:",
+		"Repeat the passphrase:", buf)
+	if err != nil {
+		return err
+	}
+
+	armor, err := kb.ExportPrivKey(name, decryptPassword, encryptPassword)
+	if err != nil {
+		return err
+	}
+	fmt.Println(armor)
+	return nil
+}
@@ -0,0 +1,125 @@
+package keys
+
+import (
+	"encoding/hex"
+	"fmt"
+	"io/ioutil"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/tendermint/tendermint/crypto"
+)
+
+func importKeyCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "import <name> <keyfile>",
+		Short: "Import a private key exported by \"keys export\"",
+		Long: `Import the ASCII armored private key of the keyfile, as exported
+by "keys export". The key is decrypted with the passphrase of the
+export and stored encrypted with a new passphrase.`,
+		RunE: runImportCmd,
+		Args: cobra.ExactArgs(2),
+	}
+	return cmd
+}
+
+func runImportCmd(cmd *cobra.Command, args []string) error {
+	name, keyfile := args[0], args[1]
+
+	kb, err := GetKeyBase()
+	if err != nil {
+		return err
+	}
+	if _, err = kb.Get(name); err == nil {
+		return fmt.Errorf("a key named %s already exists", name)
+	}
+
+	armor, err := ioutil.ReadFile(keyfile)
+	if err != nil {
+		return err
+	}
+
+	buf := client.BufferStdin()
+	decryptPassword, err := client.GetPassword(
+		"Enter the passphrase of the exported key:", buf)
+	if err != nil {
+		return err
+	}
+	pass, err := client.GetCheckPassword(
+		"Enter a passphrase for your key:",
+		"Repeat the passphrase:", buf)
+	if err != nil {
+		return err
+	}
+
+	info, err := kb.ImportPrivKey(name, string(armor), decryptPassword, pass)
+	if err != nil {
+		return err
+	}
+	printInfo(info)
+	return nil
+}
+
+func importHexKeyCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "import-hex <name>",
+		Short: "Import a raw hex encoded secp256k1 private key",
+		Long: `Import a raw secp256k1 private key, read as 64 hex characters from
+the input rather than from the arguments, to keep it out of the
+shell history. The key is stored encrypted with a new passphrase.`,
+		RunE: runImportHexCmd,
+		Args: cobra.ExactArgs(1),
+	}
+	return cmd
+}
+
+func runImportHexCmd(cmd *cobra.Command, args []string) error {
+	name := args[0]
+
+	kb, err := GetKeyBase()
+	if err != nil {
+		return err
+	}
+	if _, err = kb.Get(name); err == nil {
+		return fmt.Errorf("a key named %s already exists", name)
+	}
+
+	buf := client.BufferStdin()
+	hexKey, err := client.GetPassword(
+		"Enter the hex encoded private key:", buf)
+	if err != nil {
+		return err
+	}
+	priv, err := parseHexPrivKey(hexKey)
+	if err != nil {
+		return err
+	}
+	pass, err := client.GetCheckPassword(
+		"Enter a passphrase for your key:",
+		"Repeat the passphrase:", buf)
+	if err != nil {
+		return err
+	}
+
+	info, err := kb.ImportPrivateKeyObject(name, priv, pass)
+	if err != nil {
+		return err
+	}
+	printInfo(info)
+	return nil
+}
+
+// parseHexPrivKey decodes a hex encoded secp256k1 private key
+func parseHexPrivKey(hexKey string) (crypto.PrivKey, error) {
+	bz, err := hex.DecodeString(hexKey)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid hex private key")
+	}
+	var priv crypto.PrivKeySecp256k1
+	if len(bz) != len(priv) {
+		return nil, fmt.Errorf("a secp256k1 private key is %d bytes long, got %d", len(priv), len(bz))
+	}
+	copy(priv[:], bz)
+	return priv, nil
+}
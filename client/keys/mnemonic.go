@@ -0,0 +1,66 @@
+package keys
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/bip39"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"github.com/tendermint/tendermint/crypto"
+)
+
+const (
+	flagUserEntropy = "unsafe-entropy"
+
+	// minimum length of the user supplied entropy, 43 characters of random
+	// base64 are 256 bits of entropy
+	minUserEntropyLen = 43
+)
+
+func mnemonicKeyCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "mnemonic",
+		Short: "Generate a 24 word mnemonic without storing a key",
+		Long: `Generate a 24 word mnemonic, which can be used with "keys add --recover".
+The entropy comes from the system random number generator. With
+--unsafe-entropy it is hashed with SHA-256 together with the input,
+e.g. a long series of dice rolls, so the mnemonic doesn't only rely on
+the system random number generator, and can't be recreated from the
+input. 99 rolls of a six-sided die are 256 bits of entropy.`,
+		RunE: runMnemonicCmd,
+		Args: cobra.NoArgs,
+	}
+	cmd.Flags().Bool(flagUserEntropy, false, "Prompt for entropy to mix with the system random number generator")
+	return cmd
+}
+
+func runMnemonicCmd(cmd *cobra.Command, args []string) error {
+	entropy := crypto.CRandBytes(32)
+
+	if viper.GetBool(flagUserEntropy) {
+		buf := client.BufferStdin()
+		input, err := client.GetSeed(
+			fmt.Sprintf("Enter your entropy, e.g. dice rolls, at least %d characters:", minUserEntropyLen), buf)
+		if err != nil {
+			return err
+		}
+		input = strings.TrimSpace(input)
+		if len(input) < minUserEntropyLen {
+			return fmt.Errorf("the entropy must be at least %d characters, got %d", minUserEntropyLen, len(input))
+		}
+		// the input is mixed with the system entropy, so that a guessable
+		// input doesn't make a guessable mnemonic
+		hash := sha256.Sum256(append(entropy, input...))
+		entropy = hash[:]
+	}
+
+	words, err := bip39.NewMnemonicFromEntropy(entropy)
+	if err != nil {
+		return err
+	}
+	fmt.Println(strings.Join(words, " "))
+	return nil
+}
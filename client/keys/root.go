@@ -25,6 +25,14 @@ func Commands() *cobra.Command {
 		client.LineBreak,
 		deleteKeyCommand(),
 		updateKeyCommand(),
+		client.LineBreak,
+		exportKeyCommand(),
+		importKeyCommand(),
+		importHexKeyCommand(),
+		mnemonicKeyCommand(),
+		client.LineBreak,
+		signMessageCommand(),
+		verifyMessageCommand(),
 	)
 	return cmd
 }
@@ -34,6 +42,8 @@ func RegisterRoutes(r *mux.Router) {
 	r.HandleFunc("/keys", QueryKeysRequestHandler).Methods("GET")
 	r.HandleFunc("/keys", AddNewKeyRequestHandler).Methods("POST")
 	r.HandleFunc("/keys/seed", SeedRequestHandler).Methods("GET")
+	r.HandleFunc("/keys/verify-message", VerifyMessageRequestHandler).Methods("POST")
+	r.HandleFunc("/keys/{name}/sign-message", SignMessageRequestHandler).Methods("POST")
 	r.HandleFunc("/keys/{name}", GetKeyRequestHandler).Methods("GET")
 	r.HandleFunc("/keys/{name}", UpdateKeyRequestHandler).Methods("PUT")
 	r.HandleFunc("/keys/{name}", DeleteKeyRequestHandler).Methods("DELETE")
@@ -0,0 +1,227 @@
+package keys
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/wire"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+)
+
+const (
+	flagAddress = "address"
+	flagPubKey  = "pubkey"
+)
+
+func signMessageCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sign-message <name> <message>",
+		Short: "Sign an off-chain message with the given key",
+		Long: `Sign an off-chain message, e.g. to prove the ownership of an account.
+The message is signed within a sign doc which can never be the one of
+a transaction, so the signature can't be replayed on chain. The signed
+message is printed as JSON, to be checked with "keys verify-message".`,
+		RunE: runSignMessageCmd,
+		Args: cobra.ExactArgs(2),
+	}
+	return cmd
+}
+
+func runSignMessageCmd(cmd *cobra.Command, args []string) error {
+	name, message := args[0], args[1]
+
+	buf := client.BufferStdin()
+	pass, err := client.GetPassword(
+		"Enter the passphrase of the key:", buf)
+	if err != nil {
+		return err
+	}
+
+	signed, err := signMessage(name, pass, message)
+	if err != nil {
+		return err
+	}
+	output, err := wire.MarshalJSONIndent(cdc, signed)
+	if err != nil {
+		return err
+	}
+	fmt.Println(string(output))
+	return nil
+}
+
+func verifyMessageCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "verify-message <file>",
+		Short: "Verify an off-chain message signed by \"keys sign-message\"",
+		Long: `Verify the signature of the signed message of the file. The signer
+is checked against the --address or --pubkey of the expected signer.`,
+		RunE: runVerifyMessageCmd,
+		Args: cobra.ExactArgs(1),
+	}
+	cmd.Flags().String(flagAddress, "", "Bech32 address of the expected signer")
+	cmd.Flags().String(flagPubKey, "", "Bech32 public key of the expected signer")
+	return cmd
+}
+
+func runVerifyMessageCmd(cmd *cobra.Command, args []string) error {
+	bz, err := ioutil.ReadFile(args[0])
+	if err != nil {
+		return err
+	}
+	var signed auth.StdSignedMessage
+	err = cdc.UnmarshalJSON(bz, &signed)
+	if err != nil {
+		return err
+	}
+
+	err = verifyMessage(signed, viper.GetString(flagAddress), viper.GetString(flagPubKey))
+	if err != nil {
+		return err
+	}
+	fmt.Printf("Valid signature of %s\n", signed.Signer)
+	return nil
+}
+
+// signMessage signs the off-chain message with the named key
+func signMessage(name, pass, message string) (signed auth.StdSignedMessage, err error) {
+	kb, err := GetKeyBase()
+	if err != nil {
+		return
+	}
+	info, err := kb.Get(name)
+	if err != nil {
+		return
+	}
+
+	signer := sdk.AccAddress(info.GetPubKey().Address())
+	sig, pub, err := kb.Sign(name, pass, auth.OffChainSignBytes(signer, []byte(message)))
+	if err != nil {
+		return
+	}
+	return auth.StdSignedMessage{
+		Message:   message,
+		Signer:    signer,
+		PubKey:    pub,
+		Signature: sig,
+	}, nil
+}
+
+// verifyMessage verifies the signed message and checks its signer is the
+// expected one, given by its bech32 address or pub key. At least one of them
+// is required, a signature alone only proves the message was signed by some
+// key.
+func verifyMessage(signed auth.StdSignedMessage, address, pubkey string) error {
+	if address == "" && pubkey == "" {
+		return fmt.Errorf("the expected signer must be given by its address or its pub key")
+	}
+	if address != "" {
+		addr, err := sdk.AccAddressFromBech32(address)
+		if err != nil {
+			return err
+		}
+		if !bytes.Equal(addr, signed.Signer) {
+			return fmt.Errorf("the message is signed by %s, not %s", signed.Signer, addr)
+		}
+	}
+	if pubkey != "" {
+		pub, err := sdk.GetAccPubKeyBech32(pubkey)
+		if err != nil {
+			return err
+		}
+		if signed.PubKey == nil || !pub.Equals(signed.PubKey) {
+			return fmt.Errorf("the message is not signed by the pub key %s", pubkey)
+		}
+	}
+	return signed.Verify()
+}
+
+///////////////////////////
+// REST
+
+// sign message request REST body
+type SignMessageBody struct {
+	Password string `json:"password"`
+	Message  string `json:"message"`
+}
+
+// sign message REST handler
+func SignMessageRequestHandler(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	name := vars["name"]
+	var m SignMessageBody
+
+	decoder := json.NewDecoder(r.Body)
+	err := decoder.Decode(&m)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(err.Error()))
+		return
+	}
+
+	signed, err := signMessage(name, m.Password, m.Message)
+	if err != nil {
+		w.WriteHeader(http.StatusUnauthorized)
+		w.Write([]byte(err.Error()))
+		return
+	}
+
+	output, err := wire.MarshalJSONIndent(cdc, signed)
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(err.Error()))
+		return
+	}
+	w.Write(output)
+}
+
+// verify message request REST body
+type VerifyMessageBody struct {
+	SignedMessage auth.StdSignedMessage `json:"signed_message"`
+	Address       string                `json:"address"`
+	PubKey        string                `json:"pub_key"`
+}
+
+// verify message REST response
+type VerifyMessageOutput struct {
+	Valid bool   `json:"valid"`
+	Error string `json:"error,omitempty"`
+}
+
+// verify message REST handler
+func VerifyMessageRequestHandler(w http.ResponseWriter, r *http.Request) {
+	var m VerifyMessageBody
+	body, err := ioutil.ReadAll(r.Body)
+	if err == nil {
+		err = cdc.UnmarshalJSON(body, &m)
+	}
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(err.Error()))
+		return
+	}
+
+	var out VerifyMessageOutput
+	err = verifyMessage(m.SignedMessage, m.Address, m.PubKey)
+	if err != nil {
+		out.Error = err.Error()
+	} else {
+		out.Valid = true
+	}
+
+	output, err := json.MarshalIndent(out, "", "  ")
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(err.Error()))
+		return
+	}
+	w.Write(output)
+}
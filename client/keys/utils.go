@@ -18,6 +18,10 @@ import (
 // KeyDBName is the directory under root where we store the keys
 const KeyDBName = "keys"
 
+// KeyFileDirName is the directory under the keys directory where the file
+// keyring backend stores the keys
+const KeyFileDirName = "files"
+
 // keybase is used to make GetKeyBase a singleton
 var keybase keys.Keybase
 
@@ -29,14 +33,29 @@ func GetKeyBase() (keys.Keybase, error) {
 	return GetKeyBaseFromDir(rootDir)
 }
 
-// initialize a keybase based on the configuration
+// initialize a keybase based on the configuration. The keyring backend flag
+// selects where the keys are kept, by default in a LevelDB database under
+// rootDir.
 func GetKeyBaseFromDir(rootDir string) (keys.Keybase, error) {
 	if keybase == nil {
-		db, err := dbm.NewGoLevelDB(KeyDBName, filepath.Join(rootDir, "keys"))
-		if err != nil {
-			return nil, err
+		switch backend := viper.GetString(client.FlagKeyringBackend); backend {
+		case "", client.KeyringBackendDB:
+			db, err := dbm.NewGoLevelDB(KeyDBName, filepath.Join(rootDir, "keys"))
+			if err != nil {
+				return nil, err
+			}
+			keybase = client.GetKeyBase(db)
+		case client.KeyringBackendFile:
+			keybase = keys.NewFileKeybase(filepath.Join(rootDir, "keys", KeyFileDirName))
+		case client.KeyringBackendRemote:
+			socket := viper.GetString(client.FlagKeyringSigner)
+			if socket == "" {
+				return nil, fmt.Errorf("the %s keyring backend requires the --%s socket", backend, client.FlagKeyringSigner)
+			}
+			keybase = keys.NewRemoteKeybase(socket)
+		default:
+			return nil, fmt.Errorf("unknown keyring backend %s", backend)
 		}
-		keybase = client.GetKeyBase(db)
 	}
 	return keybase, nil
 }
@@ -1,6 +1,9 @@
 package lcd
 
 import (
+	"errors"
+	"fmt"
+	"net"
 	"net/http"
 	"os"
 
@@ -9,6 +12,7 @@ import (
 	keys "github.com/cosmos/cosmos-sdk/client/keys"
 	rpc "github.com/cosmos/cosmos-sdk/client/rpc"
 	tx "github.com/cosmos/cosmos-sdk/client/tx"
+	crkeys "github.com/cosmos/cosmos-sdk/crypto/keys"
 	"github.com/cosmos/cosmos-sdk/wire"
 	auth "github.com/cosmos/cosmos-sdk/x/auth/client/rest"
 	bank "github.com/cosmos/cosmos-sdk/x/bank/client/rest"
@@ -19,6 +23,7 @@ import (
 	"github.com/gorilla/mux"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
+	"github.com/tendermint/tendermint/libs/cli"
 	cmn "github.com/tendermint/tendermint/libs/common"
 	"github.com/tendermint/tendermint/libs/log"
 	tmserver "github.com/tendermint/tendermint/rpc/lib/server"
@@ -31,25 +36,100 @@ func ServeCommand(cdc *wire.Codec) *cobra.Command {
 	flagListenAddr := "laddr"
 	flagCORS := "cors"
 	flagMaxOpenConnections := "max-open"
+	flagTLS := "tls"
+	flagTLSCert := "tls-cert"
+	flagTLSKey := "tls-key"
+	flagAPITokens := "api-tokens"
+	flagNoKeybase := "no-keybase"
 
 	cmd := &cobra.Command{
 		Use:   "rest-server",
 		Short: "Start LCD (light-client daemon), a local REST server",
+		Long: `Start LCD (light-client daemon), a local REST server.
+
+The routes using the keys of the keybase, like /keys and the tx routes signing
+with a key name and password, require an API token in an
+"Authorization: Bearer <token>" header. The tokens are read from the
+--api-tokens file, one per line, or else from a random token generated in
+<home>/lcd/api_token. Those routes are only served over TLS, or over plain HTTP
+on a loopback address. With --no-keybase they aren't served at all.
+
+The tx routes called with ?generate_only=true don't use the keybase and don't
+need a token. They return the unsigned tx and its sign bytes, and the tx signed
+by the client is broadcasted with POST /txs.
+
+The routes are documented at /swagger.`,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			listenAddr := viper.GetString(flagListenAddr)
-			handler := createHandler(cdc)
+			home := viper.GetString(cli.HomeFlag)
 			logger := log.NewTMLogger(log.NewSyncWriter(os.Stdout)).With("module", "rest-server")
 			maxOpen := viper.GetInt(flagMaxOpenConnections)
 
-			listener, err := tmserver.StartHTTPServer(
-				listenAddr, handler, logger,
-				tmserver.Config{MaxOpenConnections: maxOpen},
-			)
+			host, err := listenHost(listenAddr)
 			if err != nil {
 				return err
 			}
 
-			logger.Info("REST server started")
+			useTLS := viper.GetBool(flagTLS)
+			certFile := viper.GetString(flagTLSCert)
+			keyFile := viper.GetString(flagTLSKey)
+			if certFile != "" || keyFile != "" {
+				if certFile == "" || keyFile == "" {
+					return errors.New("both --tls-cert and --tls-key must be given")
+				}
+				useTLS = true
+			}
+			if useTLS && certFile == "" {
+				var fingerprint string
+				certFile, keyFile, fingerprint, err = selfSignedCert(home, []string{host, "localhost", "127.0.0.1"})
+				if err != nil {
+					return err
+				}
+				logger.Info("Using a self-signed certificate", "cert", certFile, "fingerprint", fingerprint)
+			}
+
+			cfg := handlerConfig{
+				noKeybase:   viper.GetBool(flagNoKeybase),
+				corsOrigins: parseCORSOrigins(viper.GetString(flagCORS)),
+			}
+			if !cfg.noKeybase {
+				if !useTLS && !isLoopback(host) {
+					return fmt.Errorf("refusing to serve the keybase over plain HTTP on %s, "+
+						"use --tls, a loopback address or --no-keybase", listenAddr)
+				}
+				if tokenFile := viper.GetString(flagAPITokens); tokenFile != "" {
+					cfg.apiTokens, err = loadAPITokens(tokenFile)
+					if err != nil {
+						return err
+					}
+				} else {
+					token, tokenFile, err := defaultAPIToken(home)
+					if err != nil {
+						return err
+					}
+					cfg.apiTokens = []string{token}
+					logger.Info("Keybase routes require the API token", "file", tokenFile)
+				}
+			}
+
+			handler := createHandler(cdc, cfg)
+			var listener net.Listener
+			if useTLS {
+				listener, err = tmserver.StartHTTPAndTLSServer(
+					listenAddr, handler, certFile, keyFile, logger,
+					tmserver.Config{MaxOpenConnections: maxOpen},
+				)
+			} else {
+				listener, err = tmserver.StartHTTPServer(
+					listenAddr, handler, logger,
+					tmserver.Config{MaxOpenConnections: maxOpen},
+				)
+			}
+			if err != nil {
+				return err
+			}
+
+			logger.Info("REST server started", "tls", useTLS, "keybase", !cfg.noKeybase)
 
 			// wait forever and cleanup
 			cmn.TrapSignal(func() {
@@ -62,37 +142,80 @@ func ServeCommand(cdc *wire.Codec) *cobra.Command {
 	}
 
 	cmd.Flags().String(flagListenAddr, "tcp://localhost:1317", "The address for the server to listen on")
-	cmd.Flags().String(flagCORS, "", "Set the domains that can make CORS requests (* for all)")
+	cmd.Flags().String(flagCORS, "", "Comma separated list of the origins that can make CORS requests (* for all)")
 	cmd.Flags().String(client.FlagChainID, "", "The chain ID to connect to")
 	cmd.Flags().String(client.FlagNode, "tcp://localhost:26657", "Address of the node to connect to")
 	cmd.Flags().Int(flagMaxOpenConnections, 1000, "The number of maximum open connections")
+	cmd.Flags().Bool(flagTLS, false, "Serve over TLS, with a self-signed certificate unless --tls-cert and --tls-key are given")
+	cmd.Flags().String(flagTLSCert, "", "Path to the PEM certificate to serve over TLS")
+	cmd.Flags().String(flagTLSKey, "", "Path to the PEM key of the TLS certificate")
+	cmd.Flags().String(flagAPITokens, "", "Path to a file of API tokens, one per line, allowed to use the keybase routes")
+	cmd.Flags().Bool(flagNoKeybase, false, "Don't serve the routes using the keybase, only accept txs signed by the clients")
 
 	return cmd
 }
 
-func createHandler(cdc *wire.Codec) http.Handler {
-	r := mux.NewRouter()
+// handlerConfig configures the routes served by the LCD and their protection
+type handlerConfig struct {
+	noKeybase   bool
+	apiTokens   []string
+	corsOrigins []string
+}
+
+func createHandler(cdc *wire.Codec, cfg handlerConfig) http.Handler {
+	r, kr := createRouters(cdc, cfg)
+	return corsHandler(cfg.corsOrigins, tokenAuthHandler(cfg.apiTokens, kr, r))
+}
 
-	kb, err := keys.GetKeyBase() //XXX
-	if err != nil {
-		panic(err)
-	}
+// createRouters returns the router of the public routes and the router of the
+// routes using the keybase, which is nil with noKeybase
+func createRouters(cdc *wire.Codec, cfg handlerConfig) (r *mux.Router, kr *mux.Router) {
+	r = mux.NewRouter()
 
 	ctx := context.NewCoreContextFromViper()
 
 	// TODO: make more functional? aka r = keys.RegisterRoutes(r)
 	r.HandleFunc("/version", CLIVersionRequestHandler).Methods("GET")
 	r.HandleFunc("/node_version", NodeVersionRequestHandler(ctx)).Methods("GET")
+	r.HandleFunc("/swagger", SwaggerUIRequestHandler).Methods("GET")
+	r.HandleFunc("/swagger/swagger.yaml", SwaggerSpecRequestHandler).Methods("GET")
+	r.HandleFunc("/swagger/swagger-ui.css", SwaggerUIAssetRequestHandler("swagger-ui.css")).Methods("GET")
+	r.HandleFunc("/swagger/swagger-ui-bundle.js", SwaggerUIAssetRequestHandler("swagger-ui-bundle.js")).Methods("GET")
 
-	keys.RegisterRoutes(r)
 	rpc.RegisterRoutes(ctx, r)
 	tx.RegisterRoutes(ctx, r, cdc)
 	auth.RegisterRoutes(ctx, r, cdc, "acc")
-	bank.RegisterRoutes(ctx, r, cdc, kb)
-	ibc.RegisterRoutes(ctx, r, cdc, kb)
-	stake.RegisterRoutes(ctx, r, cdc, kb)
-	slashing.RegisterRoutes(ctx, r, cdc, kb)
-	gov.RegisterRoutes(ctx, r, cdc)
+	bank.RegisterQueryRoutes(ctx, r, cdc, "supply")
+	stake.RegisterQueryRoutes(ctx, r, cdc)
+	slashing.RegisterQueryRoutes(ctx, r, cdc)
+	gov.RegisterQueryRoutes(ctx, r, cdc)
+
+	// registered without a keybase, the tx routes only generate unsigned txs,
+	// which doesn't need an API token
+	registerTxRoutes(ctx, r, cdc, nil)
+
+	// the routes touching the keybase are kept apart, to be served only to
+	// the requests carrying an API token
+	if !cfg.noKeybase {
+		kb, err := keys.GetKeyBase() //XXX
+		if err != nil {
+			panic(err)
+		}
+
+		kr = mux.NewRouter()
+		keys.RegisterRoutes(kr)
+		registerTxRoutes(ctx, kr, cdc, kb)
+	}
 
-	return r
+	return r, kr
+}
+
+// registerTxRoutes registers the tx routes of the modules, signing the txs
+// with the keybase or, if it is nil, only generating them
+func registerTxRoutes(ctx context.CoreContext, r *mux.Router, cdc *wire.Codec, kb crkeys.Keybase) {
+	bank.RegisterTxRoutes(ctx, r, cdc, kb)
+	ibc.RegisterRoutes(ctx, r, cdc, kb)
+	stake.RegisterTxRoutes(ctx, r, cdc, kb)
+	slashing.RegisterTxRoutes(ctx, r, cdc, kb)
+	gov.RegisterTxRoutes(ctx, r, cdc, kb)
 }
@@ -0,0 +1,289 @@
+package lcd
+
+import (
+	"bufio"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/hex"
+	"encoding/pem"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/pkg/errors"
+
+	"github.com/cosmos/cosmos-sdk/client/utils"
+)
+
+const (
+	// LCDDirName is the directory under the home of the client holding the
+	// default API token and the self-signed certificate of the LCD
+	LCDDirName = "lcd"
+
+	apiTokenFileName = "api_token"
+	certFileName     = "cert.pem"
+	keyFileName      = "key.pem"
+
+	// authScheme is the scheme of the Authorization header carrying an API token
+	authScheme = "Bearer "
+)
+
+// tokenAuthHandler requires a valid API token for the requests matching the
+// keybase routes, and hands every other request, including the ones only
+// generating a tx, to the public handler
+func tokenAuthHandler(tokens []string, keybaseRouter *mux.Router, public http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var match mux.RouteMatch
+		if keybaseRouter == nil || utils.HasGenerateOnlyArg(r) || !keybaseRouter.Match(r, &match) {
+			public.ServeHTTP(w, r)
+			return
+		}
+		if !validToken(tokens, r.Header.Get("Authorization")) {
+			w.Header().Set("WWW-Authenticate", `Bearer realm="lcd"`)
+			w.WriteHeader(http.StatusUnauthorized)
+			w.Write([]byte("a valid API token is required to use the keys of the LCD"))
+			return
+		}
+		keybaseRouter.ServeHTTP(w, r)
+	})
+}
+
+// validToken checks the Authorization header against the API tokens in
+// constant time
+func validToken(tokens []string, header string) bool {
+	if !strings.HasPrefix(header, authScheme) {
+		return false
+	}
+	given := []byte(strings.TrimSpace(strings.TrimPrefix(header, authScheme)))
+	valid := false
+	for _, token := range tokens {
+		if subtle.ConstantTimeCompare(given, []byte(token)) == 1 {
+			valid = true
+		}
+	}
+	return valid
+}
+
+// corsHandler allows the given origins, or any origin for "*", to make
+// requests to the LCD from a browser, and answers their preflight requests
+func corsHandler(origins []string, next http.Handler) http.Handler {
+	if len(origins) == 0 {
+		return next
+	}
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		origin := r.Header.Get("Origin")
+		if origin == "" || !allowedOrigin(origins, origin) {
+			next.ServeHTTP(w, r)
+			return
+		}
+		h := w.Header()
+		h.Set("Access-Control-Allow-Origin", origin)
+		h.Add("Vary", "Origin")
+		if r.Method == http.MethodOptions && r.Header.Get("Access-Control-Request-Method") != "" {
+			h.Set("Access-Control-Allow-Methods", "GET, POST, PUT, DELETE, OPTIONS")
+			h.Set("Access-Control-Allow-Headers", "Content-Type, Authorization")
+			h.Set("Access-Control-Max-Age", "600")
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		h.Set("Access-Control-Expose-Headers", utils.HeaderBlockHeight)
+		next.ServeHTTP(w, r)
+	})
+}
+
+func allowedOrigin(origins []string, origin string) bool {
+	for _, allowed := range origins {
+		if allowed == "*" || strings.EqualFold(allowed, origin) {
+			return true
+		}
+	}
+	return false
+}
+
+// parseCORSOrigins splits the comma separated value of the cors flag
+func parseCORSOrigins(flag string) (origins []string) {
+	for _, origin := range strings.Split(flag, ",") {
+		origin = strings.TrimSpace(origin)
+		if origin != "" {
+			origins = append(origins, origin)
+		}
+	}
+	return origins
+}
+
+// loadAPITokens reads the API tokens from a file holding one token per line.
+// Empty lines and lines starting with # are ignored.
+func loadAPITokens(file string) ([]string, error) {
+	f, err := os.Open(file)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var tokens []string
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		tokens = append(tokens, line)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	if len(tokens) == 0 {
+		return nil, fmt.Errorf("no API token in %s", file)
+	}
+	return tokens, nil
+}
+
+// defaultAPIToken returns the API token stored in the lcd directory of the
+// home, generating a random one the first time
+func defaultAPIToken(home string) (token string, file string, err error) {
+	file = filepath.Join(home, LCDDirName, apiTokenFileName)
+	if _, err = os.Stat(file); err == nil {
+		tokens, err := loadAPITokens(file)
+		if err != nil {
+			return "", file, err
+		}
+		return tokens[0], file, nil
+	}
+
+	bz := make([]byte, 32)
+	if _, err = rand.Read(bz); err != nil {
+		return "", file, err
+	}
+	token = hex.EncodeToString(bz)
+	if err = os.MkdirAll(filepath.Dir(file), 0700); err != nil {
+		return "", file, err
+	}
+	err = ioutil.WriteFile(file, []byte(token+"\n"), 0600)
+	return token, file, err
+}
+
+// selfSignedCert returns the paths of the self-signed certificate and key
+// stored in the lcd directory of the home, generating them the first time,
+// together with the SHA-256 fingerprint of the certificate
+func selfSignedCert(home string, hosts []string) (certFile, keyFile, fingerprint string, err error) {
+	dir := filepath.Join(home, LCDDirName)
+	certFile = filepath.Join(dir, certFileName)
+	keyFile = filepath.Join(dir, keyFileName)
+
+	if _, err = os.Stat(certFile); os.IsNotExist(err) {
+		if err = os.MkdirAll(dir, 0700); err != nil {
+			return
+		}
+		if err = generateSelfSignedCert(certFile, keyFile, hosts); err != nil {
+			return
+		}
+	}
+
+	fingerprint, err = certFingerprint(certFile)
+	return
+}
+
+// generateSelfSignedCert writes a new ECDSA P-256 certificate valid for the
+// hosts, and its key, in PEM format
+func generateSelfSignedCert(certFile, keyFile string, hosts []string) error {
+	priv, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return err
+	}
+	serial, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+	if err != nil {
+		return err
+	}
+
+	notBefore := time.Now()
+	template := x509.Certificate{
+		SerialNumber:          serial,
+		Subject:               pkix.Name{Organization: []string{"Cosmos SDK LCD"}},
+		NotBefore:             notBefore,
+		NotAfter:              notBefore.Add(365 * 24 * time.Hour),
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageKeyEncipherment,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+		BasicConstraintsValid: true,
+	}
+	for _, host := range hosts {
+		if ip := net.ParseIP(host); ip != nil {
+			template.IPAddresses = append(template.IPAddresses, ip)
+		} else {
+			template.DNSNames = append(template.DNSNames, host)
+		}
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, &template, &template, &priv.PublicKey, priv)
+	if err != nil {
+		return err
+	}
+	keyBytes, err := x509.MarshalECPrivateKey(priv)
+	if err != nil {
+		return err
+	}
+
+	err = ioutil.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyBytes}), 0600)
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0644)
+}
+
+// certFingerprint returns the SHA-256 fingerprint of a PEM certificate, to be
+// compared by the clients trusting a self-signed certificate
+func certFingerprint(certFile string) (string, error) {
+	bz, err := ioutil.ReadFile(certFile)
+	if err != nil {
+		return "", err
+	}
+	block, _ := pem.Decode(bz)
+	if block == nil || block.Type != "CERTIFICATE" {
+		return "", fmt.Errorf("no certificate in %s", certFile)
+	}
+	sum := sha256.Sum256(block.Bytes)
+	parts := make([]string, len(sum))
+	for i, b := range sum {
+		parts[i] = fmt.Sprintf("%02X", b)
+	}
+	return strings.Join(parts, ":"), nil
+}
+
+// listenHost returns the host of a listen address like tcp://localhost:1317
+func listenHost(listenAddr string) (string, error) {
+	addr := listenAddr
+	if strings.Contains(addr, "://") {
+		u, err := url.Parse(addr)
+		if err != nil {
+			return "", err
+		}
+		addr = u.Host
+	}
+	host, _, err := net.SplitHostPort(addr)
+	if err != nil {
+		return "", errors.Wrapf(err, "invalid listen address %s", listenAddr)
+	}
+	return host, nil
+}
+
+// isLoopback tells whether the host only accepts connections from the local
+// machine
+func isLoopback(host string) bool {
+	if host == "localhost" {
+		return true
+	}
+	ip := net.ParseIP(host)
+	return ip != nil && ip.IsLoopback()
+}
@@ -0,0 +1,168 @@
+package lcd
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTokenAuthHandler(t *testing.T) {
+	public := mux.NewRouter()
+	public.HandleFunc("/keys/seed", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("public"))
+	}).Methods("GET")
+	public.HandleFunc("/send", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("generated"))
+	}).Methods("POST")
+	kr := mux.NewRouter()
+	kr.HandleFunc("/keys", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("keybase"))
+	}).Methods("POST")
+	kr.HandleFunc("/send", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("signed"))
+	}).Methods("POST")
+	handler := tokenAuthHandler([]string{"a", "secret"}, kr, public)
+
+	cases := []struct {
+		method, path, auth string
+		code               int
+		body               string
+	}{
+		{"GET", "/keys/seed", "", http.StatusOK, "public"},
+		{"POST", "/keys", "", http.StatusUnauthorized, ""},
+		{"POST", "/keys", "Bearer wrong", http.StatusUnauthorized, ""},
+		{"POST", "/keys", "secret", http.StatusUnauthorized, ""},
+		{"POST", "/keys", "Bearer secret", http.StatusOK, "keybase"},
+		{"GET", "/keys", "", http.StatusNotFound, ""},
+		{"POST", "/send", "", http.StatusUnauthorized, ""},
+		{"POST", "/send", "Bearer a", http.StatusOK, "signed"},
+		{"POST", "/send?generate_only=true", "", http.StatusOK, "generated"},
+		{"POST", "/keys?generate_only=true", "", http.StatusNotFound, ""},
+	}
+	for i, tc := range cases {
+		req := httptest.NewRequest(tc.method, tc.path, nil)
+		if tc.auth != "" {
+			req.Header.Set("Authorization", tc.auth)
+		}
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		require.Equal(t, tc.code, rec.Code, "case %d", i)
+		if tc.body != "" {
+			require.Equal(t, tc.body, rec.Body.String(), "case %d", i)
+		}
+	}
+
+	// without keybase routes the requests only reach the public routes
+	handler = tokenAuthHandler(nil, nil, public)
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest("POST", "/keys", nil))
+	require.Equal(t, http.StatusNotFound, rec.Code)
+}
+
+func TestCORSHandler(t *testing.T) {
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("ok"))
+	})
+	handler := corsHandler(parseCORSOrigins("https://wallet.io, https://other.io"), next)
+
+	// allowed origin
+	req := httptest.NewRequest("GET", "/version", nil)
+	req.Header.Set("Origin", "https://wallet.io")
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	require.Equal(t, "https://wallet.io", rec.Header().Get("Access-Control-Allow-Origin"))
+	require.Equal(t, "ok", rec.Body.String())
+
+	// unknown origin
+	req = httptest.NewRequest("GET", "/version", nil)
+	req.Header.Set("Origin", "https://evil.io")
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	require.Empty(t, rec.Header().Get("Access-Control-Allow-Origin"))
+
+	// preflight
+	req = httptest.NewRequest("OPTIONS", "/keys", nil)
+	req.Header.Set("Origin", "https://other.io")
+	req.Header.Set("Access-Control-Request-Method", "POST")
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	require.Equal(t, http.StatusNoContent, rec.Code)
+	require.Contains(t, rec.Header().Get("Access-Control-Allow-Headers"), "Authorization")
+	require.Empty(t, rec.Body.String())
+
+	// wildcard
+	handler = corsHandler([]string{"*"}, next)
+	req = httptest.NewRequest("GET", "/version", nil)
+	req.Header.Set("Origin", "https://any.io")
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	require.Equal(t, "https://any.io", rec.Header().Get("Access-Control-Allow-Origin"))
+}
+
+func TestDefaultAPIToken(t *testing.T) {
+	home, err := ioutil.TempDir("", "lcd")
+	require.Nil(t, err)
+	defer os.RemoveAll(home)
+
+	token, file, err := defaultAPIToken(home)
+	require.Nil(t, err)
+	require.Len(t, token, 64)
+	fi, err := os.Stat(file)
+	require.Nil(t, err)
+	require.Equal(t, os.FileMode(0600), fi.Mode().Perm())
+
+	// the token is kept across restarts
+	again, _, err := defaultAPIToken(home)
+	require.Nil(t, err)
+	require.Equal(t, token, again)
+
+	// token files
+	tokenFile := filepath.Join(home, "tokens")
+	err = ioutil.WriteFile(tokenFile, []byte("# wallets\none\n\n two \n"), 0600)
+	require.Nil(t, err)
+	tokens, err := loadAPITokens(tokenFile)
+	require.Nil(t, err)
+	require.Equal(t, []string{"one", "two"}, tokens)
+}
+
+func TestSelfSignedCert(t *testing.T) {
+	home, err := ioutil.TempDir("", "lcd")
+	require.Nil(t, err)
+	defer os.RemoveAll(home)
+
+	certFile, keyFile, fingerprint, err := selfSignedCert(home, []string{"localhost", "127.0.0.1"})
+	require.Nil(t, err)
+	require.NotEmpty(t, fingerprint)
+	_, err = os.Stat(keyFile)
+	require.Nil(t, err)
+
+	// the certificate is generated once
+	certFile2, _, fingerprint2, err := selfSignedCert(home, []string{"localhost"})
+	require.Nil(t, err)
+	require.Equal(t, certFile, certFile2)
+	require.Equal(t, fingerprint, fingerprint2)
+}
+
+func TestListenHost(t *testing.T) {
+	host, err := listenHost("tcp://localhost:1317")
+	require.Nil(t, err)
+	require.Equal(t, "localhost", host)
+	require.True(t, isLoopback(host))
+
+	host, err = listenHost("tcp://0.0.0.0:1317")
+	require.Nil(t, err)
+	require.False(t, isLoopback(host))
+
+	host, err = listenHost("127.0.0.1:1317")
+	require.Nil(t, err)
+	require.True(t, isLoopback(host))
+
+	_, err = listenHost("tcp://localhost")
+	require.NotNil(t, err)
+}
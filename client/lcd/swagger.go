@@ -0,0 +1,64 @@
+package lcd
+
+import (
+	"net/http"
+	"path"
+)
+
+//go:generate go run swagger_ui_gen.go
+
+// swaggerUI loads the Swagger UI assets served by the LCD and points it at the
+// specification served next to them
+const swaggerUI = `<!DOCTYPE html>
+<html>
+<head>
+  <meta charset="utf-8">
+  <title>Cosmos SDK LCD</title>
+  <link rel="stylesheet" href="swagger/swagger-ui.css">
+</head>
+<body>
+  <div id="swagger-ui"></div>
+  <script src="swagger/swagger-ui-bundle.js"></script>
+  <script>
+    window.onload = function() {
+      window.ui = SwaggerUIBundle({
+        url: "swagger/swagger.yaml",
+        dom_id: "#swagger-ui"
+      });
+    };
+  </script>
+</body>
+</html>
+`
+
+// SwaggerUIRequestHandler serves the interactive documentation of the LCD
+func SwaggerUIRequestHandler(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.Write([]byte(swaggerUI))
+}
+
+// swaggerUIContentTypes are the content types of the Swagger UI assets, by
+// extension
+var swaggerUIContentTypes = map[string]string{
+	".css": "text/css; charset=utf-8",
+	".js":  "application/javascript; charset=utf-8",
+}
+
+// SwaggerUIAssetRequestHandler serves a Swagger UI asset vendored in
+// swagger-ui/
+func SwaggerUIAssetRequestHandler(name string) http.HandlerFunc {
+	asset, ok := swaggerUIAssets[name]
+	if !ok {
+		panic("unknown swagger UI asset " + name)
+	}
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", swaggerUIContentTypes[path.Ext(name)])
+		w.Write([]byte(asset))
+	}
+}
+
+// SwaggerSpecRequestHandler serves the OpenAPI specification of the LCD
+func SwaggerSpecRequestHandler(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/x-yaml")
+	w.Write([]byte(swaggerSpec))
+}
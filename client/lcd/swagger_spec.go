@@ -0,0 +1,1865 @@
+package lcd
+
+// swaggerSpec is the OpenAPI 2.0 specification of the routes served by the
+// LCD. TestSwaggerSpecCoversRoutes fails when a route is registered without
+// being specified here.
+const swaggerSpec = `swagger: '2.0'
+info:
+  version: '1.1.0'
+  title: Light client daemon to interface with Cosmos baseserver via REST
+  description: |
+    Specification for the LCD provided by "gaiacli advanced rest-server".
+
+    The routes using the keybase require an API token, see the kms security
+    definition. The tx routes called with generate_only return the unsigned
+    tx, to be signed by the client and broadcasted with POST /txs.
+
+    Int64 values encoded with amino, like the gas of a fee or the heights of
+    the stake and gov records, are strings. So are the amounts of coins and
+    the decimals.
+consumes:
+  - application/json
+produces:
+  - application/json
+
+securityDefinitions:
+  kms:
+    type: apiKey
+    in: header
+    name: Authorization
+    description: 'API token of the LCD, sent as "Bearer <token>". Required by the routes using the keybase, which are not served with --no-keybase'
+
+parameters:
+  height:
+    in: query
+    name: height
+    description: Height to serve the query at, the latest by default. The served height is returned in the X-Cosmos-Block-Height header
+    type: integer
+  page:
+    in: query
+    name: page
+    description: Page of the results, starting at 1
+    type: integer
+    default: 1
+  limit:
+    in: query
+    name: limit
+    description: Number of results per page
+    type: integer
+  generateOnly:
+    in: query
+    name: generate_only
+    description: Only generate the unsigned tx, without using the keybase nor an API token
+    type: boolean
+  address:
+    in: path
+    name: address
+    description: Account address in bech32 format
+    required: true
+    type: string
+  delegator:
+    in: path
+    name: delegator
+    description: Delegator address in bech32 format
+    required: true
+    type: string
+  validator:
+    in: path
+    name: validator
+    description: Validator owner address in bech32 format
+    required: true
+    type: string
+  proposalID:
+    in: path
+    name: proposalID
+    description: Proposal id
+    required: true
+    type: integer
+  keyName:
+    in: path
+    name: name
+    description: Key name
+    required: true
+    type: string
+  denom:
+    in: path
+    name: denom
+    description: Coin denomination
+    required: true
+    type: string
+
+paths:
+  /version:
+    get:
+      summary: Version of the light client daemon
+      description: Get the version of the LCD running locally to compare against expected
+      produces:
+        - text/plain
+      responses:
+        200:
+          description: Plaintext version i.e. "v0.5.0"
+  /node_version:
+    get:
+      summary: Version of the connected node
+      produces:
+        - text/plain
+      responses:
+        200:
+          description: Plaintext version of the app of the node
+        500:
+          description: The node couldn't be queried
+  /node_info:
+    get:
+      summary: Information about the connected node
+      responses:
+        200:
+          description: Node status
+          schema:
+            $ref: "#/definitions/NodeInfo"
+        500:
+          description: The node couldn't be queried
+  /syncing:
+    get:
+      summary: Syncing state of node
+      description: Get if the node is currently syning with other nodes
+      produces:
+        - text/plain
+      responses:
+        200:
+          description: '"true" or "false"'
+        500:
+          description: The node couldn't be queried
+  /swagger:
+    get:
+      summary: Interactive documentation of this specification
+      produces:
+        - text/html
+      responses:
+        200:
+          description: Swagger UI page
+  /swagger/swagger.yaml:
+    get:
+      summary: This specification
+      produces:
+        - application/x-yaml
+      responses:
+        200:
+          description: OpenAPI 2.0 specification of the LCD
+  /swagger/swagger-ui.css:
+    get:
+      summary: Stylesheet of the Swagger UI page
+      produces:
+        - text/css
+      responses:
+        200:
+          description: Swagger UI stylesheet
+  /swagger/swagger-ui-bundle.js:
+    get:
+      summary: Script of the Swagger UI page
+      produces:
+        - application/javascript
+      responses:
+        200:
+          description: Swagger UI bundle
+
+  /keys:
+    get:
+      summary: List of accounts stored locally
+      security:
+        - kms: []
+      responses:
+        200:
+          description: Array of accounts
+          schema:
+            type: array
+            items:
+              $ref: '#/definitions/KeyOutput'
+        500:
+          description: The keybase couldn't be read
+    post:
+      summary: Create a new account locally
+      security:
+        - kms: []
+      parameters:
+        - in: body
+          name: account
+          description: The account to create, from a new seed if none is given
+          required: true
+          schema:
+            type: object
+            required:
+              - name
+              - password
+            properties:
+              name:
+                type: string
+              password:
+                type: string
+              seed:
+                type: string
+      responses:
+        200:
+          description: The account created, with its seed
+          schema:
+            $ref: '#/definitions/KeyOutput'
+        400:
+          description: The body is malformed or the name or password is missing
+        409:
+          description: An account with this name already exists
+        500:
+          description: The account couldn't be created
+  /keys/seed:
+    get:
+      summary: Create a new seed to create a new account with
+      security:
+        - kms: []
+      produces:
+        - text/plain
+      responses:
+        200:
+          description: 24 word Seed
+  /keys/{name}:
+    parameters:
+      - $ref: "#/parameters/keyName"
+    get:
+      summary: Get a certain locally stored account
+      security:
+        - kms: []
+      responses:
+        200:
+          description: Locally stored account
+          schema:
+            $ref: "#/definitions/KeyOutput"
+        404:
+          description: Account is not available
+        500:
+          description: The account couldn't be read
+    put:
+      summary: Update the password for this account in the KMS
+      security:
+        - kms: []
+      parameters:
+        - in: body
+          name: account
+          description: The new and old password
+          required: true
+          schema:
+            type: object
+            required:
+              - new_password
+              - old_password
+            properties:
+              new_password:
+                type: string
+              old_password:
+                type: string
+      responses:
+        200:
+          description: Updated password
+        400:
+          description: The body is malformed
+        401:
+          description: Password is wrong
+        500:
+          description: The keybase couldn't be read
+    delete:
+      summary: Remove an account
+      security:
+        - kms: []
+      parameters:
+        - in: body
+          name: account
+          description: The password of the account to remove from the KMS
+          required: true
+          schema:
+            type: object
+            required:
+              - password
+            properties:
+              password:
+                type: string
+      responses:
+        200:
+          description: Removed account
+        400:
+          description: The body is malformed
+        500:
+          description: Password is wrong or the keybase couldn't be read
+  /keys/{name}/sign-message:
+    parameters:
+      - $ref: "#/parameters/keyName"
+    post:
+      summary: Sign an off-chain message
+      description: The message is signed within a sign doc for the off-chain chain ID, which can never be valid as a tx.
+      security:
+        - kms: []
+      parameters:
+        - in: body
+          name: message
+          required: true
+          schema:
+            type: object
+            required:
+              - password
+              - message
+            properties:
+              password:
+                type: string
+              message:
+                type: string
+      responses:
+        200:
+          description: The signed message
+          schema:
+            $ref: "#/definitions/SignedMessage"
+        400:
+          description: The body is malformed
+        401:
+          description: The key or password is wrong
+        500:
+          description: The signed message couldn't be encoded
+  /keys/verify-message:
+    post:
+      summary: Verify a signed off-chain message
+      security:
+        - kms: []
+      parameters:
+        - in: body
+          name: signed_message
+          required: true
+          schema:
+            type: object
+            required:
+              - signed_message
+            properties:
+              signed_message:
+                $ref: "#/definitions/SignedMessage"
+              address:
+                type: string
+                description: Expected signer address in bech32 format
+              pub_key:
+                type: string
+                description: Expected signer public key in bech32 format
+      responses:
+        200:
+          description: Whether the signature is valid
+          schema:
+            type: object
+            required:
+              - valid
+            properties:
+              valid:
+                type: boolean
+              error:
+                type: string
+        400:
+          description: The body is malformed
+        500:
+          description: The result couldn't be encoded
+
+  /accounts:
+    get:
+      summary: List the accounts
+      parameters:
+        - $ref: "#/parameters/page"
+        - $ref: "#/parameters/limit"
+        - $ref: "#/parameters/height"
+      responses:
+        200:
+          description: A page of the accounts, in the order of their addresses
+          schema:
+            type: array
+            items:
+              $ref: "#/definitions/Account"
+        400:
+          description: The page, the limit or the height is invalid
+        404:
+          description: The height is not available
+        500:
+          description: The node couldn't be queried
+  /accounts/{address}:
+    parameters:
+      - $ref: "#/parameters/address"
+    get:
+      summary: Get the account information on blockchain
+      parameters:
+        - $ref: "#/parameters/height"
+      responses:
+        200:
+          description: Account information on the blockchain
+          schema:
+            $ref: "#/definitions/Account"
+        204:
+          description: There is no account with this address
+        400:
+          description: The address or the height is invalid
+        404:
+          description: The height is not available
+        500:
+          description: The node couldn't be queried
+  /accounts/{address}/txs:
+    parameters:
+      - $ref: "#/parameters/address"
+    get:
+      summary: Search the txs of an account
+      description: Lists the txs spending coins from or adding coins to the account, found by its coin_spent and coin_received events, in the order they were committed. Only the first 10000 txs can be listed.
+      parameters:
+        - in: query
+          name: direction
+          description: List only the txs where the account "sent" or "received" coins
+          type: string
+          enum:
+            - sent
+            - received
+        - $ref: "#/parameters/page"
+        - $ref: "#/parameters/limit"
+      responses:
+        200:
+          description: A page of the txs of the account
+          schema:
+            type: array
+            items:
+              $ref: "#/definitions/TxInfo"
+        400:
+          description: The address, the direction or the page is invalid, or the page is past the first 10000 txs
+        500:
+          description: The node couldn't be queried
+  /accounts/{address}/send:
+    parameters:
+      - $ref: "#/parameters/address"
+    post:
+      summary: Send coins (build -> sign -> send)
+      description: With generate_only the tx isn't signed and no API token is needed. The sender is given by its address instead of a key name and password, and the unsigned tx is returned with its sign bytes.
+      security:
+        - kms: []
+      parameters:
+        - $ref: "#/parameters/generateOnly"
+        - in: body
+          name: send
+          required: true
+          schema:
+            allOf:
+              - $ref: "#/definitions/SignReq"
+              - type: object
+                properties:
+                  amount:
+                    $ref: "#/definitions/Coins"
+                  from:
+                    type: string
+                    description: Sender address in bech32 format, used with generate_only
+      responses:
+        200:
+          description: The committed tx, or the unsigned tx with generate_only
+          schema:
+            $ref: "#/definitions/TxRouteResult"
+        400:
+          description: The body is malformed or the keybase is disabled
+        401:
+          description: The key or password is wrong
+        500:
+          description: The tx failed
+  /ibc/{destchain}/{address}/send:
+    parameters:
+      - in: path
+        name: destchain
+        description: Destination chain ID
+        required: true
+        type: string
+      - $ref: "#/parameters/address"
+    post:
+      summary: Transfer coins to an account of another chain
+      security:
+        - kms: []
+      parameters:
+        - $ref: "#/parameters/generateOnly"
+        - in: body
+          name: transfer
+          required: true
+          schema:
+            allOf:
+              - $ref: "#/definitions/SignReq"
+              - type: object
+                properties:
+                  amount:
+                    $ref: "#/definitions/Coins"
+                  src_chain_id:
+                    type: string
+                  from:
+                    type: string
+                    description: Sender address in bech32 format, used with generate_only
+      responses:
+        200:
+          description: The committed tx, or the unsigned tx with generate_only
+          schema:
+            $ref: "#/definitions/TxRouteResult"
+        400:
+          description: The body is malformed or the keybase is disabled
+        401:
+          description: The key or password is wrong
+        500:
+          description: The tx failed
+
+  /blocks/latest:
+    get:
+      summary: Get the latest block
+      responses:
+        200:
+          description: The latest block
+          schema:
+            $ref: "#/definitions/Block"
+        500:
+          description: The node couldn't be queried
+  /blocks/{height}:
+    parameters:
+      - in: path
+        name: height
+        description: Block height
+        required: true
+        type: integer
+    get:
+      summary: Get a block at a certain height
+      responses:
+        200:
+          description: The block at a specific height
+          schema:
+            $ref: "#/definitions/Block"
+        400:
+          description: The height is invalid
+        404:
+          description: Block at height is not available
+  /validatorsets/latest:
+    get:
+      summary: Get the latest validator set
+      responses:
+        200:
+          description: The validator set at the latest block height
+          schema:
+            $ref: "#/definitions/ValidatorSet"
+        500:
+          description: The node couldn't be queried
+  /validatorsets/{height}:
+    parameters:
+      - in: path
+        name: height
+        description: Block height
+        required: true
+        type: integer
+    get:
+      summary: Get a validator set a certain height
+      responses:
+        200:
+          description: The validator set at a specific block height
+          schema:
+            $ref: "#/definitions/ValidatorSet"
+        400:
+          description: The height is invalid
+        404:
+          description: Block at height not available
+
+  /txs:
+    get:
+      summary: Search txs by tag
+      parameters:
+        - in: query
+          name: tag
+          description: Tag of an event attribute, like transfer.recipient='cosmosaccaddr1...'
+          required: true
+          type: string
+        - $ref: "#/parameters/page"
+        - $ref: "#/parameters/limit"
+      responses:
+        200:
+          description: A page of the txs matching the tag
+          schema:
+            type: array
+            items:
+              $ref: "#/definitions/TxInfo"
+        400:
+          description: The tag or the page is invalid
+        500:
+          description: The node couldn't be queried
+    post:
+      summary: Broadcast a signed Tx
+      description: Broadcasts a Tx signed by the client, e.g. by a browser wallet or a hardware signer, usually generated by a tx route with generate_only. It is also served with --no-keybase.
+      parameters:
+        - in: body
+          name: tx
+          required: true
+          schema:
+            type: object
+            required:
+              - tx
+            properties:
+              tx:
+                $ref: "#/definitions/StdTx"
+              mode:
+                type: string
+                enum: [commit, sync, async]
+                default: commit
+                description: Wait for the Tx to be committed, to pass CheckTx, or not at all
+      responses:
+        200:
+          description: The result of the broadcast in the requested mode, a BroadcastTxCommitResult in the commit mode
+          schema:
+            $ref: "#/definitions/BroadcastTxResult"
+        400:
+          description: The Tx is malformed or not signed, or the mode is invalid
+        500:
+          description: The Tx failed or couldn't be broadcasted
+  /txs/{hash}:
+    parameters:
+      - in: path
+        name: hash
+        description: Tx hash
+        required: true
+        type: string
+    get:
+      summary: Get a Tx by hash
+      responses:
+        200:
+          description: Tx with the provided hash
+          schema:
+            $ref: "#/definitions/TxInfo"
+        500:
+          description: Tx not available for provided hash
+
+  /supply:
+    get:
+      summary: Total supply of every denomination
+      parameters:
+        - $ref: "#/parameters/height"
+      responses:
+        200:
+          description: The supply of the coins
+          schema:
+            $ref: "#/definitions/Coins"
+        400:
+          description: The height is invalid
+        404:
+          description: The height is not available
+        500:
+          description: The node couldn't be queried
+  /supply/{denom}:
+    parameters:
+      - $ref: "#/parameters/denom"
+    get:
+      summary: Total supply of a denomination
+      parameters:
+        - $ref: "#/parameters/height"
+      responses:
+        200:
+          description: The supply of the coin
+          schema:
+            $ref: "#/definitions/Coin"
+        400:
+          description: The height is invalid
+        404:
+          description: The height is not available
+        500:
+          description: The node couldn't be queried
+  /denoms/metadata:
+    get:
+      summary: Metadata of every registered denomination
+      parameters:
+        - $ref: "#/parameters/height"
+      responses:
+        200:
+          description: The denom metadata
+          schema:
+            type: array
+            items:
+              $ref: "#/definitions/DenomMetadata"
+        400:
+          description: The height is invalid
+        404:
+          description: The height is not available
+        500:
+          description: The node couldn't be queried
+  /denoms/{denom}/metadata:
+    parameters:
+      - $ref: "#/parameters/denom"
+    get:
+      summary: Metadata of a denomination
+      parameters:
+        - $ref: "#/parameters/height"
+      responses:
+        200:
+          description: The denom metadata
+          schema:
+            $ref: "#/definitions/DenomMetadata"
+        400:
+          description: The height is invalid
+        404:
+          description: The denomination isn't registered or the height is not available
+        500:
+          description: The node couldn't be queried
+
+  /stake/{delegator}/delegation/{validator}:
+    parameters:
+      - $ref: "#/parameters/delegator"
+      - $ref: "#/parameters/validator"
+    get:
+      summary: Get the delegation of a delegator to a validator
+      parameters:
+        - $ref: "#/parameters/height"
+      responses:
+        200:
+          description: The delegation
+          schema:
+            $ref: "#/definitions/Delegation"
+        204:
+          description: There is no such delegation
+        400:
+          description: An address or the height is invalid
+        404:
+          description: The height is not available
+        500:
+          description: The node couldn't be queried
+  /stake/{delegator}/ubd/{validator}:
+    parameters:
+      - $ref: "#/parameters/delegator"
+      - $ref: "#/parameters/validator"
+    get:
+      summary: Get the unbonding delegation of a delegator from a validator
+      parameters:
+        - $ref: "#/parameters/height"
+      responses:
+        200:
+          description: The unbonding delegation
+          schema:
+            $ref: "#/definitions/UnbondingDelegation"
+        204:
+          description: There is no such unbonding delegation
+        400:
+          description: An address or the height is invalid
+        404:
+          description: The height is not available
+        500:
+          description: The node couldn't be queried
+  /stake/{delegator}/red/{validator_src}/{validator_dst}:
+    parameters:
+      - $ref: "#/parameters/delegator"
+      - in: path
+        name: validator_src
+        description: Source validator owner address in bech32 format
+        required: true
+        type: string
+      - in: path
+        name: validator_dst
+        description: Destination validator owner address in bech32 format
+        required: true
+        type: string
+    get:
+      summary: Get a redelegation of a delegator
+      parameters:
+        - $ref: "#/parameters/height"
+      responses:
+        200:
+          description: The redelegation
+          schema:
+            $ref: "#/definitions/Redelegation"
+        204:
+          description: There is no such redelegation
+        400:
+          description: An address or the height is invalid
+        404:
+          description: The height is not available
+        500:
+          description: The node couldn't be queried
+  /stake/{delegator}/delegations:
+    parameters:
+      - $ref: "#/parameters/delegator"
+    get:
+      summary: List the delegations of a delegator
+      parameters:
+        - $ref: "#/parameters/page"
+        - $ref: "#/parameters/limit"
+        - $ref: "#/parameters/height"
+      responses:
+        200:
+          description: A page of the delegations
+          schema:
+            type: array
+            items:
+              $ref: "#/definitions/Delegation"
+        400:
+          description: The address, the page or the height is invalid
+        404:
+          description: The height is not available
+        500:
+          description: The node couldn't be queried
+  /stake/{delegator}/ubds:
+    parameters:
+      - $ref: "#/parameters/delegator"
+    get:
+      summary: List the unbonding delegations of a delegator
+      parameters:
+        - $ref: "#/parameters/page"
+        - $ref: "#/parameters/limit"
+        - $ref: "#/parameters/height"
+      responses:
+        200:
+          description: A page of the unbonding delegations
+          schema:
+            type: array
+            items:
+              $ref: "#/definitions/UnbondingDelegation"
+        400:
+          description: The address, the page or the height is invalid
+        404:
+          description: The height is not available
+        500:
+          description: The node couldn't be queried
+  /stake/{delegator}/reds:
+    parameters:
+      - $ref: "#/parameters/delegator"
+    get:
+      summary: List the redelegations of a delegator
+      parameters:
+        - $ref: "#/parameters/page"
+        - $ref: "#/parameters/limit"
+        - $ref: "#/parameters/height"
+      responses:
+        200:
+          description: A page of the redelegations
+          schema:
+            type: array
+            items:
+              $ref: "#/definitions/Redelegation"
+        400:
+          description: The address, the page or the height is invalid
+        404:
+          description: The height is not available
+        500:
+          description: The node couldn't be queried
+  /stake/validators:
+    get:
+      summary: List the validators
+      parameters:
+        - in: query
+          name: status
+          description: List only the validators with this status
+          type: string
+          enum:
+            - Bonded
+            - Unbonding
+            - Unbonded
+        - $ref: "#/parameters/page"
+        - $ref: "#/parameters/limit"
+        - $ref: "#/parameters/height"
+      responses:
+        200:
+          description: A page of the validators, in the order of their owner addresses
+          schema:
+            type: array
+            items:
+              $ref: "#/definitions/Validator"
+        204:
+          description: There are no validators on the page
+        400:
+          description: The status, the page or the height is invalid
+        404:
+          description: The height is not available
+        500:
+          description: The node couldn't be queried
+  /stake/delegations:
+    post:
+      summary: Delegate, unbond and redelegate
+      description: Every msg is signed and broadcasted in its own tx, with increasing sequences. With generate_only a list of the unsigned txs is returned.
+      security:
+        - kms: []
+      parameters:
+        - $ref: "#/parameters/generateOnly"
+        - in: body
+          name: delegations
+          required: true
+          schema:
+            allOf:
+              - $ref: "#/definitions/SignReq"
+              - type: object
+                properties:
+                  delegations:
+                    type: array
+                    items:
+                      type: object
+                      properties:
+                        delegator_addr:
+                          $ref: "#/definitions/Address"
+                        validator_addr:
+                          $ref: "#/definitions/ValidatorAddress"
+                        delegation:
+                          $ref: "#/definitions/Coin"
+                  begin_unbondings:
+                    type: array
+                    items:
+                      type: object
+                      properties:
+                        delegator_addr:
+                          $ref: "#/definitions/Address"
+                        validator_addr:
+                          $ref: "#/definitions/ValidatorAddress"
+                        shares:
+                          $ref: "#/definitions/Dec"
+                  complete_unbondings:
+                    type: array
+                    items:
+                      type: object
+                      properties:
+                        delegator_addr:
+                          $ref: "#/definitions/Address"
+                        validator_addr:
+                          $ref: "#/definitions/ValidatorAddress"
+                  begin_redelegates:
+                    type: array
+                    items:
+                      type: object
+                      properties:
+                        delegator_addr:
+                          $ref: "#/definitions/Address"
+                        validator_src_addr:
+                          $ref: "#/definitions/ValidatorAddress"
+                        validator_dst_addr:
+                          $ref: "#/definitions/ValidatorAddress"
+                        shares:
+                          $ref: "#/definitions/Dec"
+                  complete_redelegates:
+                    type: array
+                    items:
+                      type: object
+                      properties:
+                        delegator_addr:
+                          $ref: "#/definitions/Address"
+                        validator_src_addr:
+                          $ref: "#/definitions/ValidatorAddress"
+                        validator_dst_addr:
+                          $ref: "#/definitions/ValidatorAddress"
+      responses:
+        200:
+          description: The committed txs, or the unsigned txs with generate_only
+          schema:
+            type: array
+            items:
+              $ref: "#/definitions/TxRouteResult"
+        400:
+          description: The body is malformed or the keybase is disabled
+        401:
+          description: The key or password is wrong, or a delegator isn't the key
+        500:
+          description: An address is invalid or a tx failed
+
+  /slashing/signing_info/{validator}:
+    parameters:
+      - in: path
+        name: validator
+        description: Validator public key in bech32 format
+        required: true
+        type: string
+    get:
+      summary: Get the signing info of a validator
+      responses:
+        200:
+          description: The signing info
+          schema:
+            $ref: "#/definitions/SigningInfo"
+        204:
+          description: The validator has no signing info
+        400:
+          description: The public key is invalid
+        500:
+          description: The node couldn't be queried
+  /slashing/unrevoke:
+    post:
+      summary: Unrevoke a revoked validator
+      security:
+        - kms: []
+      parameters:
+        - $ref: "#/parameters/generateOnly"
+        - in: body
+          name: unrevoke
+          required: true
+          schema:
+            allOf:
+              - $ref: "#/definitions/SignReq"
+              - type: object
+                properties:
+                  validator_addr:
+                    $ref: "#/definitions/ValidatorAddress"
+      responses:
+        200:
+          description: The committed tx, or the unsigned tx with generate_only
+          schema:
+            $ref: "#/definitions/TxRouteResult"
+        400:
+          description: The body is malformed or the keybase is disabled
+        401:
+          description: The key or password is wrong, or the validator isn't the key
+        500:
+          description: The validator address is invalid or the tx failed
+
+  /gov/proposals:
+    get:
+      summary: Query proposals
+      parameters:
+        - in: query
+          name: voter
+          description: List only the proposals voted on by this address
+          type: string
+        - in: query
+          name: depositer
+          description: List only the proposals deposited on by this address
+          type: string
+        - in: query
+          name: status
+          description: List only the proposals with this status
+          type: string
+          enum:
+            - DepositPeriod
+            - VotingPeriod
+            - Passed
+            - Rejected
+        - $ref: "#/parameters/page"
+        - $ref: "#/parameters/limit"
+        - $ref: "#/parameters/height"
+      responses:
+        200:
+          description: A page of the proposals
+          schema:
+            type: array
+            items:
+              $ref: "#/definitions/Proposal"
+        400:
+          description: A filter, the page or the height is invalid
+        404:
+          description: The height is not available
+        500:
+          description: The node couldn't be queried
+    post:
+      summary: Submit a proposal
+      security:
+        - kms: []
+      parameters:
+        - $ref: "#/parameters/generateOnly"
+        - in: body
+          name: proposal
+          required: true
+          schema:
+            type: object
+            properties:
+              base_req:
+                $ref: "#/definitions/SignReq"
+              title:
+                type: string
+              description:
+                type: string
+              proposal_type:
+                type: string
+                enum:
+                  - Text
+                  - ParameterChange
+                  - SoftwareUpgrade
+                  - DenomMetadata
+              proposer:
+                $ref: "#/definitions/Address"
+              initial_deposit:
+                $ref: "#/definitions/Coins"
+      responses:
+        200:
+          description: The committed tx, or the unsigned tx with generate_only
+          schema:
+            $ref: "#/definitions/TxRouteResult"
+        400:
+          description: The body or the msg is malformed, or the keybase is disabled
+        401:
+          description: The key, password or sign parameters are wrong
+        500:
+          description: The tx failed
+  /gov/proposals/{proposalID}:
+    parameters:
+      - $ref: "#/parameters/proposalID"
+    get:
+      summary: Query a proposal
+      parameters:
+        - $ref: "#/parameters/height"
+      responses:
+        200:
+          description: The proposal
+          schema:
+            $ref: "#/definitions/Proposal"
+        400:
+          description: The proposal id or the height is invalid
+        404:
+          description: The height is not available
+        500:
+          description: The node couldn't be queried
+  /gov/proposals/{proposalID}/deposits:
+    parameters:
+      - $ref: "#/parameters/proposalID"
+    get:
+      summary: List the deposits on a proposal
+      parameters:
+        - $ref: "#/parameters/page"
+        - $ref: "#/parameters/limit"
+        - $ref: "#/parameters/height"
+      responses:
+        200:
+          description: A page of the deposits
+          schema:
+            type: array
+            items:
+              $ref: "#/definitions/Deposit"
+        400:
+          description: The proposal id, the page or the height is invalid
+        404:
+          description: The height is not available
+        500:
+          description: The node couldn't be queried
+    post:
+      summary: Deposit on a proposal
+      security:
+        - kms: []
+      parameters:
+        - $ref: "#/parameters/generateOnly"
+        - in: body
+          name: deposit
+          required: true
+          schema:
+            type: object
+            properties:
+              base_req:
+                $ref: "#/definitions/SignReq"
+              depositer:
+                $ref: "#/definitions/Address"
+              amount:
+                $ref: "#/definitions/Coins"
+      responses:
+        200:
+          description: The committed tx, or the unsigned tx with generate_only
+          schema:
+            $ref: "#/definitions/TxRouteResult"
+        400:
+          description: The body or the msg is malformed, or the keybase is disabled
+        401:
+          description: The key, password or sign parameters are wrong
+        500:
+          description: The tx failed
+  /gov/proposals/{proposalID}/deposits/{depositer}:
+    parameters:
+      - $ref: "#/parameters/proposalID"
+      - in: path
+        name: depositer
+        description: Depositer address in bech32 format
+        required: true
+        type: string
+    get:
+      summary: Query a deposit on a proposal
+      parameters:
+        - $ref: "#/parameters/height"
+      responses:
+        200:
+          description: The deposit
+          schema:
+            $ref: "#/definitions/Deposit"
+        400:
+          description: The proposal id, the address or the height is invalid
+        404:
+          description: The height is not available
+        500:
+          description: The node couldn't be queried
+  /gov/proposals/{proposalID}/votes:
+    parameters:
+      - $ref: "#/parameters/proposalID"
+    get:
+      summary: List the votes on a proposal
+      parameters:
+        - $ref: "#/parameters/page"
+        - $ref: "#/parameters/limit"
+        - $ref: "#/parameters/height"
+      responses:
+        200:
+          description: A page of the votes
+          schema:
+            type: array
+            items:
+              $ref: "#/definitions/Vote"
+        400:
+          description: The proposal id, the page or the height is invalid
+        404:
+          description: The height is not available
+        500:
+          description: The node couldn't be queried
+    post:
+      summary: Vote on a proposal
+      security:
+        - kms: []
+      parameters:
+        - $ref: "#/parameters/generateOnly"
+        - in: body
+          name: vote
+          required: true
+          schema:
+            type: object
+            properties:
+              base_req:
+                $ref: "#/definitions/SignReq"
+              voter:
+                $ref: "#/definitions/Address"
+              option:
+                type: string
+                enum:
+                  - "Yes"
+                  - Abstain
+                  - "No"
+                  - NoWithVeto
+      responses:
+        200:
+          description: The committed tx, or the unsigned tx with generate_only
+          schema:
+            $ref: "#/definitions/TxRouteResult"
+        400:
+          description: The body or the msg is malformed, or the keybase is disabled
+        401:
+          description: The key, password or sign parameters are wrong
+        500:
+          description: The tx failed
+  /gov/proposals/{proposalID}/votes/{voter}:
+    parameters:
+      - $ref: "#/parameters/proposalID"
+      - in: path
+        name: voter
+        description: Voter address in bech32 format
+        required: true
+        type: string
+    get:
+      summary: Query a vote on a proposal
+      parameters:
+        - $ref: "#/parameters/height"
+      responses:
+        200:
+          description: The vote
+          schema:
+            $ref: "#/definitions/Vote"
+        400:
+          description: The proposal id, the address or the height is invalid
+        404:
+          description: The height is not available
+        500:
+          description: The node couldn't be queried
+  /gov/proposals/{proposalID}/tally:
+    parameters:
+      - $ref: "#/parameters/proposalID"
+    get:
+      summary: Tally the votes on a proposal
+      responses:
+        200:
+          description: The current tally, or the final one of a finished proposal
+          schema:
+            $ref: "#/definitions/TallyResult"
+        400:
+          description: The proposal id is invalid
+        500:
+          description: The node couldn't be queried
+  /gov/params:
+    get:
+      summary: Query the governance params
+      parameters:
+        - $ref: "#/parameters/height"
+      responses:
+        200:
+          description: The deposit, voting and tallying procedures
+          schema:
+            $ref: "#/definitions/GovParams"
+        400:
+          description: The height is invalid
+        404:
+          description: The height is not available
+        500:
+          description: The node couldn't be queried
+
+definitions:
+  Address:
+    type: string
+    description: bech32 encoded account address
+    example: cosmosaccaddr1zgnkwr7eyyv643dllwfpdwensmgdtz89yu73zq
+  ValidatorAddress:
+    type: string
+    description: bech32 encoded account address of the validator owner
+    example: cosmosaccaddr1zgnkwr7eyyv643dllwfpdwensmgdtz89yu73zq
+  ConsAddress:
+    type: string
+    description: bech32 encoded consensus address
+    example: cosmosconsaddr1zgnkwr7eyyv643dllwfpdwensmgdtz89yu73zq
+  PubKey:
+    type: string
+    description: bech32 encoded public key
+    example: cosmosaccpub1addwnpepqt7ry3jaddhvm0l4mz7vhvq0y8azr8v5gxlmlr
+  ValidatorPubKey:
+    type: string
+    description: bech32 encoded public key
+    example: cosmosvalpub1zcjduepq0vu2zgkgk49efa0nqwzndanq5m4c7pa3u4apz4g2r9gspqg6g9cs3k9cuf
+  Amino:
+    type: object
+    description: Value of a registered amino type, like a public key, a signature or a msg
+    required:
+      - type
+      - value
+    properties:
+      type:
+        type: string
+        example: tendermint/PubKeySecp256k1
+      value: {}
+  Int64:
+    type: string
+    description: int64 encoded with amino
+    example: "100"
+  Dec:
+    type: string
+    description: decimal
+    example: "1.0000000000"
+  Hash:
+    type: string
+    example: EE5F3404034C524501629B56E0DDC38FAD651F04
+  Coin:
+    type: object
+    required:
+      - denom
+      - amount
+    properties:
+      denom:
+        type: string
+        example: steak
+      amount:
+        type: string
+        example: "50"
+  Coins:
+    type: array
+    items:
+      $ref: "#/definitions/Coin"
+  SignReq:
+    type: object
+    description: The parameters of the tx to sign. The name and password aren't needed with generate_only
+    properties:
+      name:
+        type: string
+      password:
+        type: string
+      chain_id:
+        type: string
+      account_number:
+        $ref: "#/definitions/Int64"
+      sequence:
+        $ref: "#/definitions/Int64"
+      gas:
+        $ref: "#/definitions/Int64"
+  StdFee:
+    type: object
+    required:
+      - amount
+      - gas
+    properties:
+      amount:
+        $ref: "#/definitions/Coins"
+      gas:
+        $ref: "#/definitions/Int64"
+  StdSignature:
+    type: object
+    properties:
+      pub_key:
+        $ref: "#/definitions/Amino"
+      signature:
+        $ref: "#/definitions/Amino"
+      account_number:
+        $ref: "#/definitions/Int64"
+      sequence:
+        $ref: "#/definitions/Int64"
+  StdTx:
+    type: object
+    required:
+      - msg
+      - fee
+      - memo
+    properties:
+      msg:
+        type: array
+        items:
+          $ref: "#/definitions/Amino"
+      fee:
+        $ref: "#/definitions/StdFee"
+      signatures:
+        type: array
+        items:
+          $ref: "#/definitions/StdSignature"
+      memo:
+        type: string
+  GeneratedTx:
+    type: object
+    required:
+      - tx
+      - sign_bytes
+    properties:
+      tx:
+        $ref: "#/definitions/StdTx"
+      sign_bytes:
+        type: string
+        description: The canonical JSON to sign
+  TxResult:
+    type: object
+    properties:
+      code:
+        type: integer
+      data:
+        type: string
+      log:
+        type: string
+      gas_wanted:
+        $ref: "#/definitions/Int64"
+      gas_used:
+        $ref: "#/definitions/Int64"
+      tags:
+        type: array
+        items:
+          type: object
+  BroadcastTxResult:
+    type: object
+    properties:
+      code:
+        type: integer
+      data:
+        type: string
+      log:
+        type: string
+      hash:
+        $ref: "#/definitions/Hash"
+  BroadcastTxCommitResult:
+    type: object
+    properties:
+      check_tx:
+        $ref: "#/definitions/TxResult"
+      deliver_tx:
+        $ref: "#/definitions/TxResult"
+      hash:
+        $ref: "#/definitions/Hash"
+      height:
+        $ref: "#/definitions/Int64"
+  TxRouteResult:
+    type: object
+    description: The BroadcastTxCommitResult of the signed tx, or the GeneratedTx with generate_only
+    properties:
+      check_tx:
+        $ref: "#/definitions/TxResult"
+      deliver_tx:
+        $ref: "#/definitions/TxResult"
+      hash:
+        $ref: "#/definitions/Hash"
+      height:
+        $ref: "#/definitions/Int64"
+      tx:
+        $ref: "#/definitions/StdTx"
+      sign_bytes:
+        type: string
+  TxInfo:
+    type: object
+    properties:
+      hash:
+        $ref: "#/definitions/Hash"
+      height:
+        $ref: "#/definitions/Int64"
+      tx:
+        $ref: "#/definitions/Amino"
+      result:
+        $ref: "#/definitions/TxResult"
+      msg_results:
+        type: array
+        items:
+          type: object
+      events:
+        type: array
+        items:
+          type: object
+          properties:
+            type:
+              type: string
+            attributes:
+              type: array
+              items:
+                type: object
+  KeyOutput:
+    type: object
+    required:
+      - name
+      - type
+      - address
+      - pub_key
+    properties:
+      name:
+        type: string
+        example: Main Account
+      type:
+        type: string
+        example: local
+      address:
+        $ref: "#/definitions/Address"
+      pub_key:
+        $ref: "#/definitions/PubKey"
+      seed:
+        type: string
+        description: Only returned when the key is created
+  SignedMessage:
+    type: object
+    required:
+      - message
+      - signer
+      - pub_key
+      - signature
+    properties:
+      message:
+        type: string
+      signer:
+        $ref: "#/definitions/Address"
+      pub_key:
+        $ref: "#/definitions/Amino"
+      signature:
+        $ref: "#/definitions/Amino"
+  Account:
+    type: object
+    required:
+      - type
+      - value
+    properties:
+      type:
+        type: string
+        example: auth/Account
+      value:
+        type: object
+        properties:
+          address:
+            $ref: "#/definitions/Address"
+          coins:
+            $ref: "#/definitions/Coins"
+          public_key:
+            $ref: "#/definitions/Amino"
+          account_number:
+            $ref: "#/definitions/Int64"
+          sequence:
+            $ref: "#/definitions/Int64"
+  DenomMetadata:
+    type: object
+    properties:
+      base:
+        type: string
+        example: uatom
+      display:
+        type: string
+        example: atom
+      exponent:
+        type: integer
+        example: 6
+      description:
+        type: string
+  NodeInfo:
+    type: object
+    properties:
+      id:
+        type: string
+      listen_addr:
+        type: string
+      network:
+        type: string
+      version:
+        type: string
+      channels:
+        type: string
+      moniker:
+        type: string
+      other:
+        type: array
+        items:
+          type: string
+  BlockID:
+    type: object
+    properties:
+      hash:
+        $ref: "#/definitions/Hash"
+      parts:
+        type: object
+        properties:
+          total:
+            type: integer
+          hash:
+            $ref: "#/definitions/Hash"
+  Block:
+    type: object
+    properties:
+      block_meta:
+        type: object
+        properties:
+          block_id:
+            $ref: "#/definitions/BlockID"
+          header:
+            $ref: "#/definitions/BlockHeader"
+      block:
+        type: object
+        properties:
+          header:
+            $ref: "#/definitions/BlockHeader"
+          data:
+            type: object
+            properties:
+              txs:
+                type: array
+                items:
+                  type: string
+          evidence:
+            type: object
+          last_commit:
+            type: object
+            properties:
+              block_id:
+                $ref: "#/definitions/BlockID"
+              precommits:
+                type: array
+                items:
+                  type: object
+  BlockHeader:
+    type: object
+    properties:
+      chain_id:
+        type: string
+        example: gaia-2
+      height:
+        $ref: "#/definitions/Int64"
+      time:
+        type: string
+        example: '2017-12-30T05:53:09.287+01:00'
+      num_txs:
+        $ref: "#/definitions/Int64"
+      last_block_id:
+        $ref: "#/definitions/BlockID"
+      total_txs:
+        $ref: "#/definitions/Int64"
+      last_commit_hash:
+        $ref: "#/definitions/Hash"
+      data_hash:
+        $ref: "#/definitions/Hash"
+      validators_hash:
+        $ref: "#/definitions/Hash"
+      consensus_hash:
+        $ref: "#/definitions/Hash"
+      app_hash:
+        $ref: "#/definitions/Hash"
+      last_results_hash:
+        $ref: "#/definitions/Hash"
+      evidence_hash:
+        $ref: "#/definitions/Hash"
+  ValidatorSet:
+    type: object
+    properties:
+      block_height:
+        $ref: "#/definitions/Int64"
+      validators:
+        type: array
+        items:
+          type: object
+          properties:
+            address:
+              $ref: '#/definitions/ConsAddress'
+            pub_key:
+              $ref: "#/definitions/ValidatorPubKey"
+            accum:
+              $ref: "#/definitions/Int64"
+            voting_power:
+              $ref: "#/definitions/Int64"
+  Validator:
+    type: object
+    properties:
+      owner:
+        $ref: '#/definitions/ValidatorAddress'
+      pub_key:
+        $ref: "#/definitions/ValidatorPubKey"
+      consensus_address:
+        $ref: "#/definitions/ConsAddress"
+      revoked:
+        type: boolean
+      status:
+        type: integer
+        description: 0 for unbonded, 1 for unbonding and 2 for bonded
+      tokens:
+        $ref: "#/definitions/Dec"
+      delegator_shares:
+        $ref: "#/definitions/Dec"
+      description:
+        type: object
+        properties:
+          moniker:
+            type: string
+          identity:
+            type: string
+          website:
+            type: string
+          details:
+            type: string
+      bond_height:
+        $ref: "#/definitions/Int64"
+      bond_intra_tx_counter:
+        type: integer
+      proposer_reward_pool:
+        $ref: "#/definitions/Coins"
+      commission:
+        $ref: "#/definitions/Dec"
+      commission_max:
+        $ref: "#/definitions/Dec"
+      commission_change_rate:
+        $ref: "#/definitions/Dec"
+      commission_change_today:
+        $ref: "#/definitions/Dec"
+      prev_bonded_tokens:
+        $ref: "#/definitions/Dec"
+  Delegation:
+    type: object
+    properties:
+      delegator_addr:
+        $ref: "#/definitions/Address"
+      validator_addr:
+        $ref: "#/definitions/ValidatorAddress"
+      shares:
+        $ref: "#/definitions/Dec"
+      height:
+        $ref: "#/definitions/Int64"
+  UnbondingDelegation:
+    type: object
+    properties:
+      delegator_addr:
+        $ref: "#/definitions/Address"
+      validator_addr:
+        $ref: "#/definitions/ValidatorAddress"
+      creation_height:
+        $ref: "#/definitions/Int64"
+      min_time:
+        $ref: "#/definitions/Int64"
+      initial_balance:
+        $ref: "#/definitions/Coin"
+      balance:
+        $ref: "#/definitions/Coin"
+  Redelegation:
+    type: object
+    properties:
+      delegator_addr:
+        $ref: "#/definitions/Address"
+      validator_src_addr:
+        $ref: "#/definitions/ValidatorAddress"
+      validator_dst_addr:
+        $ref: "#/definitions/ValidatorAddress"
+      creation_height:
+        $ref: "#/definitions/Int64"
+      min_time:
+        $ref: "#/definitions/Int64"
+      initial_balance:
+        $ref: "#/definitions/Coin"
+      balance:
+        $ref: "#/definitions/Coin"
+      shares_src:
+        $ref: "#/definitions/Dec"
+      shares_dst:
+        $ref: "#/definitions/Dec"
+  SigningInfo:
+    type: object
+    properties:
+      start_height:
+        $ref: "#/definitions/Int64"
+      index_offset:
+        $ref: "#/definitions/Int64"
+      jailed_until:
+        $ref: "#/definitions/Int64"
+      signed_blocks_counter:
+        $ref: "#/definitions/Int64"
+  Proposal:
+    type: object
+    description: A TextProposal, DenomMetadataProposal or ParameterChangeProposal
+    required:
+      - type
+      - value
+    properties:
+      type:
+        type: string
+        example: gov/TextProposal
+      value:
+        type: object
+        properties:
+          proposal_id:
+            $ref: "#/definitions/Int64"
+          title:
+            type: string
+          description:
+            type: string
+          proposal_type:
+            type: string
+          proposal_status:
+            type: string
+          tally_result:
+            $ref: "#/definitions/TallyResult"
+          submit_block:
+            $ref: "#/definitions/Int64"
+          total_deposit:
+            $ref: "#/definitions/Coins"
+          voting_start_block:
+            $ref: "#/definitions/Int64"
+  Deposit:
+    type: object
+    properties:
+      depositer:
+        $ref: "#/definitions/Address"
+      proposal_id:
+        $ref: "#/definitions/Int64"
+      amount:
+        $ref: "#/definitions/Coins"
+  Vote:
+    type: object
+    properties:
+      voter:
+        $ref: "#/definitions/Address"
+      proposal_id:
+        $ref: "#/definitions/Int64"
+      option:
+        type: string
+        enum:
+          - "Yes"
+          - Abstain
+          - "No"
+          - NoWithVeto
+  TallyResult:
+    type: object
+    properties:
+      yes:
+        $ref: "#/definitions/Dec"
+      abstain:
+        $ref: "#/definitions/Dec"
+      no:
+        $ref: "#/definitions/Dec"
+      no_with_veto:
+        $ref: "#/definitions/Dec"
+  GovParams:
+    type: object
+    properties:
+      deposit_procedure:
+        type: object
+        properties:
+          min_deposit:
+            $ref: "#/definitions/Coins"
+          max_deposit_period:
+            $ref: "#/definitions/Int64"
+      voting_procedure:
+        type: object
+        properties:
+          voting_period:
+            $ref: "#/definitions/Int64"
+          vote_history_period:
+            $ref: "#/definitions/Int64"
+      tallying_procedure:
+        type: object
+        properties:
+          threshold:
+            $ref: "#/definitions/Dec"
+          veto:
+            $ref: "#/definitions/Dec"
+          governance_penalty:
+            $ref: "#/definitions/Dec"
+`
@@ -0,0 +1,405 @@
+package lcd
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/libs/cli"
+	yaml "gopkg.in/yaml.v2"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	keys "github.com/cosmos/cosmos-sdk/client/keys"
+	gapp "github.com/cosmos/cosmos-sdk/cmd/gaia/app"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var swaggerMethods = []string{"get", "post", "put", "delete"}
+
+func TestSwaggerSpecCoversRoutes(t *testing.T) {
+	spec := loadSwaggerSpec(t)
+	defer setupSwaggerKeybase(t)()
+
+	registered := make(map[string]bool)
+	public, kr := createRouters(gapp.MakeCodec(), handlerConfig{})
+	for _, router := range []*mux.Router{public, kr} {
+		err := router.Walk(func(route *mux.Route, _ *mux.Router, _ []*mux.Route) error {
+			path, err := route.GetPathTemplate()
+			if err != nil {
+				return err
+			}
+			methods, err := route.GetMethods()
+			if err != nil {
+				return fmt.Errorf("route %s has no method", path)
+			}
+			for _, method := range methods {
+				registered[strings.ToLower(method)+" "+path] = true
+			}
+			return nil
+		})
+		require.Nil(t, err)
+	}
+
+	specified := make(map[string]bool)
+	for path, item := range spec["paths"].(map[string]interface{}) {
+		for _, method := range swaggerMethods {
+			if _, ok := item.(map[string]interface{})[method]; ok {
+				specified[method+" "+path] = true
+			}
+		}
+	}
+
+	var missing, unknown []string
+	for route := range registered {
+		if !specified[route] {
+			missing = append(missing, route)
+		}
+	}
+	for route := range specified {
+		if !registered[route] {
+			unknown = append(unknown, route)
+		}
+	}
+	sort.Strings(missing)
+	sort.Strings(unknown)
+	require.Empty(t, missing, "registered routes missing from the swagger spec")
+	require.Empty(t, unknown, "swagger spec has routes which aren't registered")
+}
+
+// TestSwaggerSpecResponses checks the responses of the routes which don't need
+// a node against their spec
+func TestSwaggerSpecResponses(t *testing.T) {
+	spec := loadSwaggerSpec(t)
+	defer setupSwaggerKeybase(t)()
+	handler := createHandler(gapp.MakeCodec(), handlerConfig{apiTokens: []string{testAPIToken}})
+
+	request := func(method, path, template, body string) (int, []byte) {
+		req := httptest.NewRequest(method, path, strings.NewReader(body))
+		req.Header.Set("Authorization", authScheme+testAPIToken)
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		requireSwaggerResponse(t, spec, method, template, rec.Code, rec.Body.Bytes())
+		return rec.Code, rec.Body.Bytes()
+	}
+
+	code, _ := request("GET", "/version", "/version", "")
+	require.Equal(t, http.StatusOK, code)
+	code, _ = request("GET", "/swagger", "/swagger", "")
+	require.Equal(t, http.StatusOK, code)
+	for _, name := range []string{"swagger-ui.css", "swagger-ui-bundle.js"} {
+		code, body := request("GET", "/swagger/"+name, "/swagger/"+name, "")
+		require.Equal(t, http.StatusOK, code)
+		require.Equal(t, swaggerUIAssets[name], string(body))
+	}
+	code, body := request("GET", "/swagger/swagger.yaml", "/swagger/swagger.yaml", "")
+	require.Equal(t, http.StatusOK, code)
+	require.Equal(t, swaggerSpec, string(body))
+
+	// keys
+	code, _ = request("GET", "/keys", "/keys", "")
+	require.Equal(t, http.StatusOK, code)
+	code, _ = request("GET", "/keys/seed", "/keys/seed", "")
+	require.Equal(t, http.StatusOK, code)
+	code, body = request("POST", "/keys", "/keys", `{"name": "test", "password": "1234567890"}`)
+	require.Equal(t, http.StatusOK, code, string(body))
+	var key keys.KeyOutput
+	require.Nil(t, json.Unmarshal(body, &key))
+	addr := key.Address.String()
+	code, _ = request("POST", "/keys", "/keys", `{"name": "test", "password": "1234567890"}`)
+	require.Equal(t, http.StatusConflict, code)
+	code, _ = request("GET", "/keys", "/keys", "")
+	require.Equal(t, http.StatusOK, code)
+	code, _ = request("GET", "/keys/test", "/keys/{name}", "")
+	require.Equal(t, http.StatusOK, code)
+	code, _ = request("GET", "/keys/unknown", "/keys/{name}", "")
+	require.Equal(t, http.StatusNotFound, code)
+
+	code, signed := request("POST", "/keys/test/sign-message", "/keys/{name}/sign-message",
+		`{"password": "1234567890", "message": "hello"}`)
+	require.Equal(t, http.StatusOK, code, string(signed))
+	code, body = request("POST", "/keys/verify-message", "/keys/verify-message",
+		fmt.Sprintf(`{"signed_message": %s, "address": "%s"}`, signed, addr))
+	require.Equal(t, http.StatusOK, code)
+	require.Contains(t, string(body), `"valid": true`)
+
+	code, _ = request("PUT", "/keys/test", "/keys/{name}",
+		`{"old_password": "1234567890", "new_password": "0987654321"}`)
+	require.Equal(t, http.StatusOK, code)
+
+	// txs generated without the keybase
+	code, body = request("POST", fmt.Sprintf("/accounts/%s/send?generate_only=true", addr), "/accounts/{address}/send",
+		fmt.Sprintf(`{"from": "%s", "amount": [{"denom": "steak", "amount": "1"}], "chain_id": "test-chain", "account_number": "0", "sequence": "0", "gas": "200000"}`, addr))
+	require.Equal(t, http.StatusOK, code, string(body))
+	require.Contains(t, string(body), "sign_bytes")
+	code, body = request("POST", "/gov/proposals?generate_only=true", "/gov/proposals",
+		fmt.Sprintf(`{"base_req": {"chain_id": "test-chain", "account_number": "0", "sequence": "0", "gas": "200000"}, "title": "Test", "description": "test", "proposal_type": "Text", "proposer": "%s", "initial_deposit": [{"denom": "steak", "amount": "5"}]}`, addr))
+	require.Equal(t, http.StatusOK, code, string(body))
+
+	// the generated tx isn't signed
+	var generated struct {
+		Tx json.RawMessage `json:"tx"`
+	}
+	require.Nil(t, json.Unmarshal(body, &generated))
+	code, _ = request("POST", "/txs", "/txs", fmt.Sprintf(`{"tx": %s}`, generated.Tx))
+	require.Equal(t, http.StatusBadRequest, code)
+
+	code, _ = request("DELETE", "/keys/test", "/keys/{name}", `{"password": "0987654321"}`)
+	require.Equal(t, http.StatusOK, code)
+}
+
+// TestSwaggerSpecNodeResponses checks the responses of the routes querying an
+// in-process node, and of the txs signed on it, against their spec
+func TestSwaggerSpecNodeResponses(t *testing.T) {
+	spec := loadSwaggerSpec(t)
+	name, password := "test", "1234567890"
+	addr, _ := CreateAddr(t, name, password, GetKB(t))
+	cleanup, pks, port := InitializeTestLCD(t, 1, []sdk.AccAddress{addr})
+	defer cleanup()
+	chainID := viper.GetString(client.FlagChainID)
+	valAddr := sdk.AccAddress(pks[0].Address()).String()
+	receiver := sdk.AccAddress(crypto.GenPrivKeyEd25519().PubKey().Address()).String()
+
+	request := func(method, path, template, body string) (int, []byte) {
+		res, output := Request(t, port, method, path, []byte(body))
+		requireSwaggerResponse(t, spec, method, template, res.StatusCode, []byte(output))
+		return res.StatusCode, []byte(output)
+	}
+	// signReq returns the fields signing a tx with the test key at the
+	// current sequence of its account
+	signReq := func() string {
+		code, body := request("GET", "/accounts/"+addr.String(), "/accounts/{address}", "")
+		require.Equal(t, http.StatusOK, code, string(body))
+		var acc struct {
+			Value struct {
+				AccountNumber string `json:"account_number"`
+				Sequence      string `json:"sequence"`
+			} `json:"value"`
+		}
+		require.Nil(t, json.Unmarshal(body, &acc))
+		return fmt.Sprintf(`"name": "%s", "password": "%s", "chain_id": "%s", "account_number": "%s", "sequence": "%s", "gas": "200000"`,
+			name, password, chainID, acc.Value.AccountNumber, acc.Value.Sequence)
+	}
+
+	// accounts and txs
+	code, body := request("GET", "/accounts", "/accounts", "")
+	require.Equal(t, http.StatusOK, code, string(body))
+	code, body = request("POST", fmt.Sprintf("/accounts/%s/send", receiver), "/accounts/{address}/send",
+		fmt.Sprintf(`{%s, "amount": [{"denom": "steak", "amount": "1"}]}`, signReq()))
+	require.Equal(t, http.StatusOK, code, string(body))
+	var sent struct {
+		Hash string `json:"hash"`
+	}
+	require.Nil(t, json.Unmarshal(body, &sent))
+	code, body = request("GET", "/txs/"+sent.Hash, "/txs/{hash}", "")
+	require.Equal(t, http.StatusOK, code, string(body))
+	code, body = request("GET", "/txs?tag="+url.QueryEscape(fmt.Sprintf("transfer.recipient='%s'", receiver)), "/txs", "")
+	require.Equal(t, http.StatusOK, code, string(body))
+	code, body = request("GET", fmt.Sprintf("/accounts/%s/txs", addr), "/accounts/{address}/txs", "")
+	require.Equal(t, http.StatusOK, code, string(body))
+
+	// stake
+	code, body = request("GET", "/stake/validators", "/stake/validators", "")
+	require.Equal(t, http.StatusOK, code, string(body))
+	code, body = request("POST", "/stake/delegations", "/stake/delegations",
+		fmt.Sprintf(`{%s, "delegations": [{"delegator_addr": "%s", "validator_addr": "%s", "delegation": {"denom": "steak", "amount": "10"}}]}`,
+			signReq(), addr, valAddr))
+	require.Equal(t, http.StatusOK, code, string(body))
+	code, body = request("GET", fmt.Sprintf("/stake/%s/delegation/%s", addr, valAddr), "/stake/{delegator}/delegation/{validator}", "")
+	require.Equal(t, http.StatusOK, code, string(body))
+	code, body = request("GET", fmt.Sprintf("/stake/%s/delegations", addr), "/stake/{delegator}/delegations", "")
+	require.Equal(t, http.StatusOK, code, string(body))
+	request("GET", fmt.Sprintf("/stake/%s/ubds", addr), "/stake/{delegator}/ubds", "")
+	request("GET", fmt.Sprintf("/stake/%s/reds", addr), "/stake/{delegator}/reds", "")
+
+	// gov, the initial deposit reaching the min deposit opens the voting
+	code, body = request("POST", "/gov/proposals", "/gov/proposals",
+		fmt.Sprintf(`{"base_req": {%s}, "title": "Test", "description": "test", "proposal_type": "Text", "proposer": "%s", "initial_deposit": [{"denom": "steak", "amount": "10"}]}`,
+			signReq(), addr))
+	require.Equal(t, http.StatusOK, code, string(body))
+	code, body = request("POST", "/gov/proposals/1/deposits", "/gov/proposals/{proposalID}/deposits",
+		fmt.Sprintf(`{"base_req": {%s}, "depositer": "%s", "amount": [{"denom": "steak", "amount": "1"}]}`, signReq(), addr))
+	require.Equal(t, http.StatusOK, code, string(body))
+	code, body = request("POST", "/gov/proposals/1/votes", "/gov/proposals/{proposalID}/votes",
+		fmt.Sprintf(`{"base_req": {%s}, "voter": "%s", "option": "Yes"}`, signReq(), addr))
+	require.Equal(t, http.StatusOK, code, string(body))
+
+	code, body = request("GET", "/gov/proposals", "/gov/proposals", "")
+	require.Equal(t, http.StatusOK, code, string(body))
+	code, body = request("GET", "/gov/proposals/1", "/gov/proposals/{proposalID}", "")
+	require.Equal(t, http.StatusOK, code, string(body))
+	code, body = request("GET", "/gov/proposals/1/deposits", "/gov/proposals/{proposalID}/deposits", "")
+	require.Equal(t, http.StatusOK, code, string(body))
+	code, body = request("GET", fmt.Sprintf("/gov/proposals/1/deposits/%s", addr), "/gov/proposals/{proposalID}/deposits/{depositer}", "")
+	require.Equal(t, http.StatusOK, code, string(body))
+	code, body = request("GET", "/gov/proposals/1/votes", "/gov/proposals/{proposalID}/votes", "")
+	require.Equal(t, http.StatusOK, code, string(body))
+	code, body = request("GET", fmt.Sprintf("/gov/proposals/1/votes/%s", addr), "/gov/proposals/{proposalID}/votes/{voter}", "")
+	require.Equal(t, http.StatusOK, code, string(body))
+	code, body = request("GET", "/gov/proposals/1/tally", "/gov/proposals/{proposalID}/tally", "")
+	require.Equal(t, http.StatusOK, code, string(body))
+	code, body = request("GET", "/gov/params", "/gov/params", "")
+	require.Equal(t, http.StatusOK, code, string(body))
+}
+
+// setupSwaggerKeybase serves the keys of an in memory keybase, under a
+// temporary home
+func setupSwaggerKeybase(t *testing.T) (cleanup func()) {
+	home, err := ioutil.TempDir("", "lcd_swagger")
+	require.Nil(t, err)
+	prevHome := viper.GetString(cli.HomeFlag)
+	viper.Set(cli.HomeFlag, home)
+	keys.SetKeyBase(client.MockKeyBase())
+	return func() {
+		keys.SetKeyBase(nil)
+		viper.Set(cli.HomeFlag, prevHome)
+		os.RemoveAll(home)
+	}
+}
+
+// loadSwaggerSpec parses the spec, with string keys all the way down
+func loadSwaggerSpec(t *testing.T) map[string]interface{} {
+	var spec interface{}
+	err := yaml.Unmarshal([]byte(swaggerSpec), &spec)
+	require.Nil(t, err)
+	return stringKeys(spec).(map[string]interface{})
+}
+
+func stringKeys(v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[interface{}]interface{}:
+		m := make(map[string]interface{}, len(v))
+		for key, value := range v {
+			m[fmt.Sprint(key)] = stringKeys(value)
+		}
+		return m
+	case []interface{}:
+		for i, value := range v {
+			v[i] = stringKeys(value)
+		}
+	}
+	return v
+}
+
+// requireSwaggerResponse checks the status code is specified for the route,
+// and that a JSON body matches the schema of the response
+func requireSwaggerResponse(t *testing.T, spec map[string]interface{}, method, path string, code int, body []byte) {
+	item, ok := spec["paths"].(map[string]interface{})[path].(map[string]interface{})
+	require.True(t, ok, "no spec for %s", path)
+	op, ok := item[strings.ToLower(method)].(map[string]interface{})
+	require.True(t, ok, "no spec for %s %s", method, path)
+	response, ok := op["responses"].(map[string]interface{})[strconv.Itoa(code)].(map[string]interface{})
+	require.True(t, ok, "status %d of %s %s isn't specified: %s", code, method, path, body)
+
+	schema, ok := response["schema"]
+	if !ok {
+		return
+	}
+	var value interface{}
+	err := json.NewDecoder(bytes.NewReader(body)).Decode(&value)
+	require.Nil(t, err, "%s %s: %s", method, path, body)
+	err = validateSchema(spec, schema.(map[string]interface{}), value, "response")
+	require.Nil(t, err, "%s %s: %s", method, path, body)
+}
+
+// validateSchema checks a decoded JSON value against the subset of the
+// swagger schemas used by the spec. As Go encodes nil slices and pointers as
+// null, null is accepted for any value.
+func validateSchema(spec map[string]interface{}, schema map[string]interface{}, value interface{}, at string) error {
+	if ref, ok := schema["$ref"].(string); ok {
+		name := strings.TrimPrefix(ref, "#/definitions/")
+		def, ok := spec["definitions"].(map[string]interface{})[name].(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("%s: unknown definition %s", at, ref)
+		}
+		return validateSchema(spec, def, value, at)
+	}
+	if all, ok := schema["allOf"].([]interface{}); ok {
+		for _, sub := range all {
+			if err := validateSchema(spec, sub.(map[string]interface{}), value, at); err != nil {
+				return err
+			}
+		}
+	}
+	if value == nil {
+		return nil
+	}
+
+	switch schema["type"] {
+	case "object":
+		obj, ok := value.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("%s: expected an object, got %v", at, value)
+		}
+		if required, ok := schema["required"].([]interface{}); ok {
+			for _, field := range required {
+				if _, ok := obj[field.(string)]; !ok {
+					return fmt.Errorf("%s: missing required field %s", at, field)
+				}
+			}
+		}
+		props, _ := schema["properties"].(map[string]interface{})
+		for field, prop := range props {
+			if v, ok := obj[field]; ok {
+				if err := validateSchema(spec, prop.(map[string]interface{}), v, at+"."+field); err != nil {
+					return err
+				}
+			}
+		}
+	case "array":
+		arr, ok := value.([]interface{})
+		if !ok {
+			return fmt.Errorf("%s: expected an array, got %v", at, value)
+		}
+		items, _ := schema["items"].(map[string]interface{})
+		for i, v := range arr {
+			if err := validateSchema(spec, items, v, fmt.Sprintf("%s[%d]", at, i)); err != nil {
+				return err
+			}
+		}
+	case "string":
+		s, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("%s: expected a string, got %v", at, value)
+		}
+		if enum, ok := schema["enum"].([]interface{}); ok && !containsValue(enum, s) {
+			return fmt.Errorf("%s: %s isn't one of %v", at, s, enum)
+		}
+	case "integer":
+		n, ok := value.(float64)
+		if !ok || n != math.Trunc(n) {
+			return fmt.Errorf("%s: expected an integer, got %v", at, value)
+		}
+	case "number":
+		if _, ok := value.(float64); !ok {
+			return fmt.Errorf("%s: expected a number, got %v", at, value)
+		}
+	case "boolean":
+		if _, ok := value.(bool); !ok {
+			return fmt.Errorf("%s: expected a boolean, got %v", at, value)
+		}
+	}
+	return nil
+}
+
+func containsValue(values []interface{}, value string) bool {
+	for _, v := range values {
+		if fmt.Sprint(v) == value {
+			return true
+		}
+	}
+	return false
+}
@@ -146,7 +146,8 @@ func InitializeTestLCD(t *testing.T, nValidators int, initAddrs []sdk.AccAddress
 		accAuth.Coins = sdk.Coins{sdk.NewCoin("steak", 100)}
 		acc := gapp.NewGenesisAccount(&accAuth)
 		genesisState.Accounts = append(genesisState.Accounts, acc)
-		genesisState.StakeData.Pool.LooseTokens = genesisState.StakeData.Pool.LooseTokens.Add(sdk.NewRat(100))
+		genesisState.StakeData.Pool.LooseTokens = genesisState.StakeData.Pool.LooseTokens.Add(sdk.NewDec(100))
+		genesisState.BankData.Supply = genesisState.BankData.Supply.Plus(accAuth.Coins)
 	}
 
 	appState, err := wire.MarshalJSONIndent(cdc, genesisState)
@@ -211,9 +212,12 @@ func startTM(tmcfg *tmcfg.Config, logger log.Logger, genDoc *tmtypes.GenesisDoc,
 	return n, err
 }
 
+// API token used by the test requests to access the keybase routes
+const testAPIToken = "test-api-token"
+
 // start the LCD. note this blocks!
 func startLCD(logger log.Logger, listenAddr string, cdc *wire.Codec) (net.Listener, error) {
-	handler := createHandler(cdc)
+	handler := createHandler(cdc, handlerConfig{apiTokens: []string{testAPIToken}})
 	return tmrpc.StartHTTPServer(listenAddr, handler, logger, tmrpc.Config{})
 }
 
@@ -224,6 +228,7 @@ func Request(t *testing.T, port, method, path string, payload []byte) (*http.Res
 	url := fmt.Sprintf("http://localhost:%v%v", port, path)
 	req, err := http.NewRequest(method, url, bytes.NewBuffer(payload))
 	require.Nil(t, err)
+	req.Header.Set("Authorization", "Bearer "+testAPIToken)
 	res, err = http.DefaultClient.Do(req)
 	//	res, err = http.Post(url, "application/json", bytes.NewBuffer(payload))
 	require.Nil(t, err)
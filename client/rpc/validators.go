@@ -32,10 +32,10 @@ func ValidatorCommand() *cobra.Command {
 
 // Validator output in bech32 format
 type ValidatorOutput struct {
-	Address     sdk.ValAddress `json:"address"` // in bech32
-	PubKey      string         `json:"pub_key"` // in bech32
-	Accum       int64          `json:"accum"`
-	VotingPower int64          `json:"voting_power"`
+	Address     sdk.ConsAddress `json:"address"` // in bech32
+	PubKey      string          `json:"pub_key"` // in bech32
+	Accum       int64           `json:"accum"`
+	VotingPower int64           `json:"voting_power"`
 }
 
 // Validators at a certain height output in bech32 format
@@ -51,7 +51,7 @@ func bech32ValidatorOutput(validator *tmtypes.Validator) (ValidatorOutput, error
 	}
 
 	return ValidatorOutput{
-		Address:     sdk.ValAddress(validator.Address),
+		Address:     sdk.ConsAddress(validator.Address),
 		PubKey:      bechValPubkey,
 		Accum:       validator.Accum,
 		VotingPower: validator.VotingPower,
@@ -1,37 +1,91 @@
 package tx
 
 import (
-	"encoding/json"
+	"fmt"
+	"io/ioutil"
 	"net/http"
 
 	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/wire"
+	"github.com/cosmos/cosmos-sdk/x/auth"
 )
 
-// Tx Broadcast Body
+// Modes of broadcasting a tx with POST /txs
+const (
+	// BroadcastCommit waits for the tx to be committed in a block
+	BroadcastCommit = "commit"
+	// BroadcastSync waits for the tx to pass CheckTx
+	BroadcastSync = "sync"
+	// BroadcastAsync returns right away
+	BroadcastAsync = "async"
+)
+
+// BroadcastTxBody is the body of a request broadcasting a tx signed by the
+// client, e.g. by a browser wallet or a hardware signer. The mode defaults to
+// commit.
 type BroadcastTxBody struct {
-	TxBytes string `json:"tx"`
+	Tx   auth.StdTx `json:"tx"`
+	Mode string     `json:"mode"`
 }
 
-// BroadcastTx REST Handler
-func BroadcastTxRequestHandlerFn(ctx context.CoreContext) http.HandlerFunc {
+// BroadcastTxRequestHandlerFn broadcasts a signed tx in the mode of the
+// request. It doesn't touch the keybase, so it is also served by an LCD
+// running without one.
+func BroadcastTxRequestHandlerFn(cdc *wire.Codec, ctx context.CoreContext) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		var m BroadcastTxBody
+		body, err := ioutil.ReadAll(r.Body)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(err.Error()))
+			return
+		}
+		err = cdc.UnmarshalJSON(body, &m)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(err.Error()))
+			return
+		}
+		if len(m.Tx.Signatures) == 0 {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte("tx is not signed"))
+			return
+		}
 
-		decoder := json.NewDecoder(r.Body)
-		err := decoder.Decode(&m)
+		txBytes, err := cdc.MarshalBinary(m.Tx)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(err.Error()))
+			return
+		}
+
+		var res interface{}
+		switch m.Mode {
+		case "", BroadcastCommit:
+			res, err = ctx.BroadcastTx(txBytes)
+		case BroadcastSync:
+			res, err = ctx.BroadcastTxSync(txBytes)
+		case BroadcastAsync:
+			res, err = ctx.BroadcastTxAsync(txBytes)
+		default:
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(fmt.Sprintf("invalid broadcast mode '%s', expected %s, %s or %s",
+				m.Mode, BroadcastCommit, BroadcastSync, BroadcastAsync)))
+			return
+		}
 		if err != nil {
-			w.WriteHeader(400)
+			w.WriteHeader(http.StatusInternalServerError)
 			w.Write([]byte(err.Error()))
 			return
 		}
 
-		res, err := ctx.BroadcastTx([]byte(m.TxBytes))
+		output, err := wire.MarshalJSONIndent(cdc, res)
 		if err != nil {
-			w.WriteHeader(500)
+			w.WriteHeader(http.StatusInternalServerError)
 			w.Write([]byte(err.Error()))
 			return
 		}
 
-		w.Write([]byte(string(res.Height)))
+		w.Write(output)
 	}
 }
@@ -82,20 +82,38 @@ func formatTxResult(cdc *wire.Codec, res *ctypes.ResultTx) (txInfo, error) {
 	}
 
 	info := txInfo{
-		Hash:   res.Hash,
-		Height: res.Height,
-		Tx:     tx,
-		Result: res.TxResult,
+		Hash:       res.Hash,
+		Height:     res.Height,
+		Tx:         tx,
+		Result:     res.TxResult,
+		MsgResults: parseMsgResults(cdc, res.TxResult.Data),
+		Events:     sdk.EventsFromTags(res.TxResult.Tags),
 	}
 	return info, nil
 }
 
+// parseMsgResults decodes the per-msg results from the data of a tx result.
+// Txs rejected before running their msgs carry no msg results.
+func parseMsgResults(cdc *wire.Codec, data []byte) []sdk.MsgResult {
+	if len(data) == 0 {
+		return nil
+	}
+	var txData sdk.TxData
+	err := cdc.UnmarshalBinary(data, &txData)
+	if err != nil {
+		return nil
+	}
+	return txData.MsgResults
+}
+
 // txInfo is used to prepare info to display
 type txInfo struct {
-	Hash   common.HexBytes        `json:"hash"`
-	Height int64                  `json:"height"`
-	Tx     sdk.Tx                 `json:"tx"`
-	Result abci.ResponseDeliverTx `json:"result"`
+	Hash       common.HexBytes        `json:"hash"`
+	Height     int64                  `json:"height"`
+	Tx         sdk.Tx                 `json:"tx"`
+	Result     abci.ResponseDeliverTx `json:"result"`
+	MsgResults []sdk.MsgResult        `json:"msg_results"`
+	Events     sdk.Events             `json:"events"`
 }
 
 func parseTx(cdc *wire.Codec, txBytes []byte) (sdk.Tx, error) {
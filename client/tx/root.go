@@ -20,6 +20,6 @@ func AddCommands(cmd *cobra.Command, cdc *wire.Codec) {
 func RegisterRoutes(ctx context.CoreContext, r *mux.Router, cdc *wire.Codec) {
 	r.HandleFunc("/txs/{hash}", QueryTxRequestHandlerFn(cdc, ctx)).Methods("GET")
 	r.HandleFunc("/txs", SearchTxRequestHandlerFn(ctx, cdc)).Methods("GET")
-	// r.HandleFunc("/txs/sign", SignTxRequstHandler).Methods("POST")
-	// r.HandleFunc("/txs/broadcast", BroadcastTxRequestHandler).Methods("POST")
+	r.HandleFunc("/accounts/{address}/txs", SearchAccountTxsRequestHandlerFn(ctx, cdc)).Methods("GET")
+	r.HandleFunc("/txs", BroadcastTxRequestHandlerFn(cdc, ctx)).Methods("POST")
 }
@@ -5,8 +5,10 @@ import (
 	"fmt"
 	"net/http"
 	"net/url"
+	"sort"
 	"strings"
 
+	"github.com/gorilla/mux"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 
@@ -14,27 +16,66 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/utils"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/wire"
+	"github.com/cosmos/cosmos-sdk/x/bank"
 )
 
 const (
-	flagTags = "tag"
-	flagAny  = "any"
+	flagTags      = "tag"
+	flagAny       = "any"
+	flagAddress   = "address"
+	flagDirection = "direction"
 )
 
+// directions of the transfers listed by an account transaction search
+const (
+	DirectionSent     = "sent"
+	DirectionReceived = "received"
+)
+
+// maximum number of txs requested from the node per page of a tag search
+const maxSearchPerPage = 100
+
+// maximum number of txs fetched from each of the searches merged into the
+// pages of an account search
+const maxMergedSearchTxs = 10000
+
 // default client command to search through tagged transactions
 func SearchTxCmd(cdc *wire.Codec) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "txs",
-		Short: "Search for all transactions that match the given tags",
+		Short: "Search for all transactions that match the given tags, or that spend or receive the coins of an address",
 		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.NewCoreContextFromViper()
 			tags := viper.GetStringSlice(flagTags)
-
-			txs, err := searchTxs(context.NewCoreContextFromViper(), cdc, tags)
-			if err != nil {
+			address := viper.GetString(flagAddress)
+			pagination := sdk.NewPaginationParams(viper.GetInt(client.FlagPage), viper.GetInt(client.FlagLimit))
+			if err := pagination.ValidateBasic(); err != nil {
 				return err
 			}
+
+			var txs []txInfo
+			if address != "" {
+				if len(tags) != 0 {
+					return errors.New("an address search can't be combined with tags")
+				}
+				addr, err := sdk.AccAddressFromBech32(address)
+				if err != nil {
+					return err
+				}
+				txs, err = searchAccountTxs(ctx, cdc, addr, viper.GetString(flagDirection), pagination)
+				if err != nil {
+					return err
+				}
+			} else {
+				var err error
+				txs, err = searchTxs(ctx, cdc, tags, pagination)
+				if err != nil {
+					return err
+				}
+			}
 			output, err := cdc.MarshalJSON(txs)
 			if err != nil {
 				return err
@@ -48,12 +89,15 @@ func SearchTxCmd(cdc *wire.Codec) *cobra.Command {
 
 	// TODO: change this to false once proofs built in
 	cmd.Flags().Bool(client.FlagTrustNode, true, "Don't verify proofs for responses")
-	cmd.Flags().StringSlice(flagTags, nil, "Tags that must match (may provide multiple)")
+	cmd.Flags().StringSlice(flagTags, nil, "Tags that must match, eg. transfer.recipient='<address>' (may provide multiple)")
 	cmd.Flags().Bool(flagAny, false, "Return transactions that match ANY tag, rather than ALL")
+	cmd.Flags().String(flagAddress, "", "Bech32 address of an account, return the transactions spending its coins or adding coins to it")
+	cmd.Flags().String(flagDirection, "", fmt.Sprintf("With --address, return only the transactions where the account %s or %s coins", DirectionSent, DirectionReceived))
+	client.AddPaginationFlags(cmd)
 	return cmd
 }
 
-func searchTxs(ctx context.CoreContext, cdc *wire.Codec, tags []string) ([]txInfo, error) {
+func searchTxs(ctx context.CoreContext, cdc *wire.Codec, tags []string, pagination sdk.PaginationParams) ([]txInfo, error) {
 	if len(tags) == 0 {
 		return nil, errors.New("must declare at least one tag to search")
 	}
@@ -66,10 +110,7 @@ func searchTxs(ctx context.CoreContext, cdc *wire.Codec, tags []string) ([]txInf
 	}
 
 	prove := !viper.GetBool(client.FlagTrustNode)
-	// TODO: take these as args
-	page := 0
-	perPage := 100
-	res, err := node.TxSearch(query, prove, page, perPage)
+	res, err := node.TxSearch(query, prove, pagination.Page, pagination.Limit)
 	if err != nil {
 		return nil, err
 	}
@@ -82,6 +123,101 @@ func searchTxs(ctx context.CoreContext, cdc *wire.Codec, tags []string) ([]txInf
 	return info, nil
 }
 
+// searchAccountTxs returns a page of the transactions spending coins from or
+// adding coins to the address through the bank, ordered by height. These are
+// found by the coin_spent and coin_received events, which are emitted for
+// every input and output of a send, unlike the transfer event. The txs found
+// by both searches are merged, so a tx matching both is listed once.
+func searchAccountTxs(ctx context.CoreContext, cdc *wire.Codec, addr sdk.AccAddress, direction string, pagination sdk.PaginationParams) ([]txInfo, error) {
+	sent := eventTag(bank.EventTypeCoinSpent, bank.AttributeKeySpender, addr)
+	received := eventTag(bank.EventTypeCoinReceived, bank.AttributeKeyReceiver, addr)
+	var queries []string
+	switch direction {
+	case "":
+		queries = []string{sent, received}
+	case DirectionSent:
+		queries = []string{sent}
+	case DirectionReceived:
+		queries = []string{received}
+	default:
+		return nil, fmt.Errorf("invalid direction %s, expected %s or %s", direction, DirectionSent, DirectionReceived)
+	}
+
+	node, err := ctx.GetNode()
+	if err != nil {
+		return nil, err
+	}
+	prove := !viper.GetBool(client.FlagTrustNode)
+
+	results, err := mergeTxSearches(node.TxSearch, queries, prove, pagination)
+	if err != nil {
+		return nil, err
+	}
+	return formatTxResults(cdc, results)
+}
+
+// txSearchFunc searches the txs indexed by the node, like the TxSearch of the
+// rpc client
+type txSearchFunc func(query string, prove bool, page, perPage int) (*ctypes.ResultTxSearch, error)
+
+// mergeTxSearches returns a page of the txs matching any of the queries,
+// ordered by height and index, listing a tx matching several queries once.
+// The node orders the results of each search by height, so the merged page
+// only needs the txs of each search up to the end of the page.
+func mergeTxSearches(search txSearchFunc, queries []string, prove bool, pagination sdk.PaginationParams) ([]*ctypes.ResultTx, error) {
+	end := pagination.End()
+	if end > maxMergedSearchTxs {
+		return nil, fmt.Errorf("only the first %d txs can be listed, requested up to %d", maxMergedSearchTxs, end)
+	}
+	perPage := maxSearchPerPage
+	if end < perPage {
+		perPage = end
+	}
+
+	seen := make(map[string]bool)
+	var results []*ctypes.ResultTx
+	for _, query := range queries {
+		for page, fetched := 1, 0; fetched < end; page++ {
+			res, err := search(query, prove, page, perPage)
+			if err != nil {
+				return nil, err
+			}
+			for _, tx := range res.Txs {
+				if !seen[string(tx.Hash)] {
+					seen[string(tx.Hash)] = true
+					results = append(results, tx)
+				}
+			}
+			fetched += len(res.Txs)
+			if len(res.Txs) < perPage || page*perPage >= res.TotalCount {
+				break
+			}
+		}
+	}
+
+	sort.Slice(results, func(i, j int) bool {
+		if results[i].Height != results[j].Height {
+			return results[i].Height < results[j].Height
+		}
+		return results[i].Index < results[j].Index
+	})
+
+	start := pagination.Start()
+	if start > len(results) {
+		start = len(results)
+	}
+	if end > len(results) {
+		end = len(results)
+	}
+	return results[start:end], nil
+}
+
+// eventTag returns the tag query matching the events of the type with the
+// address as the given attribute
+func eventTag(eventType, attribute string, addr sdk.AccAddress) string {
+	return fmt.Sprintf("%s.%s='%s'", eventType, attribute, addr.String())
+}
+
 func formatTxResults(cdc *wire.Codec, res []*ctypes.ResultTx) ([]txInfo, error) {
 	var err error
 	out := make([]txInfo, len(res))
@@ -127,7 +263,12 @@ func SearchTxRequestHandlerFn(ctx context.CoreContext, cdc *wire.Codec) http.Han
 			tag = strings.TrimRight(key, "_bech32") + "='" + sdk.AccAddress(bz).String() + "'"
 		}
 
-		txs, err := searchTxs(ctx, cdc, []string{tag})
+		pagination, ok := utils.ParsePaginationParams(w, r)
+		if !ok {
+			return
+		}
+
+		txs, err := searchTxs(ctx, cdc, []string{tag}, pagination)
 		if err != nil {
 			w.WriteHeader(500)
 			w.Write([]byte(err.Error()))
@@ -149,3 +290,56 @@ func SearchTxRequestHandlerFn(ctx context.CoreContext, cdc *wire.Codec) http.Han
 		w.Write(output)
 	}
 }
+
+// Search the txs of an account REST Handler. The txs spending coins from or
+// adding coins to the address are listed, or only the ones in the direction
+// given by the optional direction URL query parameter.
+func SearchAccountTxsRequestHandlerFn(ctx context.CoreContext, cdc *wire.Codec) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		bech32addr := mux.Vars(r)["address"]
+		addr, err := sdk.AccAddressFromBech32(bech32addr)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(err.Error()))
+			return
+		}
+
+		direction := r.URL.Query().Get(flagDirection)
+		if direction != "" && direction != DirectionSent && direction != DirectionReceived {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(fmt.Sprintf("invalid direction %s, expected %s or %s", direction, DirectionSent, DirectionReceived)))
+			return
+		}
+
+		pagination, ok := utils.ParsePaginationParams(w, r)
+		if !ok {
+			return
+		}
+		if pagination.End() > maxMergedSearchTxs {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(fmt.Sprintf("only the first %d txs can be listed", maxMergedSearchTxs)))
+			return
+		}
+
+		txs, err := searchAccountTxs(ctx, cdc, addr, direction, pagination)
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte(err.Error()))
+			return
+		}
+
+		if len(txs) == 0 {
+			w.Write([]byte("[]"))
+			return
+		}
+
+		output, err := cdc.MarshalJSON(txs)
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte(err.Error()))
+			return
+		}
+
+		w.Write(output)
+	}
+}
@@ -0,0 +1,101 @@
+package tx
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// mockTxSearch serves the txs of every query ordered by height, like the node,
+// and counts the txs it returned
+type mockTxSearch struct {
+	txs     map[string][]*ctypes.ResultTx
+	fetched int
+}
+
+func (m *mockTxSearch) search(query string, prove bool, page, perPage int) (*ctypes.ResultTxSearch, error) {
+	txs, ok := m.txs[query]
+	if !ok {
+		return nil, fmt.Errorf("unknown query %s", query)
+	}
+	start, end := (page-1)*perPage, page*perPage
+	if start > len(txs) {
+		start = len(txs)
+	}
+	if end > len(txs) {
+		end = len(txs)
+	}
+	m.fetched += end - start
+	return &ctypes.ResultTxSearch{Txs: txs[start:end], TotalCount: len(txs)}, nil
+}
+
+func mockTx(height int64, index uint32) *ctypes.ResultTx {
+	return &ctypes.ResultTx{
+		Hash:   []byte(fmt.Sprintf("%d/%d", height, index)),
+		Height: height,
+		Index:  index,
+	}
+}
+
+func TestMergeTxSearches(t *testing.T) {
+	sent := []*ctypes.ResultTx{mockTx(1, 0), mockTx(3, 1), mockTx(5, 0)}
+	// 3/1 spent and received coins, e.g. a send to itself
+	received := []*ctypes.ResultTx{mockTx(2, 0), mockTx(3, 0), mockTx(3, 1), mockTx(4, 2)}
+	search := &mockTxSearch{txs: map[string][]*ctypes.ResultTx{"sent": sent, "received": received}}
+
+	cases := []struct {
+		queries    []string
+		pagination sdk.PaginationParams
+		expected   []*ctypes.ResultTx
+	}{
+		{[]string{"sent"}, sdk.NewPaginationParams(1, 2), sent[:2]},
+		{[]string{"sent", "received"}, sdk.NewPaginationParams(1, 10), []*ctypes.ResultTx{
+			mockTx(1, 0), mockTx(2, 0), mockTx(3, 0), mockTx(3, 1), mockTx(4, 2), mockTx(5, 0),
+		}},
+		{[]string{"sent", "received"}, sdk.NewPaginationParams(2, 2), []*ctypes.ResultTx{mockTx(3, 0), mockTx(3, 1)}},
+		{[]string{"sent", "received"}, sdk.NewPaginationParams(3, 2), []*ctypes.ResultTx{mockTx(4, 2), mockTx(5, 0)}},
+		{[]string{"received", "sent"}, sdk.NewPaginationParams(4, 2), nil},
+	}
+
+	for tcIndex, tc := range cases {
+		res, err := mergeTxSearches(search.search, tc.queries, false, tc.pagination)
+		require.Nil(t, err, "tc #%d", tcIndex)
+		require.Equal(t, len(tc.expected), len(res), "tc #%d", tcIndex)
+		for i := range tc.expected {
+			require.Equal(t, tc.expected[i].Hash, res[i].Hash, "tc #%d", tcIndex)
+		}
+	}
+}
+
+func TestMergeTxSearchesBounded(t *testing.T) {
+	var txs []*ctypes.ResultTx
+	for height := int64(1); height <= 1000; height++ {
+		txs = append(txs, mockTx(height, 0))
+	}
+	search := &mockTxSearch{txs: map[string][]*ctypes.ResultTx{"sent": txs, "received": txs}}
+
+	// only the txs up to the end of the page are fetched from each search
+	res, err := mergeTxSearches(search.search, []string{"sent", "received"}, false, sdk.NewPaginationParams(2, 30))
+	require.Nil(t, err)
+	require.Equal(t, 30, len(res))
+	require.Equal(t, int64(31), res[0].Height)
+	require.Equal(t, 2*60, search.fetched)
+
+	search.fetched = 0
+	res, err = mergeTxSearches(search.search, []string{"sent"}, false, sdk.NewPaginationParams(3, 100))
+	require.Nil(t, err)
+	require.Equal(t, 100, len(res))
+	require.Equal(t, int64(201), res[0].Height)
+	require.Equal(t, 300, search.fetched)
+
+	// the pages past the limit aren't searched
+	search.fetched = 0
+	_, err = mergeTxSearches(search.search, []string{"sent"}, false, sdk.NewPaginationParams(maxMergedSearchTxs/100+1, 100))
+	require.NotNil(t, err)
+	require.Equal(t, 0, search.fetched)
+}
@@ -0,0 +1,146 @@
+package utils
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/pkg/errors"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/wire"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+)
+
+// ErrKeybaseDisabled is written by the REST tx routes asked to sign a tx
+// when the LCD serves them without a keybase
+var ErrKeybaseDisabled = errors.New("the keybase is disabled, use generate_only and broadcast the signed tx with POST /txs")
+
+const (
+	// QueryParamHeight is the optional URL query parameter selecting the
+	// block height a REST query is served at
+	QueryParamHeight = "height"
+
+	// QueryParamPage and QueryParamLimit are the optional URL query
+	// parameters selecting the page of a paginated REST query
+	QueryParamPage  = "page"
+	QueryParamLimit = "limit"
+
+	// QueryParamGenerateOnly is the optional URL query parameter asking a
+	// REST tx route to return the unsigned tx instead of signing it with the
+	// keybase and broadcasting it
+	QueryParamGenerateOnly = "generate_only"
+
+	// HeaderBlockHeight is the response header carrying the height a REST
+	// query was actually served at
+	HeaderBlockHeight = "X-Cosmos-Block-Height"
+)
+
+// ParseQueryHeight applies the optional height URL query parameter to the
+// context. If the parameter is malformed a 400 is written to the response and
+// false is returned.
+func ParseQueryHeight(w http.ResponseWriter, r *http.Request, ctx context.CoreContext) (context.CoreContext, bool) {
+	heightStr := r.URL.Query().Get(QueryParamHeight)
+	if heightStr == "" {
+		return ctx, true
+	}
+	height, err := strconv.ParseInt(heightStr, 10, 64)
+	if err != nil || height < 0 {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(fmt.Sprintf("couldn't parse height '%s', expected a non-negative integer", heightStr)))
+		return ctx, false
+	}
+	return ctx.WithHeight(height), true
+}
+
+// ParsePaginationParams reads the optional page and limit URL query parameters
+// of a paginated query. If they are malformed or out of bounds a 400 is
+// written to the response and false is returned.
+func ParsePaginationParams(w http.ResponseWriter, r *http.Request) (sdk.PaginationParams, bool) {
+	var page, limit int
+	for param, value := range map[string]*int{QueryParamPage: &page, QueryParamLimit: &limit} {
+		str := r.URL.Query().Get(param)
+		if str == "" {
+			continue
+		}
+		i, err := strconv.Atoi(str)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(fmt.Sprintf("couldn't parse %s '%s', expected an integer", param, str)))
+			return sdk.PaginationParams{}, false
+		}
+		*value = i
+	}
+
+	params := sdk.NewPaginationParams(page, limit)
+	if err := params.ValidateBasic(); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(err.Error()))
+		return params, false
+	}
+	return params, true
+}
+
+// WriteQueryHeight sets the header carrying the served height. It must be
+// called before the response status or body is written.
+func WriteQueryHeight(w http.ResponseWriter, height int64) {
+	w.Header().Set(HeaderBlockHeight, strconv.FormatInt(height, 10))
+}
+
+// WriteQueryError writes a failed query to the response. Queries for a height
+// the node cannot serve are reported as 404, anything else as 500.
+func WriteQueryError(w http.ResponseWriter, msg string, err error) {
+	if errors.Cause(err) == context.ErrInvalidHeight {
+		w.WriteHeader(http.StatusNotFound)
+	} else {
+		w.WriteHeader(http.StatusInternalServerError)
+	}
+	w.Write([]byte(fmt.Sprintf("%s. Error: %s", msg, err.Error())))
+}
+
+// HasGenerateOnlyArg tells whether a REST tx route is asked to only generate
+// the unsigned tx
+func HasGenerateOnlyArg(r *http.Request) bool {
+	generateOnly, _ := strconv.ParseBool(r.URL.Query().Get(QueryParamGenerateOnly))
+	return generateOnly
+}
+
+// GeneratedTx is an unsigned tx returned by a REST tx route, together with the
+// bytes to sign. Once signed, the signature is added to the tx and the tx
+// broadcasted with POST /txs.
+type GeneratedTx struct {
+	Tx        auth.StdTx `json:"tx"`
+	SignBytes string     `json:"sign_bytes"`
+}
+
+// BuildGeneratedTx builds the unsigned tx of the msgs with the chain ID,
+// account number, sequence, memo, gas and fee of the context
+func BuildGeneratedTx(ctx context.CoreContext, msgs []sdk.Msg) (GeneratedTx, error) {
+	signMsg, err := ctx.BuildSignMsg(msgs)
+	if err != nil {
+		return GeneratedTx{}, err
+	}
+	return GeneratedTx{
+		Tx:        auth.NewStdTx(signMsg.Msgs, signMsg.Fee, nil, signMsg.Memo),
+		SignBytes: string(signMsg.Bytes()),
+	}, nil
+}
+
+// WriteGenerateOnlyResponse writes the unsigned tx of the msgs to the
+// response. If it can't be built a 400 is written instead.
+func WriteGenerateOnlyResponse(w http.ResponseWriter, ctx context.CoreContext, cdc *wire.Codec, msgs []sdk.Msg) {
+	tx, err := BuildGeneratedTx(ctx, msgs)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(err.Error()))
+		return
+	}
+	output, err := wire.MarshalJSONIndent(cdc, tx)
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(err.Error()))
+		return
+	}
+	w.Write(output)
+}
@@ -1,8 +1,10 @@
 package app
 
 import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/wire"
 	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/bank"
 	"github.com/cosmos/cosmos-sdk/x/stake"
 
 	abci "github.com/tendermint/tendermint/abci/types"
@@ -10,12 +12,15 @@ import (
 
 func setGenesis(gapp *GaiaApp, accs ...*auth.BaseAccount) error {
 	genaccs := make([]GenesisAccount, len(accs))
+	supply := sdk.Coins{}
 	for i, acc := range accs {
 		genaccs[i] = NewGenesisAccount(acc)
+		supply = supply.Plus(acc.Coins)
 	}
 
 	genesisState := GenesisState{
 		Accounts:  genaccs,
+		BankData:  bank.NewGenesisState(supply, nil, nil, nil),
 		StakeData: stake.DefaultGenesisState(),
 	}
 
@@ -3,6 +3,7 @@ package app
 import (
 	"encoding/json"
 	"errors"
+	"fmt"
 
 	"github.com/spf13/pflag"
 	"github.com/tendermint/tendermint/crypto"
@@ -13,6 +14,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/wire"
 	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/bank"
 	"github.com/cosmos/cosmos-sdk/x/stake"
 )
 
@@ -25,6 +27,7 @@ var (
 // State to Unmarshal
 type GenesisState struct {
 	Accounts  []GenesisAccount   `json:"accounts"`
+	BankData  bank.GenesisState  `json:"bank"`
 	StakeData stake.GenesisState `json:"stake"`
 }
 
@@ -142,6 +145,9 @@ func GaiaAppGenState(cdc *wire.Codec, appGenTxs []json.RawMessage) (genesisState
 	// start with the default staking genesis state
 	stakeData := stake.DefaultGenesisState()
 
+	// start with no supply, every created token is added to it
+	bankData := bank.DefaultGenesisState()
+
 	// get genesis flag account information
 	genaccs := make([]GenesisAccount, len(appGenTxs))
 	for i, appGenTx := range appGenTxs {
@@ -160,7 +166,8 @@ func GaiaAppGenState(cdc *wire.Codec, appGenTxs []json.RawMessage) (genesisState
 		}
 		acc := NewGenesisAccount(&accAuth)
 		genaccs[i] = acc
-		stakeData.Pool.LooseTokens = stakeData.Pool.LooseTokens.Add(sdk.NewRat(freeFermionsAcc)) // increase the supply
+		stakeData.Pool.LooseTokens = stakeData.Pool.LooseTokens.Add(sdk.NewDec(freeFermionsAcc)) // increase the supply
+		bankData.Supply = bankData.Supply.Plus(acc.Coins.Sort())
 
 		// add the validator
 		if len(genTx.Name) > 0 {
@@ -168,10 +175,11 @@ func GaiaAppGenState(cdc *wire.Codec, appGenTxs []json.RawMessage) (genesisState
 			validator := stake.NewValidator(genTx.Address,
 				sdk.MustGetAccPubKeyBech32(genTx.PubKey), desc)
 
-			stakeData.Pool.LooseTokens = stakeData.Pool.LooseTokens.Add(sdk.NewRat(freeFermionVal)) // increase the supply
+			stakeData.Pool.LooseTokens = stakeData.Pool.LooseTokens.Add(sdk.NewDec(freeFermionVal)) // increase the supply
+			bankData.Supply = bankData.Supply.Plus(sdk.Coins{{"steak", sdk.NewInt(freeFermionVal)}})
 
 			// add some new shares to the validator
-			var issuedDelShares sdk.Rat
+			var issuedDelShares sdk.Dec
 			validator, stakeData.Pool, issuedDelShares = validator.AddTokensFromDel(stakeData.Pool, freeFermionVal)
 			stakeData.Validators = append(stakeData.Validators, validator)
 
@@ -190,11 +198,47 @@ func GaiaAppGenState(cdc *wire.Codec, appGenTxs []json.RawMessage) (genesisState
 	// create the final app state
 	genesisState = GenesisState{
 		Accounts:  genaccs,
+		BankData:  bankData,
 		StakeData: stakeData,
 	}
 	return
 }
 
+// GaiaGenesisSupply returns the supply of the coins of a genesis state: the
+// coins of the accounts, except for the bond denom whose supply is the token
+// supply of the stake pool. The loose tokens of the pool already count the
+// bond tokens held by the accounts.
+func GaiaGenesisSupply(genesisState GenesisState) sdk.Coins {
+	bondDenom := genesisState.StakeData.Params.BondDenom
+	supply := sdk.Coins{}
+	for _, acc := range genesisState.Accounts {
+		for _, coin := range acc.Coins {
+			if coin.Denom != bondDenom {
+				supply = supply.Plus(sdk.Coins{coin})
+			}
+		}
+	}
+	bondSupply := genesisState.StakeData.Pool.TokenSupply().RoundInt()
+	if !bondSupply.IsZero() {
+		supply = supply.Plus(sdk.Coins{{bondDenom, bondSupply}})
+	}
+	return supply
+}
+
+// GaiaValidateGenesisState checks that the bank supply of a genesis state is
+// the supply of its coins, as burning coins missing from the supply fails
+func GaiaValidateGenesisState(genesisState GenesisState) error {
+	if err := bank.ValidateGenesis(genesisState.BankData); err != nil {
+		return err
+	}
+	supply := GaiaGenesisSupply(genesisState)
+	if !genesisState.BankData.Supply.IsEqual(supply) {
+		return fmt.Errorf("bank supply %v doesn't match the coins of the accounts and the stake pool %v",
+			genesisState.BankData.Supply, supply)
+	}
+	return nil
+}
+
 // GaiaAppGenState but with JSON
 func GaiaAppGenStateJSON(cdc *wire.Codec, appGenTxs []json.RawMessage) (appState json.RawMessage, err error) {
 
@@ -206,3 +250,27 @@ func GaiaAppGenStateJSON(cdc *wire.Codec, appGenTxs []json.RawMessage) (appState
 	appState, err = wire.MarshalJSONIndent(cdc, genesisState)
 	return
 }
+
+// MigrateRatAppState converts an app state written before the staking state
+// moved from sdk.Rat to sdk.Dec, the other sections are kept as they are
+func MigrateRatAppState(cdc *wire.Codec, appState json.RawMessage) (json.RawMessage, error) {
+	var sections map[string]json.RawMessage
+	err := json.Unmarshal(appState, &sections)
+	if err != nil {
+		return nil, err
+	}
+	stakeJSON, ok := sections["stake"]
+	if !ok {
+		return nil, errors.New("app state has no stake section")
+	}
+
+	stakeData, err := stake.MigrateRatGenesisState(stakeJSON)
+	if err != nil {
+		return nil, err
+	}
+	sections["stake"], err = cdc.MarshalJSON(stakeData)
+	if err != nil {
+		return nil, err
+	}
+	return json.MarshalIndent(sections, "", "  ")
+}
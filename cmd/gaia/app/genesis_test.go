@@ -5,6 +5,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/stake"
 	"github.com/stretchr/testify/require"
 	"github.com/tendermint/tendermint/crypto"
 )
@@ -34,3 +35,72 @@ func TestGaiaAppGenState(t *testing.T) {
 	// TODO test with both one and two genesis transactions:
 	// TODO        correct: genesis account created, canididates created, pool token variance
 }
+
+func TestMigrateRatAppState(t *testing.T) {
+	cdc := MakeCodec()
+	appState := []byte(`{
+  "accounts": [],
+  "stake": {
+    "pool": {
+      "loose_tokens": "150/1",
+      "bonded_tokens": "0/1",
+      "inflation_last_time": "0",
+      "inflation": "7/100",
+      "date_last_commission_reset": "0",
+      "prev_bonded_shares": "0/1"
+    },
+    "params": {
+      "inflation_rate_change": "13/100",
+      "inflation_max": "1/5",
+      "inflation_min": "7/100",
+      "goal_bonded": "67/100",
+      "unbonding_time": "259200",
+      "max_validators": 100,
+      "bond_denom": "steak"
+    },
+    "validators": null,
+    "bonds": null
+  }
+}`)
+
+	// the rats don't decode as decimals
+	var genesisState GenesisState
+	require.NotNil(t, cdc.UnmarshalJSON(appState, &genesisState))
+
+	migrated, err := MigrateRatAppState(cdc, appState)
+	require.Nil(t, err)
+	err = cdc.UnmarshalJSON(migrated, &genesisState)
+	require.Nil(t, err)
+
+	pool := stake.InitialPool()
+	pool.LooseTokens = sdk.NewDec(150)
+	require.True(t, pool.Equal(genesisState.StakeData.Pool))
+	require.True(t, stake.DefaultParams().Equal(genesisState.StakeData.Params))
+	require.Empty(t, genesisState.Accounts)
+}
+
+func TestGaiaValidateGenesisState(t *testing.T) {
+	priv := crypto.GenPrivKeyEd25519()
+	authAcc := auth.NewBaseAccountWithAddress(sdk.AccAddress(priv.PubKey().Address()))
+	authAcc.Coins = sdk.Coins{sdk.NewCoin("footoken", 10), sdk.NewCoin("steak", 50)}
+	stakeData := stake.DefaultGenesisState()
+	stakeData.Pool.LooseTokens = sdk.NewDec(50)
+	stakeData.Pool.BondedTokens = sdk.NewDec(100)
+	genesisState := GenesisState{
+		Accounts:  []GenesisAccount{NewGenesisAccount(&authAcc)},
+		StakeData: stakeData,
+	}
+
+	// the steak of the account is part of the loose tokens
+	supply := sdk.Coins{sdk.NewCoin("footoken", 10), sdk.NewCoin("steak", 150)}
+	require.True(t, supply.IsEqual(GaiaGenesisSupply(genesisState)))
+
+	genesisState.BankData.Supply = supply
+	require.Nil(t, GaiaValidateGenesisState(genesisState))
+
+	// a supply missing coins would fail the burns
+	genesisState.BankData.Supply = sdk.Coins{sdk.NewCoin("footoken", 10)}
+	require.NotNil(t, GaiaValidateGenesisState(genesisState))
+	genesisState.BankData.Supply = sdk.Coins{}
+	require.NotNil(t, GaiaValidateGenesisState(genesisState))
+}
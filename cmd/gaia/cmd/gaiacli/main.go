@@ -10,6 +10,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/lcd"
 	"github.com/cosmos/cosmos-sdk/client/rpc"
 	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/version"
 	authcmd "github.com/cosmos/cosmos-sdk/x/auth/client/cli"
 	bankcmd "github.com/cosmos/cosmos-sdk/x/bank/client/cli"
@@ -33,6 +34,9 @@ func main() {
 	cobra.EnableCommandSorting = false
 	cdc := app.MakeCodec()
 
+	// gaia uses the default address prefixes
+	sdk.GetConfig().Seal()
+
 	// TODO: setup keybase, viper object, etc. to be passed into
 	// the below functions and eliminate global vars, like we do
 	// with the cdc
@@ -88,13 +92,15 @@ func main() {
 			stakecmd.GetCmdQueryValidators("stake", cdc),
 			stakecmd.GetCmdQueryDelegation("stake", cdc),
 			stakecmd.GetCmdQueryDelegations("stake", cdc),
+			stakecmd.GetCmdQueryUnbondingDelegations("stake", cdc),
+			stakecmd.GetCmdQueryRedelegations("stake", cdc),
 			slashingcmd.GetCmdQuerySigningInfo("slashing", cdc),
 		)...)
 	stakeCmd.AddCommand(
 		client.PostCommands(
-			stakecmd.GetCmdCreateValidator(cdc),
+			stakecmd.GetCmdCreateValidator("supply", cdc),
 			stakecmd.GetCmdEditValidator(cdc),
-			stakecmd.GetCmdDelegate(cdc),
+			stakecmd.GetCmdDelegate("supply", cdc),
 			stakecmd.GetCmdUnbond("stake", cdc),
 			stakecmd.GetCmdRedelegate("stake", cdc),
 			slashingcmd.GetCmdUnrevoke(cdc),
@@ -112,11 +118,17 @@ func main() {
 		client.GetCommands(
 			govcmd.GetCmdQueryProposal("gov", cdc),
 			govcmd.GetCmdQueryVote("gov", cdc),
+			govcmd.GetCmdQueryTally("gov", cdc),
+			govcmd.GetCmdQueryProposals("gov", cdc),
+			govcmd.GetCmdQueryVotes("gov", cdc),
+			govcmd.GetCmdQueryDeposit("gov", cdc),
+			govcmd.GetCmdQueryDeposits("gov", cdc),
+			govcmd.GetCmdQueryParams("gov", cdc),
 		)...)
 	govCmd.AddCommand(
 		client.PostCommands(
-			govcmd.GetCmdSubmitProposal(cdc),
-			govcmd.GetCmdDeposit(cdc),
+			govcmd.GetCmdSubmitProposal("supply", cdc),
+			govcmd.GetCmdDeposit("supply", cdc),
 			govcmd.GetCmdVote(cdc),
 		)...)
 	rootCmd.AddCommand(
@@ -127,20 +139,27 @@ func main() {
 	rootCmd.AddCommand(
 		client.GetCommands(
 			authcmd.GetAccountCmd("acc", cdc, authcmd.GetAccountDecoder(cdc)),
+			authcmd.GetAccountsCmd("acc", cdc),
+			bankcmd.GetCmdQuerySupply("supply", cdc),
+			bankcmd.GetCmdQueryDenomMetadata("supply", cdc),
+			bankcmd.GetCmdQueryBalance("supply", cdc),
 		)...)
 	rootCmd.AddCommand(
 		client.PostCommands(
-			bankcmd.SendTxCmd(cdc),
+			bankcmd.SendTxCmd("supply", cdc),
+			bankcmd.IssueTxCmd("supply", cdc),
 		)...)
 
-	// add proxy, version and key info
+	// add proxy, version, key and error info
 	rootCmd.AddCommand(
 		keys.Commands(),
 		client.LineBreak,
+		client.ErrorsCmd,
 		version.VersionCmd,
 	)
 
 	// prepare and add flags
+	client.AddKeyringFlags(rootCmd)
 	executor := cli.PrepareMainCmd(rootCmd, "GA", app.DefaultCLIHome)
 	err := executor.Execute()
 	if err != nil {
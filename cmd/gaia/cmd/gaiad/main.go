@@ -2,6 +2,7 @@ package main
 
 import (
 	"encoding/json"
+	"fmt"
 	"io"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
@@ -17,11 +18,17 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/cmd/gaia/app"
 	"github.com/cosmos/cosmos-sdk/server"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/wire"
 )
 
 func main() {
 	cdc := app.MakeCodec()
 	ctx := server.NewDefaultContext()
+
+	// gaia uses the default address prefixes
+	sdk.GetConfig().Seal()
+
 	cobra.EnableCommandSorting = false
 	rootCmd := &cobra.Command{
 		Use:               "gaiad",
@@ -32,6 +39,7 @@ func main() {
 	server.AddCommands(ctx, cdc, rootCmd, app.GaiaAppInit(),
 		server.ConstructAppCreator(newApp, "gaia"),
 		server.ConstructAppExporter(exportAppStateAndTMValidators, "gaia"))
+	rootCmd.AddCommand(migrateGenesisCmd(cdc))
 
 	// prepare and add flags
 	executor := cli.PrepareBaseCmd(rootCmd, "GA", app.DefaultNodeHome)
@@ -52,3 +60,32 @@ func exportAppStateAndTMValidators(
 	gApp := app.NewGaiaApp(logger, db, traceStore)
 	return gApp.ExportAppStateAndValidators()
 }
+
+// migrateGenesisCmd prints a genesis file written before the staking state
+// moved from sdk.Rat to sdk.Dec with its app state converted
+func migrateGenesisCmd(cdc *wire.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "migrate-genesis [genesis-file]",
+		Short: "Convert the staking state of an older genesis file to decimals",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			doc, err := tmtypes.GenesisDocFromFile(args[0])
+			if err != nil {
+				return err
+			}
+
+			doc.AppStateJSON, err = app.MigrateRatAppState(cdc, doc.AppStateJSON)
+			if err != nil {
+				return err
+			}
+
+			encoded, err := wire.MarshalJSONIndent(cdc, doc)
+			if err != nil {
+				return err
+			}
+
+			fmt.Println(string(encoded))
+			return nil
+		},
+	}
+}
@@ -142,6 +142,7 @@ func runPubKeyCmd(cmd *cobra.Command, args []string) error {
 	fmt.Println("JSON (base64):", string(pubKeyJSONBytes))
 	fmt.Println("Bech32 Acc:", accPub)
 	fmt.Println("Bech32 Val:", valPub)
+	fmt.Println("Bech32 Cons Address:", sdk.GetConsAddress(pubKey))
 	return nil
 }
 
@@ -164,22 +165,30 @@ func runAddrCmd(cmd *cobra.Command, args []string) error {
 			addr, err3 = sdk.ValAddressFromBech32(addrString)
 
 			if err3 != nil {
-				return fmt.Errorf(`Expected hex or bech32. Got errors:
+				var err4 error
+				addr, err4 = sdk.ConsAddressFromBech32(addrString)
+
+				if err4 != nil {
+					return fmt.Errorf(`Expected hex or bech32. Got errors:
 			hex: %v,
 			bech32 acc: %v
 			bech32 val: %v
-			`, err, err2, err3)
+			bech32 cons: %v
+			`, err, err2, err3, err4)
 
+				}
 			}
 		}
 	}
 
 	accAddr := sdk.AccAddress(addr)
 	valAddr := sdk.ValAddress(addr)
+	consAddr := sdk.ConsAddress(addr)
 
 	fmt.Println("Address:", addr)
 	fmt.Println("Bech32 Acc:", accAddr)
 	fmt.Println("Bech32 Val:", valAddr)
+	fmt.Println("Bech32 Cons:", consAddr)
 	return nil
 }
 
@@ -47,6 +47,17 @@ func NewMnemonic(len ValidSentenceLen) (words []string, err error) {
 	return
 }
 
+// NewMnemonicFromEntropy returns the mnemonic words encoding the entropy,
+// which must be 128 to 256 bits long, in steps of 32 bits.
+func NewMnemonicFromEntropy(entropy []byte) (words []string, err error) {
+	mnemonic, err := bip39.NewMnemonic(entropy)
+	if err != nil {
+		return
+	}
+	words = strings.Split(mnemonic, " ")
+	return
+}
+
 // MnemonicToSeed creates a BIP 39 seed from the passed mnemonic (with an empty BIP 39 password).
 // This method does not validate the mnemonics checksum.
 func MnemonicToSeed(mne string) (seed []byte) {
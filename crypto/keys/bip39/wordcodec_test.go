@@ -1,6 +1,7 @@
 package bip39
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -13,3 +14,14 @@ func TestWordCodec_NewMnemonic(t *testing.T) {
 	_, err = NewMnemonic(FreshKey)
 	require.NoError(t, err, "unexpected error generating new 24-word mnemonic")
 }
+
+func TestWordCodec_NewMnemonicFromEntropy(t *testing.T) {
+	words, err := NewMnemonicFromEntropy(make([]byte, 32))
+	require.NoError(t, err)
+	require.Equal(t, int(FreshKey), len(words))
+	_, err = MnemonicToSeedWithErrChecking(strings.Join(words, " "))
+	require.NoError(t, err)
+
+	_, err = NewMnemonicFromEntropy(make([]byte, 10))
+	require.Error(t, err)
+}
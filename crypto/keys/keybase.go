@@ -55,12 +55,26 @@ var (
 // a full-featured key manager
 type dbKeybase struct {
 	db dbm.DB
+	// kdf derives the keys encrypting the stored private keys
+	kdf string
 }
 
 // New creates a new keybase instance using the passed DB for reading and writing keys.
 func New(db dbm.DB) Keybase {
 	return dbKeybase{
-		db: db,
+		db:  db,
+		kdf: kdfBcrypt,
+	}
+}
+
+// NewFileKeybase creates a new keybase instance storing every key in its own
+// file under dir, readable by the owner only. The private keys are encrypted
+// with a key derived from the passphrase by PBKDF2, so the keybase needs
+// neither LevelDB nor any OS specific key store.
+func NewFileKeybase(dir string) Keybase {
+	return dbKeybase{
+		db:  dbm.NewFSDB(dir),
+		kdf: kdfPBKDF2,
 	}
 }
 
@@ -266,6 +280,39 @@ func (kb dbKeybase) ExportPrivateKeyObject(name string, passphrase string) (tmcr
 	return priv, nil
 }
 
+// ExportPrivKey returns the private key of a local key in ASCII armored
+// format, decrypted with decryptPassphrase and encrypted again with
+// encryptPassphrase, so the export doesn't reveal the passphrase of the key.
+func (kb dbKeybase) ExportPrivKey(name, decryptPassphrase, encryptPassphrase string) (armor string, err error) {
+	priv, err := kb.ExportPrivateKeyObject(name, decryptPassphrase)
+	if err != nil {
+		return "", err
+	}
+	return encryptArmorPrivKey(priv, encryptPassphrase, kb.kdf), nil
+}
+
+// ImportPrivKey decrypts a private key exported by ExportPrivKey and stores
+// it encrypted with passphrase.
+func (kb dbKeybase) ImportPrivKey(name, armor, decryptPassphrase, passphrase string) (Info, error) {
+	priv, err := unarmorDecryptPrivKey(armor, decryptPassphrase)
+	if err != nil {
+		return nil, errors.Wrap(err, "couldn't decrypt the private key")
+	}
+	return kb.ImportPrivateKeyObject(name, priv, passphrase)
+}
+
+// ImportPrivateKeyObject stores the private key encrypted with passphrase.
+// Only secp256k1 keys can be imported.
+func (kb dbKeybase) ImportPrivateKeyObject(name string, priv tmcrypto.PrivKey, passphrase string) (Info, error) {
+	if len(kb.db.Get(infoKey(name))) > 0 {
+		return nil, errors.New("Cannot overwrite data for name " + name)
+	}
+	if _, ok := priv.(tmcrypto.PrivKeySecp256k1); !ok {
+		return nil, ErrUnsupportedSigningAlgo
+	}
+	return kb.writeLocalKey(priv, name, passphrase), nil
+}
+
 func (kb dbKeybase) Export(name string) (armor string, err error) {
 	bz := kb.db.Get(infoKey(name))
 	if bz == nil {
@@ -383,7 +430,7 @@ func (kb dbKeybase) Update(name, oldpass string, getNewpass func() (string, erro
 
 func (kb dbKeybase) writeLocalKey(priv tmcrypto.PrivKey, name, passphrase string) Info {
 	// encrypt private key using passphrase
-	privArmor := encryptArmorPrivKey(priv, passphrase)
+	privArmor := encryptArmorPrivKey(priv, passphrase, kb.kdf)
 	// make Info
 	pub := priv.PubKey()
 	info := newLocalInfo(name, pub, privArmor)
@@ -2,6 +2,8 @@ package keys
 
 import (
 	"fmt"
+	"io/ioutil"
+	"os"
 	"testing"
 
 	"github.com/cosmos/cosmos-sdk/crypto/keys/hd"
@@ -207,6 +209,47 @@ func TestExportImport(t *testing.T) {
 }
 
 //
+// TestExportImportPrivKey checks the private keys are exported encrypted with
+// a new passphrase
+func TestExportImportPrivKey(t *testing.T) {
+	cstore := New(
+		dbm.NewMemDB(),
+	)
+
+	info, _, err := cstore.CreateMnemonic("john", English, "secretcpw", Secp256k1)
+	require.NoError(t, err)
+
+	_, err = cstore.ExportPrivKey("john", "wrongpw", "exportpw")
+	require.Error(t, err)
+	armor, err := cstore.ExportPrivKey("john", "secretcpw", "exportpw")
+	require.NoError(t, err)
+
+	// the export is decrypted with its own passphrase
+	_, err = cstore.ImportPrivKey("john2", armor, "secretcpw", "newpw")
+	require.Error(t, err)
+	john2, err := cstore.ImportPrivKey("john2", armor, "exportpw", "newpw")
+	require.NoError(t, err)
+	require.Equal(t, info.GetPubKey(), john2.GetPubKey())
+	_, err = cstore.ImportPrivKey("john2", armor, "exportpw", "newpw")
+	require.Error(t, err, "overwriting a key")
+
+	// and stored with the new one
+	d := []byte("imported")
+	sig, pub, err := cstore.Sign("john2", "newpw", d)
+	require.NoError(t, err)
+	require.True(t, pub.VerifyBytes(d, sig))
+	_, _, err = cstore.Sign("john2", "exportpw", d)
+	require.Error(t, err)
+
+	// raw private keys can be imported as well
+	priv := crypto.GenPrivKeySecp256k1()
+	raw, err := cstore.ImportPrivateKeyObject("raw", priv, "rawpw")
+	require.NoError(t, err)
+	require.Equal(t, priv.PubKey(), raw.GetPubKey())
+	_, err = cstore.ImportPrivateKeyObject("ed", crypto.GenPrivKeyEd25519(), "rawpw")
+	require.Equal(t, ErrUnsupportedSigningAlgo, err)
+}
+
 func TestExportImportPubKey(t *testing.T) {
 	// make the storage with reasonable defaults
 	db := dbm.NewMemDB()
@@ -298,6 +341,59 @@ func TestAdvancedKeyManagement(t *testing.T) {
 	require.NotNil(t, err)
 }
 
+// TestFileKeybase checks the keys of the file keybase persist across
+// instances and can be moved to a db keybase
+func TestFileKeybase(t *testing.T) {
+	dir, err := ioutil.TempDir("", "file_keybase")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	// keep the test fast
+	defaultIterations := PBKDF2Iterations
+	PBKDF2Iterations = 16
+	defer func() { PBKDF2Iterations = defaultIterations }()
+
+	n1, n2, p1, p2 := "alice", "bob", "1234", "foobar"
+	cstore := NewFileKeybase(dir)
+	i1, _, err := cstore.CreateMnemonic(n1, English, p1, Secp256k1)
+	require.NoError(t, err)
+	_, _, err = cstore.CreateMnemonic(n2, English, p2, Secp256k1)
+	require.NoError(t, err)
+
+	// the keys are encrypted with a PBKDF2 derived key
+	info, err := cstore.Get(n1)
+	require.NoError(t, err)
+	_, header, _, err := crypto.DecodeArmor(info.(localInfo).PrivKeyArmor)
+	require.NoError(t, err)
+	require.Equal(t, kdfPBKDF2, header["kdf"])
+	require.Equal(t, "16", header["iterations"])
+
+	// another instance reads the same keys
+	cstore = NewFileKeybase(dir)
+	keyS, err := cstore.List()
+	require.NoError(t, err)
+	require.Equal(t, 2, len(keyS))
+	require.Equal(t, n1, keyS[0].GetName())
+	require.Equal(t, n2, keyS[1].GetName())
+	assertPassword(t, cstore, n1, p1, p2)
+
+	// the key can be moved to a db keybase, keeping its passphrase
+	armor, err := cstore.Export(n1)
+	require.NoError(t, err)
+	dbstore := New(dbm.NewMemDB())
+	require.NoError(t, dbstore.Import(n1, armor))
+	d := []byte("moved")
+	sig, pub, err := dbstore.Sign(n1, p1, d)
+	require.NoError(t, err)
+	require.Equal(t, i1.GetPubKey(), pub)
+	require.True(t, pub.VerifyBytes(d, sig))
+
+	require.NoError(t, cstore.Delete(n1, p1))
+	keyS, err = NewFileKeybase(dir).List()
+	require.NoError(t, err)
+	require.Equal(t, 1, len(keyS))
+}
+
 // TestSeedPhrase verifies restoring from a seed phrase
 func TestSeedPhrase(t *testing.T) {
 
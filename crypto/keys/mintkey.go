@@ -1,10 +1,13 @@
 package keys
 
 import (
+	"crypto/sha256"
 	"encoding/hex"
 	"fmt"
+	"strconv"
 
 	cmn "github.com/tendermint/tendermint/libs/common"
+	"golang.org/x/crypto/pbkdf2"
 
 	"github.com/cosmos/cosmos-sdk/crypto/keys/bcrypt"
 	"github.com/tendermint/tendermint/crypto"
@@ -16,6 +19,14 @@ const (
 	blockTypePubKey  = "TENDERMINT PUBLIC KEY"
 )
 
+// key derivation functions turning a passphrase into the symmetric key
+// encrypting a private key. The kdf is recorded in the armor header, so any
+// keybase can decrypt the keys encrypted by another one.
+const (
+	kdfBcrypt = "bcrypt"
+	kdfPBKDF2 = "pbkdf2"
+)
+
 // Make bcrypt security parameter var, so it can be changed within the lcd test
 // Making the bcrypt security parameter a var shouldn't be a security issue:
 // One can't verify an invalid key by maliciously changing the bcrypt
@@ -31,6 +42,12 @@ const (
 // TODO: Consider increasing default
 var BcryptSecurityParameter = 12
 
+// PBKDF2Iterations is the number of PBKDF2-HMAC-SHA256 iterations deriving
+// the encryption key of the private keys stored by the file keybase. The
+// number is recorded in the armor header, so it can be raised without
+// breaking the keys encrypted before, and lowered within tests.
+var PBKDF2Iterations = 1 << 18
+
 func armorInfoBytes(bz []byte) string {
 	return armorBytes(bz, blockTypeKeyInfo)
 }
@@ -71,12 +88,19 @@ func unarmorBytes(armorStr, blockType string) (bz []byte, err error) {
 	return
 }
 
-func encryptArmorPrivKey(privKey crypto.PrivKey, passphrase string) string {
-	saltBytes, encBytes := encryptPrivKey(privKey, passphrase)
+func encryptArmorPrivKey(privKey crypto.PrivKey, passphrase, kdf string) string {
 	header := map[string]string{
-		"kdf":  "bcrypt",
-		"salt": fmt.Sprintf("%X", saltBytes),
+		"kdf": kdf,
 	}
+	var saltBytes, encBytes []byte
+	switch kdf {
+	case kdfPBKDF2:
+		header["iterations"] = strconv.Itoa(PBKDF2Iterations)
+		saltBytes, encBytes = encryptPrivKeyPBKDF2(privKey, passphrase, PBKDF2Iterations)
+	default:
+		saltBytes, encBytes = encryptPrivKey(privKey, passphrase)
+	}
+	header["salt"] = fmt.Sprintf("%X", saltBytes)
 	armorStr := crypto.EncodeArmor(blockTypePrivKey, header, encBytes)
 	return armorStr
 }
@@ -90,8 +114,8 @@ func unarmorDecryptPrivKey(armorStr string, passphrase string) (crypto.PrivKey,
 	if blockType != blockTypePrivKey {
 		return privKey, fmt.Errorf("Unrecognized armor type: %v", blockType)
 	}
-	if header["kdf"] != "bcrypt" {
-		return privKey, fmt.Errorf("Unrecognized KDF type: %v", header["KDF"])
+	if header["kdf"] != kdfBcrypt && header["kdf"] != kdfPBKDF2 {
+		return privKey, fmt.Errorf("Unrecognized KDF type: %v", header["kdf"])
 	}
 	if header["salt"] == "" {
 		return privKey, fmt.Errorf("Missing salt bytes")
@@ -100,6 +124,13 @@ func unarmorDecryptPrivKey(armorStr string, passphrase string) (crypto.PrivKey,
 	if err != nil {
 		return privKey, fmt.Errorf("Error decoding salt: %v", err.Error())
 	}
+	if header["kdf"] == kdfPBKDF2 {
+		iterations, err := strconv.Atoi(header["iterations"])
+		if err != nil || iterations < 1 {
+			return privKey, fmt.Errorf("Invalid PBKDF2 iterations: %v", header["iterations"])
+		}
+		return decryptPrivKeyPBKDF2(saltBytes, encBytes, passphrase, iterations)
+	}
 	privKey, err = decryptPrivKey(saltBytes, encBytes, passphrase)
 	return privKey, err
 }
@@ -128,3 +159,18 @@ func decryptPrivKey(saltBytes []byte, encBytes []byte, passphrase string) (privK
 	privKey, err = crypto.PrivKeyFromBytes(privKeyBytes)
 	return privKey, err
 }
+
+func encryptPrivKeyPBKDF2(privKey crypto.PrivKey, passphrase string, iterations int) (saltBytes []byte, encBytes []byte) {
+	saltBytes = crypto.CRandBytes(16)
+	key := pbkdf2.Key([]byte(passphrase), saltBytes, iterations, 32, sha256.New)
+	return saltBytes, crypto.EncryptSymmetric(privKey.Bytes(), key)
+}
+
+func decryptPrivKeyPBKDF2(saltBytes []byte, encBytes []byte, passphrase string, iterations int) (privKey crypto.PrivKey, err error) {
+	key := pbkdf2.Key([]byte(passphrase), saltBytes, iterations, 32, sha256.New)
+	privKeyBytes, err := crypto.DecryptSymmetric(encBytes, key)
+	if err != nil {
+		return privKey, err
+	}
+	return crypto.PrivKeyFromBytes(privKeyBytes)
+}
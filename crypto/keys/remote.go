@@ -0,0 +1,230 @@
+package keys
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"net"
+	"time"
+
+	"github.com/pkg/errors"
+	tmcrypto "github.com/tendermint/tendermint/crypto"
+
+	"github.com/cosmos/cosmos-sdk/crypto"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/hd"
+)
+
+var _ Keybase = remoteKeybase{}
+
+// ErrRemoteSignerUnsupported is returned by the remote signer keybase for the
+// operations managing keys, which are done on the signer itself.
+var ErrRemoteSignerUnsupported = errors.New("unsupported by the remote signer keybase: keys are managed by the signer")
+
+// Methods of the remote signer protocol.
+//
+// The keybase connects to the Unix socket of the signer for every request,
+// writes the request as one line of JSON, and reads the response as one line
+// of JSON, after which the connection is closed. Public keys and signatures
+// are in the amino JSON encoding of Tendermint, and msg bytes in base64.
+//
+//	list: {"method":"list"}
+//	      -> {"keys":[{"name":"...","pub_key":{...}}, ...]}
+//	get:  {"method":"get","name":"..."}
+//	      -> {"keys":[{"name":"...","pub_key":{...}}]}
+//	sign: {"method":"sign","name":"...","passphrase":"...","msg":"<base64>"}
+//	      -> {"signature":{...},"pub_key":{...}}
+//
+// A failed request is answered with {"error":"..."}. The signer is free to
+// ignore the passphrase, e.g. to ask an operator to confirm the signature.
+const (
+	RemoteSignerMethodList = "list"
+	RemoteSignerMethodGet  = "get"
+	RemoteSignerMethodSign = "sign"
+)
+
+// RemoteSignerTimeout bounds the time a request to the remote signer can take,
+// including the time the signer waits for a confirmation
+var RemoteSignerTimeout = 2 * time.Minute
+
+// RemoteSignerRequest is a request of the remote signer protocol
+type RemoteSignerRequest struct {
+	Method     string `json:"method"`
+	Name       string `json:"name,omitempty"`
+	Passphrase string `json:"passphrase,omitempty"`
+	Msg        []byte `json:"msg,omitempty"`
+}
+
+// RemoteSignerKey is a key held by the remote signer
+type RemoteSignerKey struct {
+	Name   string          `json:"name"`
+	PubKey tmcrypto.PubKey `json:"pub_key"`
+}
+
+// RemoteSignerResponse is a response of the remote signer protocol
+type RemoteSignerResponse struct {
+	Keys      []RemoteSignerKey  `json:"keys,omitempty"`
+	Signature tmcrypto.Signature `json:"signature"`
+	PubKey    tmcrypto.PubKey    `json:"pub_key"`
+	Error     string             `json:"error,omitempty"`
+}
+
+// remoteKeybase is a keybase whose keys are held by a separate signer
+// process, listening on a Unix socket
+type remoteKeybase struct {
+	socket string
+}
+
+// NewRemoteKeybase creates a new keybase instance forwarding the listing and
+// the signing of keys to the signer listening on the Unix socket.
+func NewRemoteKeybase(socket string) Keybase {
+	return remoteKeybase{
+		socket: socket,
+	}
+}
+
+// List returns the keys held by the signer.
+func (kb remoteKeybase) List() ([]Info, error) {
+	res, err := kb.request(RemoteSignerRequest{Method: RemoteSignerMethodList})
+	if err != nil {
+		return nil, err
+	}
+	infos := make([]Info, len(res.Keys))
+	for i, key := range res.Keys {
+		infos[i] = newRemoteInfo(key.Name, key.PubKey)
+	}
+	return infos, nil
+}
+
+// Get returns the public information about one key held by the signer.
+func (kb remoteKeybase) Get(name string) (Info, error) {
+	res, err := kb.request(RemoteSignerRequest{Method: RemoteSignerMethodGet, Name: name})
+	if err != nil {
+		return nil, err
+	}
+	if len(res.Keys) != 1 || res.Keys[0].PubKey == nil {
+		return nil, fmt.Errorf("Key %s not found", name)
+	}
+	return newRemoteInfo(res.Keys[0].Name, res.Keys[0].PubKey), nil
+}
+
+// Sign asks the signer to sign the msg with the named key.
+func (kb remoteKeybase) Sign(name, passphrase string, msg []byte) (tmcrypto.Signature, tmcrypto.PubKey, error) {
+	res, err := kb.request(RemoteSignerRequest{
+		Method:     RemoteSignerMethodSign,
+		Name:       name,
+		Passphrase: passphrase,
+		Msg:        msg,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	if res.Signature == nil || res.PubKey == nil {
+		return nil, nil, errors.New("remote signer returned no signature")
+	}
+	return res.Signature, res.PubKey, nil
+}
+
+// ExportPubKey returns the public key of a key held by the signer in ASCII
+// armored format.
+func (kb remoteKeybase) ExportPubKey(name string) (armor string, err error) {
+	info, err := kb.Get(name)
+	if err != nil {
+		return
+	}
+	return armorPubKeyBytes(info.GetPubKey().Bytes()), nil
+}
+
+func (kb remoteKeybase) Delete(name, passphrase string) error {
+	return ErrRemoteSignerUnsupported
+}
+
+func (kb remoteKeybase) CreateMnemonic(name string, language Language, passwd string, algo SigningAlgo) (Info, string, error) {
+	return nil, "", ErrRemoteSignerUnsupported
+}
+
+func (kb remoteKeybase) CreateKey(name, mnemonic, passwd string) (Info, error) {
+	return nil, ErrRemoteSignerUnsupported
+}
+
+func (kb remoteKeybase) CreateFundraiserKey(name, mnemonic, passwd string) (Info, error) {
+	return nil, ErrRemoteSignerUnsupported
+}
+
+func (kb remoteKeybase) Derive(name, mnemonic, passwd string, params hd.BIP44Params) (Info, error) {
+	return nil, ErrRemoteSignerUnsupported
+}
+
+func (kb remoteKeybase) CreateLedger(name string, path crypto.DerivationPath, algo SigningAlgo) (Info, error) {
+	return nil, ErrRemoteSignerUnsupported
+}
+
+func (kb remoteKeybase) CreateOffline(name string, pubkey tmcrypto.PubKey) (Info, error) {
+	return nil, ErrRemoteSignerUnsupported
+}
+
+func (kb remoteKeybase) Update(name, oldpass string, getNewpass func() (string, error)) error {
+	return ErrRemoteSignerUnsupported
+}
+
+func (kb remoteKeybase) Import(name string, armor string) error {
+	return ErrRemoteSignerUnsupported
+}
+
+func (kb remoteKeybase) ImportPubKey(name string, armor string) error {
+	return ErrRemoteSignerUnsupported
+}
+
+func (kb remoteKeybase) Export(name string) (string, error) {
+	return "", ErrRemoteSignerUnsupported
+}
+
+func (kb remoteKeybase) ExportPrivateKeyObject(name string, passphrase string) (tmcrypto.PrivKey, error) {
+	return nil, ErrRemoteSignerUnsupported
+}
+
+func (kb remoteKeybase) ExportPrivKey(name, decryptPassphrase, encryptPassphrase string) (string, error) {
+	return "", ErrRemoteSignerUnsupported
+}
+
+func (kb remoteKeybase) ImportPrivKey(name, armor, decryptPassphrase, passphrase string) (Info, error) {
+	return nil, ErrRemoteSignerUnsupported
+}
+
+func (kb remoteKeybase) ImportPrivateKeyObject(name string, priv tmcrypto.PrivKey, passphrase string) (Info, error) {
+	return nil, ErrRemoteSignerUnsupported
+}
+
+// request sends a request to the signer and reads its response
+func (kb remoteKeybase) request(req RemoteSignerRequest) (res RemoteSignerResponse, err error) {
+	conn, err := net.DialTimeout("unix", kb.socket, RemoteSignerTimeout)
+	if err != nil {
+		return res, errors.Wrap(err, "couldn't connect to the remote signer")
+	}
+	defer conn.Close()
+	err = conn.SetDeadline(time.Now().Add(RemoteSignerTimeout))
+	if err != nil {
+		return res, err
+	}
+
+	bz, err := cdc.MarshalJSON(req)
+	if err != nil {
+		return res, err
+	}
+	_, err = conn.Write(append(bz, '\n'))
+	if err != nil {
+		return res, errors.Wrap(err, "couldn't send the request to the remote signer")
+	}
+
+	line, err := bufio.NewReader(conn).ReadBytes('\n')
+	if err != nil {
+		return res, errors.Wrap(err, "couldn't read the response of the remote signer")
+	}
+	err = cdc.UnmarshalJSON(bytes.TrimSpace(line), &res)
+	if err != nil {
+		return res, errors.Wrap(err, "invalid response of the remote signer")
+	}
+	if res.Error != "" {
+		return res, fmt.Errorf("remote signer: %s", res.Error)
+	}
+	return res, nil
+}
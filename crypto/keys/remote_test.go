@@ -0,0 +1,139 @@
+package keys
+
+import (
+	"bufio"
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto"
+)
+
+// fakeSigner is an in-process remote signer holding its keys in memory
+type fakeSigner struct {
+	listener net.Listener
+	keys     map[string]crypto.PrivKey
+	names    []string
+	passwd   string
+}
+
+func newFakeSigner(t *testing.T, socket, passwd string, names ...string) *fakeSigner {
+	listener, err := net.Listen("unix", socket)
+	require.NoError(t, err)
+	signer := &fakeSigner{
+		listener: listener,
+		keys:     make(map[string]crypto.PrivKey),
+		names:    names,
+		passwd:   passwd,
+	}
+	for _, name := range names {
+		signer.keys[name] = crypto.GenPrivKeySecp256k1()
+	}
+	go signer.serve()
+	return signer
+}
+
+func (s *fakeSigner) serve() {
+	for {
+		conn, err := s.listener.Accept()
+		if err != nil {
+			return
+		}
+		line, err := bufio.NewReader(conn).ReadBytes('\n')
+		if err == nil {
+			var req RemoteSignerRequest
+			var res RemoteSignerResponse
+			if err := cdc.UnmarshalJSON(line, &req); err != nil {
+				res.Error = err.Error()
+			} else {
+				res = s.handle(req)
+			}
+			bz := cdc.MustMarshalJSON(res)
+			conn.Write(append(bz, '\n'))
+		}
+		conn.Close()
+	}
+}
+
+func (s *fakeSigner) handle(req RemoteSignerRequest) (res RemoteSignerResponse) {
+	switch req.Method {
+	case RemoteSignerMethodList:
+		for _, name := range s.names {
+			res.Keys = append(res.Keys, RemoteSignerKey{name, s.keys[name].PubKey()})
+		}
+	case RemoteSignerMethodGet:
+		if priv, ok := s.keys[req.Name]; ok {
+			res.Keys = []RemoteSignerKey{{req.Name, priv.PubKey()}}
+		} else {
+			res.Error = "unknown key " + req.Name
+		}
+	case RemoteSignerMethodSign:
+		priv, ok := s.keys[req.Name]
+		if !ok || req.Passphrase != s.passwd {
+			res.Error = "invalid key or passphrase"
+			return
+		}
+		sig, err := priv.Sign(req.Msg)
+		if err != nil {
+			res.Error = err.Error()
+			return
+		}
+		res.Signature, res.PubKey = sig, priv.PubKey()
+	default:
+		res.Error = "unknown method " + req.Method
+	}
+	return
+}
+
+func TestRemoteKeybase(t *testing.T) {
+	dir, err := ioutil.TempDir("", "remote_signer")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	socket := filepath.Join(dir, "signer.sock")
+	n1, n2, passwd := "operator", "backup", "1234"
+	signer := newFakeSigner(t, socket, passwd, n1, n2)
+	defer signer.listener.Close()
+
+	kb := NewRemoteKeybase(socket)
+
+	infos, err := kb.List()
+	require.NoError(t, err)
+	require.Equal(t, 2, len(infos))
+	require.Equal(t, n1, infos[0].GetName())
+	require.Equal(t, "remote", infos[0].GetType())
+	require.Equal(t, signer.keys[n1].PubKey(), infos[0].GetPubKey())
+
+	info, err := kb.Get(n2)
+	require.NoError(t, err)
+	require.Equal(t, signer.keys[n2].PubKey(), info.GetPubKey())
+	_, err = kb.Get("unknown")
+	require.Error(t, err)
+
+	msg := []byte("sign me")
+	sig, pub, err := kb.Sign(n1, passwd, msg)
+	require.NoError(t, err)
+	require.Equal(t, signer.keys[n1].PubKey(), pub)
+	require.True(t, pub.VerifyBytes(msg, sig))
+	_, _, err = kb.Sign(n1, "bad", msg)
+	require.Error(t, err)
+
+	armor, err := kb.ExportPubKey(n2)
+	require.NoError(t, err)
+	pubBytes, err := unarmorPubKeyBytes(armor)
+	require.NoError(t, err)
+	require.Equal(t, signer.keys[n2].PubKey().Bytes(), pubBytes)
+
+	// keys are managed on the signer
+	_, _, err = kb.CreateMnemonic("new", English, passwd, Secp256k1)
+	require.Equal(t, ErrRemoteSignerUnsupported, err)
+	require.Equal(t, ErrRemoteSignerUnsupported, kb.Delete(n1, passwd))
+
+	// a stopped signer can't be reached
+	signer.listener.Close()
+	_, err = kb.List()
+	require.Error(t, err)
+}
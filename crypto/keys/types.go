@@ -42,6 +42,16 @@ type Keybase interface {
 
 	// *only* works on locally-stored keys. Temporary method until we redo the exporting API
 	ExportPrivateKeyObject(name string, passphrase string) (crypto.PrivKey, error)
+
+	// ExportPrivKey returns the private key of a locally-stored key in ASCII
+	// armored format, encrypted with encryptPassphrase instead of the
+	// passphrase it is stored with
+	ExportPrivKey(name, decryptPassphrase, encryptPassphrase string) (armor string, err error)
+	// ImportPrivKey stores a private key exported by ExportPrivKey, encrypted
+	// with passphrase instead of the passphrase of the export
+	ImportPrivKey(name, armor, decryptPassphrase, passphrase string) (Info, error)
+	// ImportPrivateKeyObject stores a private key, encrypted with passphrase
+	ImportPrivateKeyObject(name string, priv crypto.PrivKey, passphrase string) (Info, error)
 }
 
 // Info is the publicly exposed information about a keypair
@@ -57,6 +67,7 @@ type Info interface {
 var _ Info = &localInfo{}
 var _ Info = &ledgerInfo{}
 var _ Info = &offlineInfo{}
+var _ Info = &remoteInfo{}
 
 // localInfo is the public information about a locally stored key
 type localInfo struct {
@@ -137,6 +148,31 @@ func (i offlineInfo) GetPubKey() crypto.PubKey {
 	return i.PubKey
 }
 
+// remoteInfo is the public information about a key held by a remote signer
+type remoteInfo struct {
+	Name   string        `json:"name"`
+	PubKey crypto.PubKey `json:"pubkey"`
+}
+
+func newRemoteInfo(name string, pub crypto.PubKey) Info {
+	return &remoteInfo{
+		Name:   name,
+		PubKey: pub,
+	}
+}
+
+func (i remoteInfo) GetType() string {
+	return "remote"
+}
+
+func (i remoteInfo) GetName() string {
+	return i.Name
+}
+
+func (i remoteInfo) GetPubKey() crypto.PubKey {
+	return i.PubKey
+}
+
 // encoding info
 func writeInfo(i Info) []byte {
 	return cdc.MustMarshalBinary(i)
@@ -19,8 +19,24 @@ import (
 
 const (
 	appName = "BasecoinApp"
+
+	// bech32 prefixes of the basecoin addresses and public keys
+	Bech32PrefixAccAddr  = "baseacc"
+	Bech32PrefixAccPub   = "baseaccpub"
+	Bech32PrefixValAddr  = "baseval"
+	Bech32PrefixValPub   = "basevalpub"
+	Bech32PrefixConsAddr = "basecons"
+	Bech32PrefixConsPub  = "baseconspub"
 )
 
+// SetBech32Prefixes sets the basecoin bech32 prefixes on the sdk config. It
+// must be called at startup, before the config is sealed.
+func SetBech32Prefixes(config *sdk.Config) {
+	config.SetBech32PrefixForAccount(Bech32PrefixAccAddr, Bech32PrefixAccPub)
+	config.SetBech32PrefixForValidator(Bech32PrefixValAddr, Bech32PrefixValPub)
+	config.SetBech32PrefixForConsensusNode(Bech32PrefixConsAddr, Bech32PrefixConsPub)
+}
+
 // BasecoinApp implements an extended ABCI application. It contains a BaseApp,
 // a codec for serialization, KVStore keys for multistore state management, and
 // various mappers and keepers to manage getting, setting, and serializing the
@@ -33,6 +49,7 @@ type BasecoinApp struct {
 	keyMain    *sdk.KVStoreKey
 	keyAccount *sdk.KVStoreKey
 	keyIBC     *sdk.KVStoreKey
+	keySupply  *sdk.KVStoreKey
 
 	// manage getting and setting accounts
 	accountMapper       auth.AccountMapper
@@ -56,6 +73,7 @@ func NewBasecoinApp(logger log.Logger, db dbm.DB, baseAppOptions ...func(*bam.Ba
 		BaseApp:    bam.NewBaseApp(appName, cdc, logger, db, baseAppOptions...),
 		keyMain:    sdk.NewKVStoreKey("main"),
 		keyAccount: sdk.NewKVStoreKey("acc"),
+		keySupply:  sdk.NewKVStoreKey("supply"),
 		keyIBC:     sdk.NewKVStoreKey("ibc"),
 	}
 
@@ -65,7 +83,7 @@ func NewBasecoinApp(logger log.Logger, db dbm.DB, baseAppOptions ...func(*bam.Ba
 		app.keyAccount,        // target store
 		auth.ProtoBaseAccount, // prototype
 	)
-	app.coinKeeper = bank.NewKeeper(app.accountMapper)
+	app.coinKeeper = bank.NewKeeper(app.accountMapper, bank.NewSupplyKeeper(app.cdc, app.keySupply))
 	app.ibcMapper = ibc.NewMapper(app.cdc, app.keyIBC, app.RegisterCodespace(ibc.DefaultCodespace))
 
 	// register message routes
@@ -80,7 +98,7 @@ func NewBasecoinApp(logger log.Logger, db dbm.DB, baseAppOptions ...func(*bam.Ba
 	app.SetAnteHandler(auth.NewAnteHandler(app.accountMapper, app.feeCollectionKeeper))
 
 	// mount the multistore and load the latest state
-	app.MountStoresIAVL(app.keyMain, app.keyAccount, app.keyIBC)
+	app.MountStoresIAVL(app.keyMain, app.keyAccount, app.keyIBC, app.keySupply)
 	err := app.LoadLatestVersion(app.keyMain)
 	if err != nil {
 		cmn.Exit(err.Error())
@@ -135,6 +153,7 @@ func (app *BasecoinApp) initChainer(ctx sdk.Context, req abci.RequestInitChain)
 		panic(err)
 	}
 
+	supply := sdk.Coins{}
 	for _, gacc := range genesisState.Accounts {
 		acc, err := gacc.ToAppAccount()
 		if err != nil {
@@ -144,8 +163,12 @@ func (app *BasecoinApp) initChainer(ctx sdk.Context, req abci.RequestInitChain)
 
 		acc.AccountNumber = app.accountMapper.GetNextAccountNumber(ctx)
 		app.accountMapper.SetAccount(ctx, acc)
+		supply = supply.Plus(acc.Coins)
 	}
 
+	// all the coins are held by the genesis accounts
+	bank.InitGenesis(ctx, app.coinKeeper, bank.NewGenesisState(supply, nil, nil, nil))
+
 	return abci.ResponseInitChain{}
 }
 
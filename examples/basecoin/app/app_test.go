@@ -2,6 +2,7 @@ package app
 
 import (
 	"os"
+	"strings"
 	"testing"
 
 	"github.com/cosmos/cosmos-sdk/examples/basecoin/types"
@@ -11,6 +12,7 @@ import (
 	"github.com/stretchr/testify/require"
 	abci "github.com/tendermint/tendermint/abci/types"
 	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/libs/bech32"
 	dbm "github.com/tendermint/tendermint/libs/db"
 	"github.com/tendermint/tendermint/libs/log"
 )
@@ -79,3 +81,46 @@ func TestGenesis(t *testing.T) {
 	res = baseApp.accountMapper.GetAccount(ctx, baseAcct.Address)
 	require.Equal(t, appAcct, res)
 }
+
+func TestBech32Prefixes(t *testing.T) {
+	// the config is global, restore its prefixes for the other tests
+	config := sdk.GetConfig()
+	accAddrPrefix, accPubPrefix := config.GetBech32AccountAddrPrefix(), config.GetBech32AccountPubPrefix()
+	valAddrPrefix, valPubPrefix := config.GetBech32ValidatorAddrPrefix(), config.GetBech32ValidatorPubPrefix()
+	consAddrPrefix, consPubPrefix := config.GetBech32ConsensusAddrPrefix(), config.GetBech32ConsensusPubPrefix()
+	defer func() {
+		config.SetBech32PrefixForAccount(accAddrPrefix, accPubPrefix)
+		config.SetBech32PrefixForValidator(valAddrPrefix, valPubPrefix)
+		config.SetBech32PrefixForConsensusNode(consAddrPrefix, consPubPrefix)
+	}()
+
+	SetBech32Prefixes(config)
+
+	pubkey := crypto.GenPrivKeyEd25519().PubKey()
+	addr := sdk.AccAddress(pubkey.Address())
+	require.True(t, strings.HasPrefix(addr.String(), Bech32PrefixAccAddr+"1"))
+
+	// addresses round trip with the basecoin prefix only
+	res, err := sdk.AccAddressFromBech32(addr.String())
+	require.Nil(t, err)
+	require.Equal(t, addr, res)
+	cosmosAddr, err := bech32.ConvertAndEncode(sdk.Bech32PrefixAccAddr, addr.Bytes())
+	require.Nil(t, err)
+	_, err = sdk.AccAddressFromBech32(cosmosAddr)
+	require.NotNil(t, err)
+
+	valAddr := sdk.ValAddress(pubkey.Address())
+	require.True(t, strings.HasPrefix(valAddr.String(), Bech32PrefixValAddr+"1"))
+	consAddr := sdk.GetConsAddress(pubkey)
+	require.True(t, strings.HasPrefix(consAddr.String(), Bech32PrefixConsAddr+"1"))
+	resCons, err := sdk.ConsAddressFromBech32(consAddr.String())
+	require.Nil(t, err)
+	require.Equal(t, consAddr, resCons)
+
+	accPub, err := sdk.Bech32ifyAccPub(pubkey)
+	require.Nil(t, err)
+	require.True(t, strings.HasPrefix(accPub, Bech32PrefixAccPub+"1"))
+	resPub, err := sdk.GetAccPubKeyBech32(accPub)
+	require.Nil(t, err)
+	require.Equal(t, pubkey, resPub)
+}
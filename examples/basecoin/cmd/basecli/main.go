@@ -10,6 +10,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	"github.com/cosmos/cosmos-sdk/examples/basecoin/app"
 	"github.com/cosmos/cosmos-sdk/examples/basecoin/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/version"
 	authcmd "github.com/cosmos/cosmos-sdk/x/auth/client/cli"
 	bankcmd "github.com/cosmos/cosmos-sdk/x/bank/client/cli"
@@ -34,6 +35,11 @@ func main() {
 	// get the codec
 	cdc := app.MakeCodec()
 
+	// set the address prefixes
+	config := sdk.GetConfig()
+	app.SetBech32Prefixes(config)
+	config.Seal()
+
 	// TODO: Setup keybase, viper object, etc. to be passed into
 	// the below functions and eliminate global vars, like we do
 	// with the cdc.
@@ -52,16 +58,18 @@ func main() {
 			stakecmd.GetCmdQueryDelegation("stake", cdc),
 			stakecmd.GetCmdQueryDelegations("stake", cdc),
 			authcmd.GetAccountCmd("acc", cdc, types.GetAccountDecoder(cdc)),
+			bankcmd.GetCmdQuerySupply("supply", cdc),
+			bankcmd.GetCmdQueryDenomMetadata("supply", cdc),
 		)...)
 
 	rootCmd.AddCommand(
 		client.PostCommands(
-			bankcmd.SendTxCmd(cdc),
+			bankcmd.SendTxCmd("supply", cdc),
 			ibccmd.IBCTransferCmd(cdc),
 			ibccmd.IBCRelayCmd(cdc),
-			stakecmd.GetCmdCreateValidator(cdc),
+			stakecmd.GetCmdCreateValidator("supply", cdc),
 			stakecmd.GetCmdEditValidator(cdc),
-			stakecmd.GetCmdDelegate(cdc),
+			stakecmd.GetCmdDelegate("supply", cdc),
 			stakecmd.GetCmdUnbond("stake", cdc),
 		)...)
 
@@ -71,10 +79,12 @@ func main() {
 		lcd.ServeCommand(cdc),
 		keys.Commands(),
 		client.LineBreak,
+		client.ErrorsCmd,
 		version.VersionCmd,
 	)
 
 	// prepare and add flags
+	client.AddKeyringFlags(rootCmd)
 	executor := cli.PrepareMainCmd(rootCmd, "BC", os.ExpandEnv("$HOME/.basecli"))
 	err := executor.Execute()
 	if err != nil {
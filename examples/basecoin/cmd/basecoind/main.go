@@ -9,6 +9,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/examples/basecoin/app"
 	"github.com/cosmos/cosmos-sdk/server"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 	abci "github.com/tendermint/tendermint/abci/types"
@@ -22,6 +23,11 @@ func main() {
 	cdc := app.MakeCodec()
 	ctx := server.NewDefaultContext()
 
+	// set the address prefixes
+	config := sdk.GetConfig()
+	app.SetBech32Prefixes(config)
+	config.Seal()
+
 	rootCmd := &cobra.Command{
 		Use:               "basecoind",
 		Short:             "Basecoin Daemon (server)",
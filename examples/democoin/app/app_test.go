@@ -6,9 +6,12 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/examples/democoin/types"
 	"github.com/cosmos/cosmos-sdk/examples/democoin/x/cool"
+	"github.com/cosmos/cosmos-sdk/examples/democoin/x/pow"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/wire"
 	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/ibc"
+	"github.com/cosmos/cosmos-sdk/x/mock"
 	"github.com/stretchr/testify/require"
 	abci "github.com/tendermint/tendermint/abci/types"
 	"github.com/tendermint/tendermint/crypto"
@@ -70,3 +73,55 @@ func TestGenesis(t *testing.T) {
 	res1 = bapp.accountMapper.GetAccount(ctx, baseAcc.Address)
 	require.Equal(t, acc, res1)
 }
+
+// requireSupply checks that the sum of the coins across all accounts is the
+// total supply tracked by the bank
+func requireSupply(t *testing.T, bapp *DemocoinApp, msg string) {
+	ctx := bapp.BaseApp.NewContext(true, abci.Header{})
+	totalCoins := sdk.Coins{}
+	bapp.accountMapper.IterateAccounts(ctx, func(acc auth.Account) bool {
+		totalCoins = totalCoins.Plus(acc.GetCoins())
+		return false
+	})
+	supply := bapp.coinKeeper.GetTotalSupply(ctx)
+	require.True(t, supply.IsEqual(totalCoins), "%s: supply %v doesn't match the sum of accounts %v", msg, supply, totalCoins)
+}
+
+func TestSupplyInvariant(t *testing.T) {
+	logger := log.NewNopLogger()
+	db := dbm.NewMemDB()
+	bapp := NewDemocoinApp(logger, db)
+
+	priv := crypto.GenPrivKeyEd25519()
+	addr := sdk.AccAddress(priv.PubKey().Address())
+	baseAcc := auth.BaseAccount{
+		Address: addr,
+		Coins:   sdk.Coins{sdk.NewCoin("foocoin", 77)},
+	}
+	err := setGenesis(bapp, "icecold", baseAcc)
+	require.Nil(t, err)
+	requireSupply(t, bapp, "genesis")
+
+	// cool and pow mint their rewards
+	quizMsg := cool.MsgQuiz{Sender: addr, CoolAnswer: "icecold"}
+	mock.SignCheckDeliver(t, bapp.BaseApp, []sdk.Msg{quizMsg}, []int64{0}, []int64{0}, true, priv)
+	requireSupply(t, bapp, "cool quiz")
+	mineMsg := pow.GenerateMsgMine(addr, 1, 1)
+	mock.SignCheckDeliver(t, bapp.BaseApp, []sdk.Msg{mineMsg}, []int64{0}, []int64{1}, true, priv)
+	requireSupply(t, bapp, "pow mine")
+
+	// IBC burns the transferred coins and mints the received ones
+	packet := ibc.IBCPacket{
+		SrcAddr:   addr,
+		DestAddr:  addr,
+		Coins:     sdk.Coins{sdk.NewCoin("foocoin", 10)},
+		SrcChain:  "source-chain",
+		DestChain: "dest-chain",
+	}
+	transferMsg := ibc.IBCTransferMsg{IBCPacket: packet}
+	mock.SignCheckDeliver(t, bapp.BaseApp, []sdk.Msg{transferMsg}, []int64{0}, []int64{2}, true, priv)
+	requireSupply(t, bapp, "ibc transfer")
+	receiveMsg := ibc.IBCReceiveMsg{IBCPacket: packet, Relayer: addr, Sequence: 0}
+	mock.SignCheckDeliver(t, bapp.BaseApp, []sdk.Msg{receiveMsg}, []int64{0}, []int64{3}, true, priv)
+	requireSupply(t, bapp, "ibc receive")
+}
@@ -58,7 +58,7 @@ func main() {
 		)...)
 	rootCmd.AddCommand(
 		client.PostCommands(
-			bankcmd.SendTxCmd(cdc),
+			bankcmd.SendTxCmd("supply", cdc),
 		)...)
 	rootCmd.AddCommand(
 		client.PostCommands(
@@ -87,10 +87,12 @@ func main() {
 		lcd.ServeCommand(cdc),
 		keys.Commands(),
 		client.LineBreak,
+		client.ErrorsCmd,
 		version.VersionCmd,
 	)
 
 	// prepare and add flags
+	client.AddKeyringFlags(rootCmd)
 	executor := cli.PrepareMainCmd(rootCmd, "BC", os.ExpandEnv("$HOME/.democli"))
 	err := executor.Execute()
 	if err != nil {
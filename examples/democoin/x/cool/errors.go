@@ -14,6 +14,10 @@ const (
 	CodeIncorrectCoolAnswer sdk.CodeType = 400
 )
 
+func init() {
+	sdk.RegisterError(DefaultCodespace, CodeIncorrectCoolAnswer, "incorrect cool answer")
+}
+
 // ErrIncorrectCoolAnswer - Error returned upon an incorrect guess
 func ErrIncorrectCoolAnswer(codespace sdk.CodespaceType, answer string) sdk.Error {
 	return sdk.NewError(codespace, CodeIncorrectCoolAnswer, fmt.Sprintf("incorrect cool answer: %v", answer))
@@ -20,60 +20,35 @@ const (
 	CodeUnknownRequest        CodeType          = sdk.CodeUnknownRequest
 )
 
-func codeToDefaultMsg(code CodeType) string {
-	switch code {
-	case CodeInvalidDifficulty:
-		return "insuffient difficulty"
-	case CodeNonexistentDifficulty:
-		return "nonexistent difficulty"
-	case CodeNonexistentReward:
-		return "nonexistent reward"
-	case CodeNonexistentCount:
-		return "nonexistent count"
-	case CodeInvalidProof:
-		return "invalid proof"
-	case CodeNotBelowTarget:
-		return "not below target"
-	case CodeInvalidCount:
-		return "invalid count"
-	case CodeUnknownRequest:
-		return "unknown request"
-	default:
-		return sdk.CodeToDefaultMsg(code)
-	}
+func init() {
+	sdk.RegisterError(DefaultCodespace, CodeInvalidDifficulty, "insuffient difficulty")
+	sdk.RegisterError(DefaultCodespace, CodeNonexistentDifficulty, "nonexistent difficulty")
+	sdk.RegisterError(DefaultCodespace, CodeNonexistentReward, "nonexistent reward")
+	sdk.RegisterError(DefaultCodespace, CodeNonexistentCount, "nonexistent count")
+	sdk.RegisterError(DefaultCodespace, CodeInvalidProof, "invalid proof")
+	sdk.RegisterError(DefaultCodespace, CodeNotBelowTarget, "not below target")
+	sdk.RegisterError(DefaultCodespace, CodeInvalidCount, "invalid count")
 }
 
 // nolint
 func ErrInvalidDifficulty(codespace sdk.CodespaceType, msg string) sdk.Error {
-	return newError(codespace, CodeInvalidDifficulty, msg)
+	return sdk.NewError(codespace, CodeInvalidDifficulty, msg)
 }
 func ErrNonexistentDifficulty(codespace sdk.CodespaceType) sdk.Error {
-	return newError(codespace, CodeNonexistentDifficulty, "")
+	return sdk.NewError(codespace, CodeNonexistentDifficulty, "")
 }
 func ErrNonexistentReward(codespace sdk.CodespaceType) sdk.Error {
-	return newError(codespace, CodeNonexistentReward, "")
+	return sdk.NewError(codespace, CodeNonexistentReward, "")
 }
 func ErrNonexistentCount(codespace sdk.CodespaceType) sdk.Error {
-	return newError(codespace, CodeNonexistentCount, "")
+	return sdk.NewError(codespace, CodeNonexistentCount, "")
 }
 func ErrInvalidProof(codespace sdk.CodespaceType, msg string) sdk.Error {
-	return newError(codespace, CodeInvalidProof, msg)
+	return sdk.NewError(codespace, CodeInvalidProof, msg)
 }
 func ErrNotBelowTarget(codespace sdk.CodespaceType, msg string) sdk.Error {
-	return newError(codespace, CodeNotBelowTarget, msg)
+	return sdk.NewError(codespace, CodeNotBelowTarget, msg)
 }
 func ErrInvalidCount(codespace sdk.CodespaceType, msg string) sdk.Error {
-	return newError(codespace, CodeInvalidCount, msg)
-}
-
-func msgOrDefaultMsg(msg string, code CodeType) string {
-	if msg != "" {
-		return msg
-	}
-	return codeToDefaultMsg(code)
-}
-
-func newError(codespace sdk.CodespaceType, code CodeType, msg string) sdk.Error {
-	msg = msgOrDefaultMsg(msg, code)
-	return sdk.NewError(codespace, code, msg)
+	return sdk.NewError(codespace, CodeInvalidCount, msg)
 }
@@ -15,24 +15,23 @@ const (
 	CodeIncorrectStakingToken sdk.CodeType = 303
 )
 
+func init() {
+	sdk.RegisterError(DefaultCodespace, CodeEmptyValidator, "empty validator")
+	sdk.RegisterError(DefaultCodespace, CodeInvalidUnbond, "invalid unbond")
+	sdk.RegisterError(DefaultCodespace, CodeEmptyStake, "empty stake")
+	sdk.RegisterError(DefaultCodespace, CodeIncorrectStakingToken, "incorrect staking token")
+}
+
 // nolint
 func ErrIncorrectStakingToken(codespace sdk.CodespaceType) sdk.Error {
-	return newError(codespace, CodeIncorrectStakingToken, "")
+	return sdk.NewError(codespace, CodeIncorrectStakingToken, "")
 }
 func ErrEmptyValidator(codespace sdk.CodespaceType) sdk.Error {
-	return newError(codespace, CodeEmptyValidator, "")
+	return sdk.NewError(codespace, CodeEmptyValidator, "")
 }
 func ErrInvalidUnbond(codespace sdk.CodespaceType) sdk.Error {
-	return newError(codespace, CodeInvalidUnbond, "")
+	return sdk.NewError(codespace, CodeInvalidUnbond, "")
 }
 func ErrEmptyStake(codespace sdk.CodespaceType) sdk.Error {
-	return newError(codespace, CodeEmptyStake, "")
-}
-
-// -----------------------------
-// Helpers
-
-// nolint: unparam
-func newError(codespace sdk.CodespaceType, code sdk.CodeType, msg string) sdk.Error {
-	return sdk.NewError(codespace, code, msg)
+	return sdk.NewError(codespace, CodeEmptyStake, "")
 }
@@ -18,20 +18,33 @@ type cacheMultiStore struct {
 
 	traceWriter  io.Writer
 	traceContext TraceContext
+
+	listeners map[StoreKey][]sdk.WriteListener
 }
 
 var _ CacheMultiStore = cacheMultiStore{}
 
 func newCacheMultiStoreFromRMS(rms *rootMultiStore) cacheMultiStore {
+	stores := make(map[StoreKey]CacheWrapper, len(rms.stores))
+	for key, store := range rms.stores {
+		stores[key] = store
+	}
+	return newCacheMultiStoreFromStores(rms, stores)
+}
+
+// newCacheMultiStoreFromStores cache-wraps the given stores in place of the
+// stores of rms, e.g. their read-only versions at a past height
+func newCacheMultiStoreFromStores(rms *rootMultiStore, stores map[StoreKey]CacheWrapper) cacheMultiStore {
 	cms := cacheMultiStore{
 		db:           NewCacheKVStore(dbStoreAdapter{rms.db}),
-		stores:       make(map[StoreKey]CacheWrap, len(rms.stores)),
+		stores:       make(map[StoreKey]CacheWrap, len(stores)),
 		keysByName:   rms.keysByName,
 		traceWriter:  rms.traceWriter,
 		traceContext: rms.traceContext,
+		listeners:    make(map[StoreKey][]sdk.WriteListener),
 	}
 
-	for key, store := range rms.stores {
+	for key, store := range stores {
 		if cms.TracingEnabled() {
 			cms.stores[key] = store.CacheWrapWithTrace(cms.traceWriter, cms.traceContext)
 		} else {
@@ -48,13 +61,22 @@ func newCacheMultiStoreFromCMS(cms cacheMultiStore) cacheMultiStore {
 		stores:       make(map[StoreKey]CacheWrap, len(cms.stores)),
 		traceWriter:  cms.traceWriter,
 		traceContext: cms.traceContext,
+		listeners:    make(map[StoreKey][]sdk.WriteListener),
 	}
 
 	for key, store := range cms.stores {
+		// Listeners are not inherited, the cache-wrap writes through the
+		// listening parent store instead so that only the writes that are
+		// flushed into cms are observed.
+		var parent CacheWrapper = store
+		if cms.ListeningEnabled(key) {
+			parent = NewListenKVStore(store.(KVStore), key, cms.listeners[key])
+		}
+
 		if cms2.TracingEnabled() {
-			cms2.stores[key] = store.CacheWrapWithTrace(cms2.traceWriter, cms2.traceContext)
+			cms2.stores[key] = parent.CacheWrapWithTrace(cms2.traceWriter, cms2.traceContext)
 		} else {
-			cms2.stores[key] = store.CacheWrap()
+			cms2.stores[key] = parent.CacheWrap()
 		}
 	}
 
@@ -95,6 +117,17 @@ func (cms cacheMultiStore) ResetTraceContext() MultiStore {
 	return cms
 }
 
+// ListeningEnabled returns if listening is enabled for the KVStore of the
+// given key.
+func (cms cacheMultiStore) ListeningEnabled(key StoreKey) bool {
+	return len(cms.listeners[key]) > 0
+}
+
+// SetListeners sets the WriteListeners for the KVStore of the given key.
+func (cms cacheMultiStore) SetListeners(key StoreKey, listeners []sdk.WriteListener) {
+	cms.listeners[key] = listeners
+}
+
 // Implements Store.
 func (cms cacheMultiStore) GetStoreType() StoreType {
 	return sdk.StoreTypeMulti
@@ -128,9 +161,16 @@ func (cms cacheMultiStore) GetStore(key StoreKey) Store {
 	return cms.stores[key].(Store)
 }
 
-// Implements MultiStore.
+// GetKVStore implements the MultiStore interface. If listening is enabled
+// for the key, a wrapped ListenKVStore will be returned.
 func (cms cacheMultiStore) GetKVStore(key StoreKey) KVStore {
-	return cms.stores[key].(KVStore)
+	store := cms.stores[key].(KVStore)
+
+	if cms.ListeningEnabled(key) {
+		store = NewListenKVStore(store, key, cms.listeners[key])
+	}
+
+	return store
 }
 
 // Implements MultiStore.
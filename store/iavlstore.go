@@ -120,6 +120,15 @@ func (st *iavlStore) VersionExists(version int64) bool {
 	return st.tree.VersionExists(version)
 }
 
+// GetImmutable returns a read-only store of a committed version of the tree.
+// It fails with ErrInvalidHeight if the version was pruned or isn't committed.
+func (st *iavlStore) GetImmutable(version int64) (KVStore, sdk.Error) {
+	if !st.VersionExists(version) {
+		return nil, errHeightNotAvailable(st.tree, version)
+	}
+	return &immutableIAVLStore{tree: st.tree, version: version}, nil
+}
+
 // Implements Store.
 func (st *iavlStore) GetStoreType() StoreType {
 	return sdk.StoreTypeIAVL
@@ -192,6 +201,9 @@ func getHeight(tree *iavl.VersionedTree, req abci.RequestQuery) int64 {
 // If latest-1 is not present, use latest (which must be present)
 // if you care to have the latest data to see a tx results, you must
 // explicitly set the height you want to see
+//
+// Queries for a height that was pruned or not yet committed fail with
+// CodeInvalidHeight rather than returning an empty value.
 func (st *iavlStore) Query(req abci.RequestQuery) (res abci.ResponseQuery) {
 	if len(req.Data) == 0 {
 		msg := "Query cannot be zero length"
@@ -203,15 +215,14 @@ func (st *iavlStore) Query(req abci.RequestQuery) (res abci.ResponseQuery) {
 	// store the height we chose in the response, with 0 being changed to the
 	// latest height
 	res.Height = getHeight(tree, req)
+	if !st.VersionExists(res.Height) {
+		return errHeightNotAvailable(tree, res.Height).QueryResult()
+	}
 
 	switch req.Path {
 	case "/store", "/key": // Get by key
 		key := req.Data // Data holds the key bytes
 		res.Key = key
-		if !st.VersionExists(res.Height) {
-			res.Log = cmn.ErrorWrap(iavl.ErrVersionDoesNotExist, "").Error()
-			break
-		}
 		if req.Prove {
 			value, proof, err := tree.GetVersionedWithProof(key, res.Height)
 			if err != nil {
@@ -232,12 +243,15 @@ func (st *iavlStore) Query(req abci.RequestQuery) (res abci.ResponseQuery) {
 	case "/subspace":
 		subspace := req.Data
 		res.Key = subspace
+		keys, values, _, err := tree.GetVersionedRangeWithProof(subspace, sdk.PrefixEndBytes(subspace), 0, res.Height)
+		if err != nil {
+			res.Log = err.Error()
+			break
+		}
 		var KVs []KVPair
-		iterator := sdk.KVStorePrefixIterator(st, subspace)
-		for ; iterator.Valid(); iterator.Next() {
-			KVs = append(KVs, KVPair{iterator.Key(), iterator.Value()})
+		for i := range keys {
+			KVs = append(KVs, KVPair{keys[i], values[i]})
 		}
-		iterator.Close()
 		res.Value = cdc.MustMarshalBinary(KVs)
 	default:
 		msg := fmt.Sprintf("Unexpected Query path: %v", req.Path)
@@ -246,6 +260,20 @@ func (st *iavlStore) Query(req abci.RequestQuery) (res abci.ResponseQuery) {
 	return
 }
 
+// errHeightNotAvailable explains why a version of the tree cannot be queried
+func errHeightNotAvailable(tree *iavl.VersionedTree, height int64) sdk.Error {
+	latest := tree.Version64()
+	switch {
+	case height < 0:
+		return sdk.ErrInvalidHeight(fmt.Sprintf("height %d cannot be negative", height))
+	case height > latest || latest == 0:
+		return sdk.ErrInvalidHeight(fmt.Sprintf(
+			"no state committed at height %d, latest committed height is %d", height, latest))
+	}
+	return sdk.ErrInvalidHeight(fmt.Sprintf(
+		"height %d is not available, it has been pruned (latest height %d)", height, latest))
+}
+
 //----------------------------------------
 
 // Implements Iterator.
@@ -427,6 +427,7 @@ func TestIAVLStoreQuery(t *testing.T) {
 	require.Equal(t, v1, qres.Value)
 
 	// and for the subspace
+	querySub.Height = cid.Version
 	qres = iavlStore.Query(querySub)
 	require.Equal(t, uint32(sdk.CodeOK), qres.Code)
 	require.Equal(t, valExpSub1, qres.Value)
@@ -449,7 +450,12 @@ func TestIAVLStoreQuery(t *testing.T) {
 	qres = iavlStore.Query(query2)
 	require.Equal(t, uint32(sdk.CodeOK), qres.Code)
 	require.Equal(t, v2, qres.Value)
-	// and for the subspace
+	// subspace query will return old values, as height is fixed
+	qres = iavlStore.Query(querySub)
+	require.Equal(t, uint32(sdk.CodeOK), qres.Code)
+	require.Equal(t, valExpSub1, qres.Value)
+	// and the latest values for the latest height
+	querySub.Height = cid.Version
 	qres = iavlStore.Query(querySub)
 	require.Equal(t, uint32(sdk.CodeOK), qres.Code)
 	require.Equal(t, valExpSub2, qres.Value)
@@ -459,4 +465,38 @@ func TestIAVLStoreQuery(t *testing.T) {
 	qres = iavlStore.Query(query0)
 	require.Equal(t, uint32(sdk.CodeOK), qres.Code)
 	require.Equal(t, v1, qres.Value)
+
+	// heights which were never committed are rejected
+	queryFuture := abci.RequestQuery{Path: "/key", Data: k1, Height: cid.Version + 1}
+	qres = iavlStore.Query(queryFuture)
+	require.Equal(t, sdk.ToABCICode(sdk.CodespaceRoot, sdk.CodeInvalidHeight), sdk.ABCICodeType(qres.Code))
+	queryNegative := abci.RequestQuery{Path: "/subspace", Data: ksub, Height: -1}
+	qres = iavlStore.Query(queryNegative)
+	require.Equal(t, sdk.ToABCICode(sdk.CodespaceRoot, sdk.CodeInvalidHeight), sdk.ABCICodeType(qres.Code))
+}
+
+func TestIAVLStoreQueryPruned(t *testing.T) {
+	db := dbm.NewMemDB()
+	tree := iavl.NewVersionedTree(db, cacheSize)
+	iavlStore := newIAVLStore(tree, numRecent, storeEvery)
+
+	k, v := []byte("key"), []byte("value")
+	iavlStore.Set(k, v)
+	first := iavlStore.Commit()
+	for i := int64(0); i < numRecent+1; i++ {
+		iavlStore.Commit()
+	}
+	require.False(t, iavlStore.VersionExists(first.Version))
+
+	query := abci.RequestQuery{Path: "/key", Data: k, Height: first.Version}
+	qres := iavlStore.Query(query)
+	require.Equal(t, sdk.ToABCICode(sdk.CodespaceRoot, sdk.CodeInvalidHeight), sdk.ABCICodeType(qres.Code))
+	require.Contains(t, qres.Log, "pruned")
+	require.Nil(t, qres.Value)
+
+	query.Height = iavlStore.LastCommitID().Version
+	qres = iavlStore.Query(query)
+	require.Equal(t, uint32(sdk.CodeOK), qres.Code)
+	require.Equal(t, query.Height, qres.Height)
+	require.Equal(t, v, qres.Value)
 }
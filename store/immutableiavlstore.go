@@ -0,0 +1,94 @@
+package store
+
+import (
+	"bytes"
+	"io"
+
+	"github.com/tendermint/iavl"
+	cmn "github.com/tendermint/tendermint/libs/common"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ KVStore = (*immutableIAVLStore)(nil)
+
+// immutableIAVLStore is a read-only KVStore of a committed version of an iavl
+// tree. It serves queries at a past height and panics on writes, so it is
+// meant to be cache-wrapped.
+type immutableIAVLStore struct {
+	tree    *iavl.VersionedTree
+	version int64
+}
+
+// Implements Store.
+func (st *immutableIAVLStore) GetStoreType() StoreType {
+	return sdk.StoreTypeIAVL
+}
+
+// Implements Store.
+func (st *immutableIAVLStore) CacheWrap() CacheWrap {
+	return NewCacheKVStore(st)
+}
+
+// CacheWrapWithTrace implements the Store interface.
+func (st *immutableIAVLStore) CacheWrapWithTrace(w io.Writer, tc TraceContext) CacheWrap {
+	return NewCacheKVStore(NewTraceKVStore(st, w, tc))
+}
+
+// Implements KVStore.
+func (st *immutableIAVLStore) Get(key []byte) (value []byte) {
+	_, v := st.tree.GetVersioned(key, st.version)
+	return v
+}
+
+// Implements KVStore.
+func (st *immutableIAVLStore) Has(key []byte) (exists bool) {
+	return st.Get(key) != nil
+}
+
+// Implements KVStore.
+func (st *immutableIAVLStore) Set(key, value []byte) {
+	panic("cannot write to an immutable iavl store")
+}
+
+// Implements KVStore.
+func (st *immutableIAVLStore) Delete(key []byte) {
+	panic("cannot write to an immutable iavl store")
+}
+
+// Implements KVStore
+func (st *immutableIAVLStore) Prefix(prefix []byte) KVStore {
+	return prefixStore{st, prefix}
+}
+
+// Implements KVStore.
+func (st *immutableIAVLStore) Iterator(start, end []byte) Iterator {
+	return st.iterator(start, end, true)
+}
+
+// Implements KVStore.
+func (st *immutableIAVLStore) ReverseIterator(start, end []byte) Iterator {
+	return st.iterator(start, end, false)
+}
+
+// iterator loads the items of the domain at the version of the store, as the
+// iavl tree only iterates over its working version
+func (st *immutableIAVLStore) iterator(start, end []byte, ascending bool) Iterator {
+	if start != nil && end != nil && bytes.Compare(start, end) >= 0 {
+		return newMemIterator(start, end, nil)
+	}
+	keys, values, _, err := st.tree.GetVersionedRangeWithProof(start, end, 0, st.version)
+	if err != nil {
+		panic(err)
+	}
+	items := make([]cmn.KVPair, len(keys))
+	for i := range keys {
+		item := cmn.KVPair{Key: keys[i], Value: values[i]}
+		if ascending {
+			items[i] = item
+		} else {
+			items[len(keys)-1-i] = item
+		}
+	}
+	return newMemIterator(start, end, items)
+}
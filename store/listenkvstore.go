@@ -0,0 +1,90 @@
+package store
+
+import (
+	"io"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ListenKVStore implements the KVStore interface with listening enabled.
+// Every Set and Delete is passed on to the registered WriteListeners after it
+// has been applied to the parent KVStore. Reads are not observed.
+type ListenKVStore struct {
+	parent    sdk.KVStore
+	storeKey  StoreKey
+	listeners []sdk.WriteListener
+}
+
+// NewListenKVStore returns a reference to a new ListenKVStore given a parent
+// KVStore, the key it is mounted under and the listeners to notify.
+func NewListenKVStore(parent sdk.KVStore, storeKey StoreKey, listeners []sdk.WriteListener) *ListenKVStore {
+	return &ListenKVStore{parent: parent, storeKey: storeKey, listeners: listeners}
+}
+
+// Get implements the KVStore interface. It delegates the Get call to the
+// parent KVStore.
+func (lkv *ListenKVStore) Get(key []byte) []byte {
+	return lkv.parent.Get(key)
+}
+
+// Set implements the KVStore interface. It delegates the Set call to the
+// parent KVStore and notifies the listeners.
+func (lkv *ListenKVStore) Set(key []byte, value []byte) {
+	lkv.parent.Set(key, value)
+	lkv.onWrite(key, value, false)
+}
+
+// Delete implements the KVStore interface. It delegates the Delete call to
+// the parent KVStore and notifies the listeners.
+func (lkv *ListenKVStore) Delete(key []byte) {
+	lkv.parent.Delete(key)
+	lkv.onWrite(key, nil, true)
+}
+
+// Has implements the KVStore interface. It delegates the Has call to the
+// parent KVStore.
+func (lkv *ListenKVStore) Has(key []byte) bool {
+	return lkv.parent.Has(key)
+}
+
+// Prefix implements the KVStore interface.
+func (lkv *ListenKVStore) Prefix(prefix []byte) KVStore {
+	return prefixStore{lkv, prefix}
+}
+
+// Iterator implements the KVStore interface. It delegates the Iterator call
+// to the parent KVStore.
+func (lkv *ListenKVStore) Iterator(start, end []byte) sdk.Iterator {
+	return lkv.parent.Iterator(start, end)
+}
+
+// ReverseIterator implements the KVStore interface. It delegates the
+// ReverseIterator call to the parent KVStore.
+func (lkv *ListenKVStore) ReverseIterator(start, end []byte) sdk.Iterator {
+	return lkv.parent.ReverseIterator(start, end)
+}
+
+// GetStoreType implements the KVStore interface. It returns the underlying
+// KVStore type.
+func (lkv *ListenKVStore) GetStoreType() sdk.StoreType {
+	return lkv.parent.GetStoreType()
+}
+
+// CacheWrap implements the KVStore interface. Writes of the returned cache
+// reach the listeners once the cache is written.
+func (lkv *ListenKVStore) CacheWrap() sdk.CacheWrap {
+	return NewCacheKVStore(lkv)
+}
+
+// CacheWrapWithTrace implements the KVStore interface.
+func (lkv *ListenKVStore) CacheWrapWithTrace(w io.Writer, tc TraceContext) CacheWrap {
+	return NewCacheKVStore(NewTraceKVStore(lkv, w, tc))
+}
+
+// onWrite passes a write on to every listener. The key and value are copied
+// so listeners may hold on to them.
+func (lkv *ListenKVStore) onWrite(key, value []byte, delete bool) {
+	for _, l := range lkv.listeners {
+		l.OnWrite(lkv.storeKey, cp(key), cp(value), delete)
+	}
+}
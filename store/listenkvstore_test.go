@@ -0,0 +1,95 @@
+package store
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	dbm "github.com/tendermint/tendermint/libs/db"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+type testWrite struct {
+	storeKey StoreKey
+	key      []byte
+	value    []byte
+	delete   bool
+}
+
+type testWriteListener struct {
+	writes []testWrite
+}
+
+func (l *testWriteListener) OnWrite(storeKey StoreKey, key []byte, value []byte, delete bool) {
+	l.writes = append(l.writes, testWrite{storeKey, key, value, delete})
+}
+
+func TestListenKVStoreSetDelete(t *testing.T) {
+	storeKey := sdk.NewKVStoreKey("listen")
+	listener := &testWriteListener{}
+	parent := dbStoreAdapter{dbm.NewMemDB()}
+	store := NewListenKVStore(parent, storeKey, []sdk.WriteListener{listener})
+
+	store.Set(kvPairs[0].Key, kvPairs[0].Value)
+	require.Equal(t, kvPairs[0].Value, parent.Get(kvPairs[0].Key))
+	require.Equal(t, kvPairs[0].Value, store.Get(kvPairs[0].Key))
+
+	store.Delete(kvPairs[0].Key)
+	require.Nil(t, parent.Get(kvPairs[0].Key))
+
+	// reads aren't observed
+	store.Has(kvPairs[1].Key)
+	store.Get(kvPairs[1].Key)
+
+	expected := []testWrite{
+		{storeKey, kvPairs[0].Key, kvPairs[0].Value, false},
+		{storeKey, kvPairs[0].Key, nil, true},
+	}
+	require.Equal(t, expected, listener.writes)
+}
+
+func TestListenKVStoreCacheWrap(t *testing.T) {
+	storeKey := sdk.NewKVStoreKey("listen")
+	listener := &testWriteListener{}
+	store := NewListenKVStore(dbStoreAdapter{dbm.NewMemDB()}, storeKey, []sdk.WriteListener{listener})
+
+	cache := store.CacheWrap().(CacheKVStore)
+	cache.Set(kvPairs[0].Key, kvPairs[0].Value)
+	require.Empty(t, listener.writes)
+
+	cache.Write()
+	require.Equal(t, []testWrite{{storeKey, kvPairs[0].Key, kvPairs[0].Value, false}}, listener.writes)
+}
+
+func TestCacheMultiStoreListeners(t *testing.T) {
+	db := dbm.NewMemDB()
+	multi := newMultiStoreWithMounts(db)
+	err := multi.LoadLatestVersion()
+	require.Nil(t, err)
+
+	key1, key2 := multi.keysByName["store1"], multi.keysByName["store2"]
+	listener := &testWriteListener{}
+	cms := multi.CacheMultiStore()
+	cms.SetListeners(key1, []sdk.WriteListener{listener})
+	require.True(t, cms.ListeningEnabled(key1))
+	require.False(t, cms.ListeningEnabled(key2))
+
+	// direct writes are observed, but only on the listened store
+	cms.GetKVStore(key1).Set(kvPairs[0].Key, kvPairs[0].Value)
+	cms.GetKVStore(key2).Set(kvPairs[1].Key, kvPairs[1].Value)
+	require.Equal(t, []testWrite{{key1, kvPairs[0].Key, kvPairs[0].Value, false}}, listener.writes)
+
+	// writes of a discarded cache-wrap are not observed
+	discarded := cms.CacheMultiStore()
+	discarded.GetKVStore(key1).Set(kvPairs[1].Key, kvPairs[1].Value)
+	require.Len(t, listener.writes, 1)
+
+	// writes of a cache-wrap are observed once it is written
+	written := cms.CacheMultiStore()
+	written.GetKVStore(key1).Set(kvPairs[2].Key, kvPairs[2].Value)
+	require.Len(t, listener.writes, 1)
+	written.Write()
+	require.Equal(t, testWrite{key1, kvPairs[2].Key, kvPairs[2].Value, false}, listener.writes[1])
+	require.Len(t, listener.writes, 2)
+}
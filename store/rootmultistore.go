@@ -129,6 +129,18 @@ func (rs *rootMultiStore) LoadVersion(ver int64) error {
 		newStores[key] = store
 	}
 
+	// Transient stores are never part of the commitInfo, load them fresh.
+	for key, storeParams := range rs.storesParams {
+		if storeParams.typ != sdk.StoreTypeTransient {
+			continue
+		}
+		store, err := rs.loadCommitStoreFromParams(CommitID{}, storeParams)
+		if err != nil {
+			return fmt.Errorf("failed to load rootMultiStore: %v", err)
+		}
+		newStores[key] = store
+	}
+
 	// If any CommitStoreLoaders were not used, return error.
 	for key := range rs.storesParams {
 		if _, ok := newStores[key]; !ok {
@@ -224,6 +236,29 @@ func (rs *rootMultiStore) CacheMultiStore() CacheMultiStore {
 	return newCacheMultiStoreFromRMS(rs)
 }
 
+// CacheMultiStoreWithVersion implements CommitMultiStore. The IAVL stores are
+// read at the version, the transient stores, which hold no committed state,
+// are empty.
+func (rs *rootMultiStore) CacheMultiStoreWithVersion(version int64) (CacheMultiStore, sdk.Error) {
+	stores := make(map[StoreKey]CacheWrapper, len(rs.stores))
+	for key, store := range rs.stores {
+		switch store := store.(type) {
+		case *iavlStore:
+			immutable, err := store.GetImmutable(version)
+			if err != nil {
+				return nil, err
+			}
+			stores[key] = immutable
+		case *transientStore:
+			stores[key] = newTransientStore()
+		default:
+			msg := fmt.Sprintf("store %s cannot be read at a past version", key.Name())
+			return nil, sdk.ErrInternal(msg)
+		}
+	}
+	return newCacheMultiStoreFromStores(rs, stores), nil
+}
+
 // Implements MultiStore.
 func (rs *rootMultiStore) GetStore(key StoreKey) Store {
 	return rs.stores[key]
@@ -326,6 +361,14 @@ func (rs *rootMultiStore) loadCommitStoreFromParams(id CommitID, params storePar
 		return
 	case sdk.StoreTypeDB:
 		panic("dbm.DB is not a CommitStore")
+	case sdk.StoreTypeTransient:
+		_, ok := params.key.(*sdk.TransientStoreKey)
+		if !ok {
+			err = fmt.Errorf("invalid StoreKey for StoreTypeTransient: %s", params.key.String())
+			return
+		}
+		store = newTransientStore()
+		return
 	default:
 		panic(fmt.Sprintf("unrecognized store type %v", params.typ))
 	}
@@ -440,6 +483,11 @@ func commitStores(version int64, storeMap map[StoreKey]CommitStore) commitInfo {
 		// Commit
 		commitID := store.Commit()
 
+		// Transient stores are cleared on Commit and never affect the hash.
+		if store.GetStoreType() == sdk.StoreTypeTransient {
+			continue
+		}
+
 		// Record CommitID
 		si := storeInfo{}
 		si.Name = key.Name()
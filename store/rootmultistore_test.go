@@ -159,6 +159,89 @@ func TestMultiStoreQuery(t *testing.T) {
 	require.Equal(t, v2, qres.Value)
 }
 
+func TestMultistoreTransient(t *testing.T) {
+	db := dbm.NewMemDB()
+	multi := newMultiStoreWithMounts(db)
+	tkey := sdk.NewTransientStoreKey("transient")
+	multi.MountStoreWithDB(tkey, sdk.StoreTypeTransient, nil)
+	err := multi.LoadLatestVersion()
+	require.Nil(t, err)
+
+	k, v := []byte("wind"), []byte("blows")
+
+	// a multistore without the transient store to compare against
+	plain := newMultiStoreWithMounts(dbm.NewMemDB())
+	err = plain.LoadLatestVersion()
+	require.Nil(t, err)
+
+	// writes to the transient store don't change the commit hash
+	tstore := multi.GetKVStore(tkey)
+	tstore.Set(k, v)
+	cid2 := multi.Commit()
+	require.Equal(t, plain.Commit(), cid2)
+
+	// and are gone after Commit
+	tstore = multi.GetKVStore(tkey)
+	require.Nil(t, tstore.Get(k))
+
+	// reloading the multistore mounts an empty transient store
+	multi = newMultiStoreWithMounts(db)
+	multi.MountStoreWithDB(tkey, sdk.StoreTypeTransient, nil)
+	err = multi.LoadLatestVersion()
+	require.Nil(t, err)
+	require.NotNil(t, multi.GetKVStore(tkey))
+	require.Equal(t, cid2, multi.LastCommitID())
+}
+
+func TestMultistoreCacheWithVersion(t *testing.T) {
+	db := dbm.NewMemDB()
+	multi := NewCommitMultiStore(db)
+	key := sdk.NewKVStoreKey("store1")
+	tkey := sdk.NewTransientStoreKey("transient")
+	multi.MountStoreWithDB(key, sdk.StoreTypeIAVL, nil)
+	multi.MountStoreWithDB(tkey, sdk.StoreTypeTransient, nil)
+	err := multi.LoadLatestVersion()
+	require.Nil(t, err)
+
+	k, k2 := []byte("wind"), []byte("water")
+	store := multi.GetKVStore(key)
+	store.Set(k, []byte("blows"))
+	store.Set(k2, []byte("flows"))
+	first := multi.Commit()
+	store.Set(k, []byte("calms"))
+	store.Delete(k2)
+	multi.Commit()
+
+	cacheMS, sdkErr := multi.CacheMultiStoreWithVersion(first.Version)
+	require.Nil(t, sdkErr)
+	cached := cacheMS.GetKVStore(key)
+	require.Equal(t, []byte("blows"), cached.Get(k))
+	require.True(t, cached.Has(k2))
+
+	// the iterators read the version too
+	iter := cached.Iterator(nil, nil)
+	require.Equal(t, k2, iter.Key())
+	iter.Next()
+	require.Equal(t, k, iter.Key())
+	iter.Next()
+	require.False(t, iter.Valid())
+	iter.Close()
+	iter = cached.ReverseIterator(nil, nil)
+	require.Equal(t, k, iter.Key())
+	iter.Close()
+
+	// writes stay in the cache, the committed versions are unchanged
+	cached.Set(k, []byte("rages"))
+	require.Equal(t, []byte("rages"), cached.Get(k))
+	require.Equal(t, []byte("calms"), multi.GetKVStore(key).Get(k))
+	require.NotNil(t, cacheMS.GetKVStore(tkey))
+
+	// versions which aren't committed can't be read
+	_, sdkErr = multi.CacheMultiStoreWithVersion(first.Version + 2)
+	require.NotNil(t, sdkErr)
+	require.Equal(t, sdk.CodeInvalidHeight, sdkErr.Code())
+}
+
 //-----------------------------------------------------------------------
 // utils
 
@@ -0,0 +1,42 @@
+package store
+
+import (
+	dbm "github.com/tendermint/tendermint/libs/db"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ KVStore = (*transientStore)(nil)
+
+// transientStore is a wrapper for a MemDB with a Committer implementation.
+// It holds scratch state for the current block only: Commit throws away all
+// of its contents and it never contributes to the commitInfo.
+type transientStore struct {
+	dbStoreAdapter
+}
+
+// Constructs new MemDB adapter
+func newTransientStore() *transientStore {
+	return &transientStore{dbStoreAdapter{dbm.NewMemDB()}}
+}
+
+// Implements CommitStore
+// Commit cleans up the transientStore.
+func (ts *transientStore) Commit() (id CommitID) {
+	ts.dbStoreAdapter = dbStoreAdapter{dbm.NewMemDB()}
+	return
+}
+
+// Implements CommitStore
+func (ts *transientStore) LastCommitID() (id CommitID) {
+	return
+}
+
+// Implements CommitStore
+func (ts *transientStore) SetPruning(pruning sdk.PruningStrategy) {
+}
+
+// Implements Store.
+func (ts *transientStore) GetStoreType() StoreType {
+	return sdk.StoreTypeTransient
+}
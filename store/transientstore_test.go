@@ -0,0 +1,22 @@
+package store
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTransientStore(t *testing.T) {
+	tstore := newTransientStore()
+	k, v := []byte("hello"), []byte("world")
+
+	require.Nil(t, tstore.Get(k))
+
+	tstore.Set(k, v)
+
+	require.Equal(t, v, tstore.Get(k))
+
+	tstore.Commit()
+
+	require.Nil(t, tstore.Get(k))
+}
@@ -15,11 +15,14 @@ const (
 	// expected address length
 	AddrLen = 20
 
-	// Bech32 prefixes
-	Bech32PrefixAccAddr = "cosmosaccaddr"
-	Bech32PrefixAccPub  = "cosmosaccpub"
-	Bech32PrefixValAddr = "cosmosvaladdr"
-	Bech32PrefixValPub  = "cosmosvalpub"
+	// default Bech32 prefixes, applications can override them through the
+	// Config returned by GetConfig
+	Bech32PrefixAccAddr  = "cosmosaccaddr"
+	Bech32PrefixAccPub   = "cosmosaccpub"
+	Bech32PrefixValAddr  = "cosmosvaladdr"
+	Bech32PrefixValPub   = "cosmosvalpub"
+	Bech32PrefixConsAddr = "cosmosconsaddr"
+	Bech32PrefixConsPub  = "cosmosconspub"
 )
 
 //__________________________________________________________
@@ -42,7 +45,7 @@ func AccAddressFromHex(address string) (addr AccAddress, err error) {
 
 // create an AccAddress from a bech32 string
 func AccAddressFromBech32(address string) (addr AccAddress, err error) {
-	bz, err := GetFromBech32(address, Bech32PrefixAccAddr)
+	bz, err := GetFromBech32(address, GetConfig().GetBech32AccountAddrPrefix())
 	if err != nil {
 		return nil, err
 	}
@@ -70,7 +73,7 @@ func (bz *AccAddress) UnmarshalJSON(data []byte) error {
 	var s string
 	err := json.Unmarshal(data, &s)
 	if err != nil {
-		return nil
+		return err
 	}
 
 	bz2, err := AccAddressFromBech32(s)
@@ -87,7 +90,7 @@ func (bz AccAddress) Bytes() []byte {
 }
 
 func (bz AccAddress) String() string {
-	bech32Addr, err := bech32.ConvertAndEncode(Bech32PrefixAccAddr, bz.Bytes())
+	bech32Addr, err := bech32.ConvertAndEncode(GetConfig().GetBech32AccountAddrPrefix(), bz.Bytes())
 	if err != nil {
 		panic(err)
 	}
@@ -126,7 +129,7 @@ func ValAddressFromHex(address string) (addr ValAddress, err error) {
 
 // create a ValAddress from a bech32 string
 func ValAddressFromBech32(address string) (addr ValAddress, err error) {
-	bz, err := GetFromBech32(address, Bech32PrefixValAddr)
+	bz, err := GetFromBech32(address, GetConfig().GetBech32ValidatorAddrPrefix())
 	if err != nil {
 		return nil, err
 	}
@@ -154,7 +157,7 @@ func (bz *ValAddress) UnmarshalJSON(data []byte) error {
 	var s string
 	err := json.Unmarshal(data, &s)
 	if err != nil {
-		return nil
+		return err
 	}
 
 	bz2, err := ValAddressFromBech32(s)
@@ -171,7 +174,7 @@ func (bz ValAddress) Bytes() []byte {
 }
 
 func (bz ValAddress) String() string {
-	bech32Addr, err := bech32.ConvertAndEncode(Bech32PrefixValAddr, bz.Bytes())
+	bech32Addr, err := bech32.ConvertAndEncode(GetConfig().GetBech32ValidatorAddrPrefix(), bz.Bytes())
 	if err != nil {
 		panic(err)
 	}
@@ -190,9 +193,101 @@ func (bz ValAddress) Format(s fmt.State, verb rune) {
 	}
 }
 
+//__________________________________________________________
+
+// ConsAddress a wrapper around bytes meant to represent the address of the
+// consensus key of a validator, as used by Tendermint in the commits and the
+// evidence. When marshaled to a string or json, it uses bech32
+type ConsAddress []byte
+
+// create a ConsAddress from a hex string
+func ConsAddressFromHex(address string) (addr ConsAddress, err error) {
+	if len(address) == 0 {
+		return addr, errors.New("decoding bech32 address failed: must provide an address")
+	}
+	bz, err := hex.DecodeString(address)
+	if err != nil {
+		return nil, err
+	}
+	return ConsAddress(bz), nil
+}
+
+// create a ConsAddress from a bech32 string
+func ConsAddressFromBech32(address string) (addr ConsAddress, err error) {
+	bz, err := GetFromBech32(address, GetConfig().GetBech32ConsensusAddrPrefix())
+	if err != nil {
+		return nil, err
+	}
+	return ConsAddress(bz), nil
+}
+
+// get the consensus address of a consensus public key
+func GetConsAddress(pubkey crypto.PubKey) ConsAddress {
+	return ConsAddress(pubkey.Address())
+}
+
+// Marshal needed for protobuf compatibility
+func (bz ConsAddress) Marshal() ([]byte, error) {
+	return bz, nil
+}
+
+// Unmarshal needed for protobuf compatibility
+func (bz *ConsAddress) Unmarshal(data []byte) error {
+	*bz = data
+	return nil
+}
+
+// Marshals to JSON using Bech32
+func (bz ConsAddress) MarshalJSON() ([]byte, error) {
+	return json.Marshal(bz.String())
+}
+
+// Unmarshals from JSON assuming Bech32 encoding
+func (bz *ConsAddress) UnmarshalJSON(data []byte) error {
+	var s string
+	err := json.Unmarshal(data, &s)
+	if err != nil {
+		return err
+	}
+
+	bz2, err := ConsAddressFromBech32(s)
+	if err != nil {
+		return err
+	}
+	*bz = bz2
+	return nil
+}
+
+// Allow it to fulfill various interfaces in light-client, etc...
+func (bz ConsAddress) Bytes() []byte {
+	return bz
+}
+
+func (bz ConsAddress) String() string {
+	bech32Addr, err := bech32.ConvertAndEncode(GetConfig().GetBech32ConsensusAddrPrefix(), bz.Bytes())
+	if err != nil {
+		panic(err)
+	}
+	return bech32Addr
+}
+
+// For Printf / Sprintf, returns bech32 when using %s
+func (bz ConsAddress) Format(s fmt.State, verb rune) {
+	switch verb {
+	case 's':
+		s.Write([]byte(fmt.Sprintf("%s", bz.String())))
+	case 'p':
+		s.Write([]byte(fmt.Sprintf("%p", bz)))
+	default:
+		s.Write([]byte(fmt.Sprintf("%X", []byte(bz))))
+	}
+}
+
+//__________________________________________________________
+
 // Bech32ifyAccPub takes AccountPubKey and returns the bech32 encoded string
 func Bech32ifyAccPub(pub crypto.PubKey) (string, error) {
-	return bech32.ConvertAndEncode(Bech32PrefixAccPub, pub.Bytes())
+	return bech32.ConvertAndEncode(GetConfig().GetBech32AccountPubPrefix(), pub.Bytes())
 }
 
 // MustBech32ifyAccPub panics on bech32-encoding failure
@@ -206,7 +301,7 @@ func MustBech32ifyAccPub(pub crypto.PubKey) string {
 
 // Bech32ifyValPub returns the bech32 encoded string for a validator pubkey
 func Bech32ifyValPub(pub crypto.PubKey) (string, error) {
-	return bech32.ConvertAndEncode(Bech32PrefixValPub, pub.Bytes())
+	return bech32.ConvertAndEncode(GetConfig().GetBech32ValidatorPubPrefix(), pub.Bytes())
 }
 
 // MustBech32ifyValPub panics on bech32-encoding failure
@@ -220,7 +315,7 @@ func MustBech32ifyValPub(pub crypto.PubKey) string {
 
 // create a Pubkey from a string
 func GetAccPubKeyBech32(address string) (pk crypto.PubKey, err error) {
-	bz, err := GetFromBech32(address, Bech32PrefixAccPub)
+	bz, err := GetFromBech32(address, GetConfig().GetBech32AccountPubPrefix())
 	if err != nil {
 		return nil, err
 	}
@@ -244,7 +339,7 @@ func MustGetAccPubKeyBech32(address string) (pk crypto.PubKey) {
 
 // decode a validator public key into a PubKey
 func GetValPubKeyBech32(pubkey string) (pk crypto.PubKey, err error) {
-	bz, err := GetFromBech32(pubkey, Bech32PrefixValPub)
+	bz, err := GetFromBech32(pubkey, GetConfig().GetBech32ValidatorPubPrefix())
 	if err != nil {
 		return nil, err
 	}
@@ -266,6 +361,26 @@ func MustGetValPubKeyBech32(address string) (pk crypto.PubKey) {
 	return pk
 }
 
+// Bech32ifyConsPub returns the bech32 encoded string for a consensus pubkey
+func Bech32ifyConsPub(pub crypto.PubKey) (string, error) {
+	return bech32.ConvertAndEncode(GetConfig().GetBech32ConsensusPubPrefix(), pub.Bytes())
+}
+
+// decode a consensus public key into a PubKey
+func GetConsPubKeyBech32(pubkey string) (pk crypto.PubKey, err error) {
+	bz, err := GetFromBech32(pubkey, GetConfig().GetBech32ConsensusPubPrefix())
+	if err != nil {
+		return nil, err
+	}
+
+	pk, err = crypto.PubKeyFromBytes(bz)
+	if err != nil {
+		return nil, err
+	}
+
+	return pk, nil
+}
+
 // decode a bytestring from a bech32-encoded string
 func GetFromBech32(bech32str, prefix string) ([]byte, error) {
 	if len(bech32str) == 0 {
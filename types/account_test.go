@@ -0,0 +1,35 @@
+package types
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAddressUnmarshalJSON(t *testing.T) {
+	bz := []byte("0123456789abcdef0123")
+
+	var accAddr AccAddress
+	require.Nil(t, json.Unmarshal(mustMarshalJSON(t, AccAddress(bz)), &accAddr))
+	require.Equal(t, AccAddress(bz), accAddr)
+	var valAddr ValAddress
+	require.Nil(t, json.Unmarshal(mustMarshalJSON(t, ValAddress(bz)), &valAddr))
+	require.Equal(t, ValAddress(bz), valAddr)
+	var consAddr ConsAddress
+	require.Nil(t, json.Unmarshal(mustMarshalJSON(t, ConsAddress(bz)), &consAddr))
+	require.Equal(t, ConsAddress(bz), consAddr)
+
+	// an address which isn't a bech32 string is rejected
+	for _, data := range []string{`1`, `["foo"]`, `{}`, `"foo"`} {
+		require.NotNil(t, json.Unmarshal([]byte(data), &accAddr), data)
+		require.NotNil(t, json.Unmarshal([]byte(data), &valAddr), data)
+		require.NotNil(t, json.Unmarshal([]byte(data), &consAddr), data)
+	}
+}
+
+func mustMarshalJSON(t *testing.T, v interface{}) []byte {
+	bz, err := json.Marshal(v)
+	require.Nil(t, err)
+	return bz
+}
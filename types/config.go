@@ -0,0 +1,114 @@
+package types
+
+import (
+	"sync"
+)
+
+// Config is the application wide configuration of the sdk types. It holds
+// the bech32 prefixes used to encode and decode addresses and public keys.
+// Applications set it once at startup and then seal it, after which it can
+// no longer be modified.
+type Config struct {
+	mtx                 sync.RWMutex
+	sealed              bool
+	bech32AddressPrefix map[string]string
+}
+
+var sdkConfig = &Config{
+	bech32AddressPrefix: map[string]string{
+		"account_addr":   Bech32PrefixAccAddr,
+		"validator_addr": Bech32PrefixValAddr,
+		"consensus_addr": Bech32PrefixConsAddr,
+		"account_pub":    Bech32PrefixAccPub,
+		"validator_pub":  Bech32PrefixValPub,
+		"consensus_pub":  Bech32PrefixConsPub,
+	},
+}
+
+// GetConfig returns the application wide config
+func GetConfig() *Config {
+	return sdkConfig
+}
+
+func (config *Config) assertNotSealed() {
+	if config.sealed {
+		panic("Config is sealed")
+	}
+}
+
+func (config *Config) setBech32Prefix(kind, addressPrefix, pubKeyPrefix string) {
+	config.mtx.Lock()
+	defer config.mtx.Unlock()
+	config.assertNotSealed()
+	config.bech32AddressPrefix[kind+"_addr"] = addressPrefix
+	config.bech32AddressPrefix[kind+"_pub"] = pubKeyPrefix
+}
+
+func (config *Config) getBech32Prefix(key string) string {
+	config.mtx.RLock()
+	defer config.mtx.RUnlock()
+	return config.bech32AddressPrefix[key]
+}
+
+// SetBech32PrefixForAccount sets the bech32 prefixes of account addresses
+// and account public keys. It panics if the config is sealed.
+func (config *Config) SetBech32PrefixForAccount(addressPrefix, pubKeyPrefix string) {
+	config.setBech32Prefix("account", addressPrefix, pubKeyPrefix)
+}
+
+// SetBech32PrefixForValidator sets the bech32 prefixes of validator
+// addresses and validator public keys. It panics if the config is sealed.
+func (config *Config) SetBech32PrefixForValidator(addressPrefix, pubKeyPrefix string) {
+	config.setBech32Prefix("validator", addressPrefix, pubKeyPrefix)
+}
+
+// SetBech32PrefixForConsensusNode sets the bech32 prefixes of consensus node
+// addresses and public keys. It panics if the config is sealed.
+func (config *Config) SetBech32PrefixForConsensusNode(addressPrefix, pubKeyPrefix string) {
+	config.setBech32Prefix("consensus", addressPrefix, pubKeyPrefix)
+}
+
+// Seal prevents any further modification of the config
+func (config *Config) Seal() *Config {
+	config.mtx.Lock()
+	defer config.mtx.Unlock()
+	config.sealed = true
+	return config
+}
+
+// IsSealed returns whether the config has been sealed
+func (config *Config) IsSealed() bool {
+	config.mtx.RLock()
+	defer config.mtx.RUnlock()
+	return config.sealed
+}
+
+// GetBech32AccountAddrPrefix returns the bech32 prefix of account addresses
+func (config *Config) GetBech32AccountAddrPrefix() string {
+	return config.getBech32Prefix("account_addr")
+}
+
+// GetBech32ValidatorAddrPrefix returns the bech32 prefix of validator addresses
+func (config *Config) GetBech32ValidatorAddrPrefix() string {
+	return config.getBech32Prefix("validator_addr")
+}
+
+// GetBech32ConsensusAddrPrefix returns the bech32 prefix of consensus node addresses
+func (config *Config) GetBech32ConsensusAddrPrefix() string {
+	return config.getBech32Prefix("consensus_addr")
+}
+
+// GetBech32AccountPubPrefix returns the bech32 prefix of account public keys
+func (config *Config) GetBech32AccountPubPrefix() string {
+	return config.getBech32Prefix("account_pub")
+}
+
+// GetBech32ValidatorPubPrefix returns the bech32 prefix of validator public keys
+func (config *Config) GetBech32ValidatorPubPrefix() string {
+	return config.getBech32Prefix("validator_pub")
+}
+
+// GetBech32ConsensusPubPrefix returns the bech32 prefix of consensus node public keys
+func (config *Config) GetBech32ConsensusPubPrefix() string {
+	return config.getBech32Prefix("consensus_pub")
+}
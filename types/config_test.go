@@ -0,0 +1,34 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestConfigSeal(t *testing.T) {
+	config := &Config{bech32AddressPrefix: map[string]string{}}
+
+	config.SetBech32PrefixForAccount("fooacc", "fooaccpub")
+	config.SetBech32PrefixForValidator("fooval", "foovalpub")
+	config.SetBech32PrefixForConsensusNode("foocons", "fooconspub")
+	require.Equal(t, "fooacc", config.GetBech32AccountAddrPrefix())
+	require.Equal(t, "fooaccpub", config.GetBech32AccountPubPrefix())
+	require.Equal(t, "fooval", config.GetBech32ValidatorAddrPrefix())
+	require.Equal(t, "foovalpub", config.GetBech32ValidatorPubPrefix())
+	require.Equal(t, "foocons", config.GetBech32ConsensusAddrPrefix())
+	require.Equal(t, "fooconspub", config.GetBech32ConsensusPubPrefix())
+
+	require.False(t, config.IsSealed())
+	config.Seal()
+	require.True(t, config.IsSealed())
+	require.Panics(t, func() { config.SetBech32PrefixForAccount("baracc", "baraccpub") })
+	require.Equal(t, "fooacc", config.GetBech32AccountAddrPrefix())
+}
+
+func TestDefaultConfig(t *testing.T) {
+	config := GetConfig()
+	require.Equal(t, Bech32PrefixAccAddr, config.GetBech32AccountAddrPrefix())
+	require.Equal(t, Bech32PrefixValPub, config.GetBech32ValidatorPubPrefix())
+	require.Equal(t, Bech32PrefixConsAddr, config.GetBech32ConsensusAddrPrefix())
+}
@@ -98,3 +98,19 @@ func TestLogContext(t *testing.T) {
 	ctx.Logger().Error("error")
 	require.Equal(t, *logger.logs, []string{"debug", "info", "error"})
 }
+
+func TestEventManagerContext(t *testing.T) {
+	key := types.NewKVStoreKey(t.Name())
+	ctx := defaultContext(key)
+	event := types.NewEvent("transfer", types.NewAttribute("sender", "a"))
+
+	// contexts derived from a context share its event manager
+	ctx.WithLogger(log.NewNopLogger()).EventManager().EmitEvent(event)
+	require.Equal(t, types.Events{event}, ctx.EventManager().Events())
+
+	// unless they are given a new one
+	msgCtx := ctx.WithEventManager(types.NewEventManager())
+	msgCtx.EventManager().EmitEvent(event)
+	require.Equal(t, types.Events{event}, ctx.EventManager().Events())
+	require.Equal(t, types.Events{event}, msgCtx.EventManager().Events())
+}
@@ -0,0 +1,171 @@
+package types
+
+import (
+	"math/big"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPlusMinusDecCoins(t *testing.T) {
+	cases := []struct {
+		inputOne DecCoins
+		inputTwo DecCoins
+		expPlus  DecCoins
+		expMinus DecCoins
+	}{
+		{DecCoins{}, DecCoins{}, nil, nil},
+		{
+			DecCoins{NewDecCoinFromDec("atom", NewDecWithPrec(15, 1))},
+			DecCoins{NewDecCoinFromDec("atom", NewDecWithPrec(5, 1))},
+			DecCoins{NewDecCoin("atom", 2)},
+			DecCoins{NewDecCoin("atom", 1)},
+		},
+		{
+			DecCoins{NewDecCoinFromDec("atom", NewDecWithPrec(5, 1))},
+			DecCoins{NewDecCoinFromDec("atom", NewDecWithPrec(5, 1)), NewDecCoinFromDec("steak", NewDecWithPrec(1, 10))},
+			DecCoins{NewDecCoin("atom", 1), NewDecCoinFromDec("steak", NewDecWithPrec(1, 10))},
+			DecCoins{NewDecCoinFromDec("steak", NewDecWithPrec(-1, 10))},
+		},
+	}
+
+	for i, tc := range cases {
+		require.True(t, tc.expPlus.IsEqual(tc.inputOne.Plus(tc.inputTwo)), "test %d plus", i)
+		require.True(t, tc.expMinus.IsEqual(tc.inputOne.Minus(tc.inputTwo)), "test %d minus", i)
+	}
+}
+
+func TestTruncateDecCoins(t *testing.T) {
+	coins := DecCoins{
+		NewDecCoinFromDec("atom", MustNewDecFromStr("1.25")),
+		NewDecCoinFromDec("photon", MustNewDecFromStr("0.5")),
+		NewDecCoin("steak", 3),
+	}
+	truncated, change := coins.TruncateDecimal()
+	require.True(t, truncated.IsEqual(Coins{NewCoin("atom", 1), NewCoin("steak", 3)}), "%v", truncated)
+	require.True(t, change.IsEqual(DecCoins{
+		NewDecCoinFromDec("atom", MustNewDecFromStr("0.25")),
+		NewDecCoinFromDec("photon", MustNewDecFromStr("0.5")),
+	}), "%v", change)
+
+	truncated, change = DecCoins{}.TruncateDecimal()
+	require.Nil(t, truncated)
+	require.Nil(t, change)
+}
+
+func TestMulQuoDecCoins(t *testing.T) {
+	coins := DecCoins{NewDecCoin("atom", 10), NewDecCoinFromDec("steak", NewDecWithPrec(1, 10))}
+
+	// shares smaller than the precision are dropped
+	require.True(t, DecCoins{NewDecCoin("atom", 5)}.IsEqual(coins.MulDec(NewDecWithPrec(5, 1))))
+	require.True(t, DecCoins{NewDecCoinFromDec("atom", MustNewDecFromStr("3.3333333333"))}.IsEqual(coins.QuoDec(NewDec(3))))
+}
+
+func TestAmountOfDecCoins(t *testing.T) {
+	coins := DecCoins{
+		NewDecCoinFromDec("atom", NewDecWithPrec(15, 1)),
+		NewDecCoin("photon", 2),
+		NewDecCoin("steak", 3),
+	}
+	require.True(DecEq(t, NewDecWithPrec(15, 1), coins.AmountOf("atom")))
+	require.True(DecEq(t, NewDec(2), coins.AmountOf("photon")))
+	require.True(DecEq(t, NewDec(3), coins.AmountOf("steak")))
+	require.True(DecEq(t, ZeroDec(), coins.AmountOf("btc")))
+}
+
+func TestParseDecCoins(t *testing.T) {
+	cases := []struct {
+		input    string
+		valid    bool
+		expected DecCoins
+	}{
+		{"", true, nil},
+		{"1steak", true, DecCoins{NewDecCoin("steak", 1)}},
+		{"1.5steak", true, DecCoins{NewDecCoinFromDec("steak", NewDecWithPrec(15, 1))}},
+		{"0.0000000001steak", true, DecCoins{NewDecCoinFromDec("steak", NewDecWithPrec(1, 10))}},
+		{"2.5 photon,1.25atom", true, DecCoins{
+			NewDecCoinFromDec("atom", NewDecWithPrec(125, 2)),
+			NewDecCoinFromDec("photon", NewDecWithPrec(25, 1)),
+		}},
+		{"0.00000000001steak", false, nil}, // more decimals than the precision
+		{"1.steak", false, nil},
+		{".5steak", false, nil},
+		{"-1steak", false, nil},
+		{"0steak", false, nil},
+		{"1steak,2steak", false, nil}, // duplicate denom
+		{"1.5", false, nil},
+	}
+
+	for i, tc := range cases {
+		res, err := ParseDecCoins(tc.input)
+		if !tc.valid {
+			require.NotNil(t, err, "%s: %#v. tc #%d", tc.input, res, i)
+			continue
+		}
+		require.Nil(t, err, "%s: %+v", tc.input, err)
+		require.True(t, tc.expected.IsEqual(res), "coin parsing was incorrect, tc #%d", i)
+	}
+}
+
+func TestDecCoinsEncoding(t *testing.T) {
+	coins := DecCoins{
+		NewDecCoinFromDec("atom", NewDecWithPrec(125, 2)),
+		NewDecCoinFromDec("steak", NewDecWithPrec(1, 10)),
+	}
+
+	bz, err := cdc.MarshalJSON(coins)
+	require.Nil(t, err)
+	require.Equal(t, `[{"denom":"atom","amount":"1.2500000000"},{"denom":"steak","amount":"0.0000000001"}]`, string(bz))
+	var coins2 DecCoins
+	require.Nil(t, cdc.UnmarshalJSON(bz, &coins2))
+	require.True(t, coins.IsEqual(coins2))
+
+	bz, err = cdc.MarshalBinary(coins)
+	require.Nil(t, err)
+	var coins3 DecCoins
+	require.Nil(t, cdc.UnmarshalBinary(bz, &coins3))
+	require.True(t, coins.IsEqual(coins3))
+
+	// the string representation parses back to the same coins
+	coins4, err := ParseDecCoins(coins.String())
+	require.Nil(t, err)
+	require.True(t, coins.IsEqual(coins4))
+}
+
+func randDecCoins(r *rand.Rand) DecCoins {
+	var coins DecCoins
+	for _, denom := range []string{"atom", "photon", "steak"} {
+		if r.Intn(3) == 0 {
+			continue
+		}
+		amount := NewDecFromBigIntWithPrec(big.NewInt(r.Int63n(1e15)+1), Precision)
+		coins = append(coins, NewDecCoinFromDec(denom, amount))
+	}
+	return coins
+}
+
+func TestDecCoinsProperties(t *testing.T) {
+	r := rand.New(rand.NewSource(42))
+	for n := 0; n < 1000; n++ {
+		a, b := randDecCoins(r), randDecCoins(r)
+
+		// plus and minus are exact inverses
+		require.True(t, a.IsEqual(a.Plus(b).Minus(b)), "%v %v", a, b)
+		require.True(t, a.Plus(b).IsEqual(b.Plus(a)), "%v %v", a, b)
+		require.True(t, a.Plus(b).IsValid(), "%v %v", a, b)
+
+		// truncation loses nothing, the change is the fraction left over
+		truncated, change := a.TruncateDecimal()
+		require.True(t, truncated.IsValid() || len(truncated) == 0, "%v", a)
+		require.True(t, a.IsEqual(NewDecCoins(truncated).Plus(change)), "%v", a)
+		for _, coin := range change {
+			require.True(t, coin.IsPositive() && coin.Amount.LT(OneDec()), "%v", a)
+		}
+
+		// the string representation parses back to the same coins
+		parsed, err := ParseDecCoins(a.String())
+		require.Nil(t, err)
+		require.True(t, a.IsEqual(parsed), "%v", a)
+	}
+}
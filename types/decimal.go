@@ -0,0 +1,356 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+// Dec is a signed decimal with a fixed precision of Precision decimal places,
+// stored as an integer scaled by 10^Precision. Unlike Rat its encoding has a
+// bounded size, and every operation rounds its result to the precision with
+// bankers rounding.
+//
+// NOTE: never use new(Dec) or Dec{}, a nil Dec panics on use
+type Dec struct {
+	i *big.Int
+}
+
+// number of decimal places of a Dec
+const Precision = 10
+
+// bits needed to store the decimal part of a Dec, ceil(log2(10^Precision))
+const decimalPrecisionBits = 34
+
+// max bit length of the scaled integer, the integer part is bound like Int
+const maxDecBitLen = 255 + decimalPrecisionBits
+
+var (
+	precisionMultiplier  = new(big.Int).Exp(big.NewInt(10), big.NewInt(Precision), nil)
+	fivePrecision        = new(big.Int).Quo(precisionMultiplier, big.NewInt(2))
+	precisionMultipliers = func() (muls [Precision + 1]*big.Int) {
+		for i := 0; i <= Precision; i++ {
+			muls[i] = new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(Precision-i)), nil)
+		}
+		return muls
+	}()
+)
+
+// get the multiplier turning an integer with prec decimal places into a Dec
+func precisionMultiplierFor(prec int64) *big.Int {
+	if prec < 0 || prec > Precision {
+		panic(fmt.Sprintf("precision must be within [0, %d], got %d", Precision, prec))
+	}
+	return precisionMultipliers[prec]
+}
+
+func newDec(i *big.Int) Dec {
+	if i.BitLen() > maxDecBitLen {
+		panic("Dec out of bound")
+	}
+	return Dec{i}
+}
+
+// nolint - common values
+func ZeroDec() Dec { return Dec{new(big.Int)} }
+func OneDec() Dec  { return Dec{new(big.Int).Set(precisionMultiplier)} }
+
+// NewDec creates a Dec from an integer
+func NewDec(i int64) Dec {
+	return NewDecWithPrec(i, 0)
+}
+
+// NewDecWithPrec creates a Dec from an integer with prec decimal places, eg.
+// NewDecWithPrec(15, 1) is 1.5
+func NewDecWithPrec(i, prec int64) Dec {
+	return Dec{new(big.Int).Mul(big.NewInt(i), precisionMultiplierFor(prec))}
+}
+
+// NewDecFromBigInt creates a Dec from a big.Int
+func NewDecFromBigInt(i *big.Int) Dec {
+	return NewDecFromBigIntWithPrec(i, 0)
+}
+
+// NewDecFromBigIntWithPrec creates a Dec from a big.Int with prec decimal places
+func NewDecFromBigIntWithPrec(i *big.Int, prec int64) Dec {
+	return newDec(new(big.Int).Mul(i, precisionMultiplierFor(prec)))
+}
+
+// NewDecFromInt creates a Dec from an Int
+func NewDecFromInt(i Int) Dec {
+	return NewDecFromBigInt(i.BigInt())
+}
+
+// NewDecFromRat creates a Dec from a Rat, rounding it to the precision with
+// bankers rounding
+func NewDecFromRat(r Rat) Dec {
+	num := new(big.Int).Mul(r.Rat.Num(), precisionMultiplier)
+	return newDec(NewRatFromBigInt(num, r.Rat.Denom()).EvaluateBig())
+}
+
+// NewDecFromStr parses a decimal string such as "-1.5". Strings with more
+// decimal places than the precision are rejected.
+func NewDecFromStr(str string) (d Dec, err Error) {
+	if len(str) == 0 {
+		return d, ErrUnknownRequest("decimal string is empty")
+	}
+
+	neg := false
+	if str[0] == '-' {
+		neg = true
+		str = str[1:]
+	}
+
+	strs := strings.Split(str, ".")
+	if len(strs) > 2 || len(strs[0]) == 0 || (len(strs) == 2 && len(strs[1]) == 0) {
+		return d, ErrUnknownRequest(fmt.Sprintf("not a decimal string: %s", str))
+	}
+	intStr := strs[0]
+	if len(strs) == 2 {
+		if len(strs[1]) > Precision {
+			return d, ErrUnknownRequest(fmt.Sprintf("decimal string has more than %d decimal places: %s", Precision, str))
+		}
+		intStr += strs[1] + strings.Repeat("0", Precision-len(strs[1]))
+	} else {
+		intStr += strings.Repeat("0", Precision)
+	}
+
+	// only plain digits are accepted, SetString also takes signs and underscores
+	for _, c := range intStr {
+		if c < '0' || c > '9' {
+			return d, ErrUnknownRequest(fmt.Sprintf("not a decimal string: %s", str))
+		}
+	}
+
+	i, ok := new(big.Int).SetString(intStr, 10)
+	if !ok {
+		return d, ErrUnknownRequest(fmt.Sprintf("not a decimal string: %s", str))
+	}
+	if i.BitLen() > maxDecBitLen {
+		return d, ErrUnknownRequest(fmt.Sprintf("decimal out of range: %s", str))
+	}
+	if neg {
+		i.Neg(i)
+	}
+	return Dec{i}, nil
+}
+
+// MustNewDecFromStr parses a decimal string, panicking on error
+func MustNewDecFromStr(str string) Dec {
+	d, err := NewDecFromStr(str)
+	if err != nil {
+		panic(err)
+	}
+	return d
+}
+
+// nolint
+func (d Dec) IsNil() bool       { return d.i == nil }                 // is the Dec uninitialized
+func (d Dec) IsZero() bool      { return d.i.Sign() == 0 }            // is equal to zero
+func (d Dec) Sign() int         { return d.i.Sign() }                 // sign of the Dec
+func (d Dec) Equal(d2 Dec) bool { return d.i.Cmp(d2.i) == 0 }         // equal decimals
+func (d Dec) GT(d2 Dec) bool    { return d.i.Cmp(d2.i) > 0 }          // greater than
+func (d Dec) GTE(d2 Dec) bool   { return d.i.Cmp(d2.i) >= 0 }         // greater than or equal
+func (d Dec) LT(d2 Dec) bool    { return d.i.Cmp(d2.i) < 0 }          // less than
+func (d Dec) LTE(d2 Dec) bool   { return d.i.Cmp(d2.i) <= 0 }         // less than or equal
+func (d Dec) Neg() Dec          { return Dec{new(big.Int).Neg(d.i)} } // reverse the decimal sign
+func (d Dec) Abs() Dec          { return Dec{new(big.Int).Abs(d.i)} } // absolute value
+
+// Add returns d + d2
+func (d Dec) Add(d2 Dec) Dec {
+	return newDec(new(big.Int).Add(d.i, d2.i))
+}
+
+// Sub returns d - d2
+func (d Dec) Sub(d2 Dec) Dec {
+	return newDec(new(big.Int).Sub(d.i, d2.i))
+}
+
+// Mul returns d * d2 rounded to the precision with bankers rounding
+func (d Dec) Mul(d2 Dec) Dec {
+	mul := new(big.Int).Mul(d.i, d2.i)
+	return newDec(chopPrecisionAndRound(mul))
+}
+
+// MulInt returns d * i, which is exact
+func (d Dec) MulInt(i Int) Dec {
+	return newDec(new(big.Int).Mul(d.i, i.BigInt()))
+}
+
+// Quo returns d / d2 rounded to the precision with bankers rounding, it
+// panics on a division by zero
+func (d Dec) Quo(d2 Dec) Dec {
+	// scale twice, once to keep the precision and once to round the result
+	mul := new(big.Int).Mul(d.i, precisionMultiplier)
+	mul.Mul(mul, precisionMultiplier)
+	quo := new(big.Int).Quo(mul, d2.i)
+	return newDec(chopPrecisionAndRound(quo))
+}
+
+// QuoInt returns d / i truncated to the precision, it panics on a division by
+// zero
+func (d Dec) QuoInt(i Int) Dec {
+	return Dec{new(big.Int).Quo(d.i, i.BigInt())}
+}
+
+// remove the decimal places of a scaled integer, rounding with bankers rounding
+func chopPrecisionAndRound(d *big.Int) *big.Int {
+	// round the absolute value so that rounding is symmetric around zero
+	if d.Sign() == -1 {
+		return new(big.Int).Neg(chopPrecisionAndRound(new(big.Int).Neg(d)))
+	}
+
+	quo, rem := new(big.Int).QuoRem(d, precisionMultiplier, new(big.Int))
+	if rem.Sign() == 0 {
+		return quo
+	}
+
+	switch rem.Cmp(fivePrecision) {
+	case -1:
+		return quo
+	case 1:
+		return quo.Add(quo, big.NewInt(1))
+	default: // exactly half, round to the even number
+		if quo.Bit(0) == 0 {
+			return quo
+		}
+		return quo.Add(quo, big.NewInt(1))
+	}
+}
+
+// remove the decimal places of a scaled integer, rounding towards zero
+func chopPrecisionAndTruncate(d *big.Int) *big.Int {
+	return new(big.Int).Quo(d, precisionMultiplier)
+}
+
+// RoundInt rounds the decimal to an integer with bankers rounding
+func (d Dec) RoundInt() Int {
+	return NewIntFromBigInt(chopPrecisionAndRound(d.i))
+}
+
+// RoundInt64 rounds the decimal to an int64 with bankers rounding, it panics
+// if the result doesn't fit
+func (d Dec) RoundInt64() int64 {
+	i := chopPrecisionAndRound(d.i)
+	if !i.IsInt64() {
+		panic("Int64() out of bound")
+	}
+	return i.Int64()
+}
+
+// TruncateInt returns the integer part of the decimal, rounding towards zero
+func (d Dec) TruncateInt() Int {
+	return NewIntFromBigInt(chopPrecisionAndTruncate(d.i))
+}
+
+// TruncateInt64 returns the integer part of the decimal as an int64, it
+// panics if the result doesn't fit
+func (d Dec) TruncateInt64() int64 {
+	i := chopPrecisionAndTruncate(d.i)
+	if !i.IsInt64() {
+		panic("Int64() out of bound")
+	}
+	return i.Int64()
+}
+
+// ToLeftPadded rounds the decimal to an integer with bankers rounding and
+// left pads it with zeros to totalDigits, used to sort by value in store keys
+func (d Dec) ToLeftPadded(totalDigits int8) string {
+	intStr := chopPrecisionAndRound(d.i).String()
+	fcode := `%0` + strconv.Itoa(int(totalDigits)) + `s`
+	return fmt.Sprintf(fcode, intStr)
+}
+
+// ToRat returns the exact value of the decimal as a Rat
+func (d Dec) ToRat() Rat {
+	return NewRatFromBigInt(new(big.Int).Set(d.i), new(big.Int).Set(precisionMultiplier))
+}
+
+// String returns the decimal with all of its decimal places, eg. "-1.5000000000"
+func (d Dec) String() string {
+	if d.i == nil {
+		return "<nil>"
+	}
+	str := new(big.Int).Abs(d.i).String()
+	if len(str) <= Precision {
+		str = strings.Repeat("0", Precision-len(str)+1) + str
+	}
+	res := str[:len(str)-Precision] + "." + str[len(str)-Precision:]
+	if d.i.Sign() == -1 {
+		res = "-" + res
+	}
+	return res
+}
+
+//___________________________________________________________________________________
+
+// MarshalAmino defines custom encoding scheme, the decimal string
+func (d Dec) MarshalAmino() (string, error) {
+	if d.i == nil { // Necessary since default Dec initialization has i as nil
+		d.i = new(big.Int)
+	}
+	return d.String(), nil
+}
+
+// UnmarshalAmino defines custom decoding scheme
+func (d *Dec) UnmarshalAmino(text string) error {
+	res, err := NewDecFromStr(text)
+	if err != nil {
+		return err
+	}
+	d.i = res.i
+	return nil
+}
+
+// MarshalJSON defines custom encoding scheme
+// Must be encoded as a string for JSON precision
+func (d Dec) MarshalJSON() ([]byte, error) {
+	if d.i == nil { // Necessary since default Dec initialization has i as nil
+		d.i = new(big.Int)
+	}
+	return json.Marshal(d.String())
+}
+
+// UnmarshalJSON defines custom decoding scheme
+// Must be encoded as a string for JSON precision
+func (d *Dec) UnmarshalJSON(bz []byte) error {
+	var text string
+	err := json.Unmarshal(bz, &text)
+	if err != nil {
+		return err
+	}
+	return d.UnmarshalAmino(text)
+}
+
+//___________________________________________________________________________________
+// helpers
+
+// test if two decimal arrays are equal
+func DecsEqual(d1s, d2s []Dec) bool {
+	if len(d1s) != len(d2s) {
+		return false
+	}
+
+	for i, d1 := range d1s {
+		if !d1.Equal(d2s[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// intended to be used with require/assert:  require.True(DecEq(...))
+func DecEq(t *testing.T, exp, got Dec) (*testing.T, bool, string, Dec, Dec) {
+	return t, exp.Equal(got), "expected:\t%v\ngot:\t\t%v", exp, got
+}
+
+// minimum decimal between two
+func MinDec(d1, d2 Dec) Dec {
+	if d1.LT(d2) {
+		return d1
+	}
+	return d2
+}
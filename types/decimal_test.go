@@ -0,0 +1,260 @@
+package types
+
+import (
+	"math/big"
+	"math/rand"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewDecFromStr(t *testing.T) {
+	largeBigInt, success := new(big.Int).SetString("3109736052979742687701388262607869", 10)
+	require.True(t, success)
+	tests := []struct {
+		decimalStr string
+		expErr     bool
+		exp        Dec
+	}{
+		{"", true, Dec{}},
+		{"0", false, NewDec(0)},
+		{"1", false, NewDec(1)},
+		{"1.1", false, NewDecWithPrec(11, 1)},
+		{"0.75", false, NewDecWithPrec(75, 2)},
+		{"0.0000000001", false, NewDecWithPrec(1, 10)},
+		{"0.00000000001", true, Dec{}},
+		{"3109736052979742687701388262607869", false, NewDecFromBigInt(largeBigInt)},
+		{"310973605297974268770138826260.7869", false, NewDecFromBigIntWithPrec(largeBigInt, 4)},
+		{".", true, Dec{}},
+		{".0", true, Dec{}},
+		{"1.", true, Dec{}},
+		{"+1", true, Dec{}},
+		{"--1", true, Dec{}},
+		{"1_000", true, Dec{}},
+		{"foobar", true, Dec{}},
+		{"0.foobar", true, Dec{}},
+		{"0.foobar.", true, Dec{}},
+	}
+
+	for _, tc := range tests {
+		res, err := NewDecFromStr(tc.decimalStr)
+		if tc.expErr {
+			require.NotNil(t, err, tc.decimalStr)
+			continue
+		}
+		require.Nil(t, err, tc.decimalStr)
+		require.True(t, res.Equal(tc.exp), tc.decimalStr)
+
+		// negative tc
+		res, err = NewDecFromStr("-" + tc.decimalStr)
+		require.Nil(t, err, tc.decimalStr)
+		require.True(t, res.Equal(tc.exp.Neg()), tc.decimalStr)
+	}
+}
+
+func TestDecString(t *testing.T) {
+	tests := []struct {
+		d    Dec
+		want string
+	}{
+		{NewDec(0), "0.0000000000"},
+		{NewDec(1), "1.0000000000"},
+		{NewDec(10), "10.0000000000"},
+		{NewDecWithPrec(15, 1), "1.5000000000"},
+		{NewDecWithPrec(-15, 1), "-1.5000000000"},
+		{NewDecWithPrec(1, 10), "0.0000000001"},
+		{NewDecWithPrec(-1, 10), "-0.0000000001"},
+	}
+	for i, tc := range tests {
+		require.Equal(t, tc.want, tc.d.String(), "test %d", i)
+	}
+}
+
+func TestDecArithmetic(t *testing.T) {
+	tests := []struct {
+		d1, d2                         Dec
+		expMul, expQuo, expAdd, expSub Dec
+	}{
+		{NewDec(0), NewDec(0), NewDec(0), Dec{}, NewDec(0), NewDec(0)},
+		{NewDec(1), NewDec(0), NewDec(0), Dec{}, NewDec(1), NewDec(1)},
+		{NewDec(1), NewDec(1), NewDec(1), NewDec(1), NewDec(2), NewDec(0)},
+		{NewDec(3), NewDec(7), NewDec(21), MustNewDecFromStr("0.4285714286"), NewDec(10), NewDec(-4)},
+		{NewDec(2), NewDec(4), NewDec(8), NewDecWithPrec(5, 1), NewDec(6), NewDec(-2)},
+		{NewDec(100), NewDec(100), NewDec(10000), NewDec(1), NewDec(200), NewDec(0)},
+		{NewDecWithPrec(15, 1), NewDecWithPrec(12, 1), NewDecWithPrec(18, 1), NewDecWithPrec(125, 2), NewDecWithPrec(27, 1), NewDecWithPrec(3, 1)},
+		{NewDec(1), NewDec(-3), NewDec(-3), MustNewDecFromStr("-0.3333333333"), NewDec(-2), NewDec(4)},
+		{NewDec(2), NewDec(3), NewDec(6), MustNewDecFromStr("0.6666666667"), NewDec(5), NewDec(-1)},
+		{NewDec(-2), NewDec(3), NewDec(-6), MustNewDecFromStr("-0.6666666667"), NewDec(1), NewDec(-5)},
+	}
+
+	for i, tc := range tests {
+		require.True(DecEq(t, tc.expMul, tc.d1.Mul(tc.d2)), "test %d mul", i)
+		require.True(DecEq(t, tc.expAdd, tc.d1.Add(tc.d2)), "test %d add", i)
+		require.True(DecEq(t, tc.expSub, tc.d1.Sub(tc.d2)), "test %d sub", i)
+
+		if tc.d2.IsZero() {
+			require.Panics(t, func() { tc.d1.Quo(tc.d2) })
+		} else {
+			require.True(DecEq(t, tc.expQuo, tc.d1.Quo(tc.d2)), "test %d quo", i)
+		}
+	}
+}
+
+func TestDecBankerRounding(t *testing.T) {
+	tests := []struct {
+		d   Dec
+		exp int64
+	}{
+		{MustNewDecFromStr("0.25"), 0},
+		{MustNewDecFromStr("0.5"), 0},
+		{MustNewDecFromStr("0.75"), 1},
+		{MustNewDecFromStr("1.5"), 2},
+		{MustNewDecFromStr("2.5"), 2},
+		{MustNewDecFromStr("2.5000000001"), 3},
+		{MustNewDecFromStr("-0.5"), 0},
+		{MustNewDecFromStr("-1.5"), -2},
+		{MustNewDecFromStr("-2.5"), -2},
+		{MustNewDecFromStr("-2.75"), -3},
+	}
+	for i, tc := range tests {
+		require.Equal(t, tc.exp, tc.d.RoundInt64(), "test %d", i)
+		require.True(t, NewInt(tc.exp).Equal(tc.d.RoundInt()), "test %d", i)
+	}
+
+	// the half of the last decimal place also rounds to even
+	require.True(DecEq(t, NewDecWithPrec(2, 10), NewDecWithPrec(5, 10).Mul(NewDecWithPrec(5, 1))))
+	require.True(DecEq(t, NewDecWithPrec(2, 10), NewDecWithPrec(3, 10).Mul(NewDecWithPrec(5, 1))))
+	require.True(DecEq(t, NewDecWithPrec(0, 10), NewDecWithPrec(1, 10).Mul(NewDecWithPrec(5, 1))))
+}
+
+func TestDecTruncate(t *testing.T) {
+	tests := []struct {
+		d   Dec
+		exp int64
+	}{
+		{MustNewDecFromStr("0"), 0},
+		{MustNewDecFromStr("0.9999999999"), 0},
+		{MustNewDecFromStr("1.5"), 1},
+		{MustNewDecFromStr("-1.5"), -1},
+		{MustNewDecFromStr("-0.9999999999"), 0},
+	}
+	for i, tc := range tests {
+		require.Equal(t, tc.exp, tc.d.TruncateInt64(), "test %d", i)
+		require.True(t, NewInt(tc.exp).Equal(tc.d.TruncateInt()), "test %d", i)
+	}
+}
+
+func TestDecFromRat(t *testing.T) {
+	require.True(DecEq(t, NewDecWithPrec(5, 1), NewDecFromRat(NewRat(1, 2))))
+	require.True(DecEq(t, MustNewDecFromStr("0.3333333333"), NewDecFromRat(NewRat(1, 3))))
+	require.True(DecEq(t, MustNewDecFromStr("-0.6666666667"), NewDecFromRat(NewRat(-2, 3))))
+	// exactly halfway at the last decimal place, rounds to even
+	require.True(DecEq(t, MustNewDecFromStr("0.0000000002"), NewDecFromRat(NewRat(15, 100000000000))))
+
+	d := MustNewDecFromStr("12.3456789")
+	require.True(DecEq(t, d, NewDecFromRat(d.ToRat())))
+}
+
+func TestDecBounds(t *testing.T) {
+	// the integer part is bound like Int
+	max := NewDecFromBigInt(new(big.Int).Exp(big.NewInt(10), big.NewInt(76), nil))
+	require.NotPanics(t, func() { max.Add(max) })
+	require.Panics(t, func() { max.Mul(max) })
+	require.Panics(t, func() { NewDecFromBigInt(new(big.Int).Exp(big.NewInt(10), big.NewInt(88), nil)) })
+	_, err := NewDecFromStr("1" + strings.Repeat("0", 88))
+	require.NotNil(t, err)
+}
+
+func TestDecEncoding(t *testing.T) {
+	tests := []Dec{
+		NewDec(0),
+		NewDecWithPrec(15, 1),
+		NewDecWithPrec(-1, 10),
+		MustNewDecFromStr("3109736052979742687701388262607869.0000000001"),
+	}
+
+	for _, d := range tests {
+		bz, err := cdc.MarshalJSON(d)
+		require.Nil(t, err)
+		require.Equal(t, `"`+d.String()+`"`, string(bz))
+		var d2 Dec
+		err = cdc.UnmarshalJSON(bz, &d2)
+		require.Nil(t, err)
+		require.True(DecEq(t, d, d2))
+
+		bz, err = cdc.MarshalBinary(d)
+		require.Nil(t, err)
+		var d3 Dec
+		err = cdc.UnmarshalBinary(bz, &d3)
+		require.Nil(t, err)
+		require.True(DecEq(t, d, d3))
+	}
+
+	// a zero value Dec encodes as zero
+	bz, err := cdc.MarshalJSON(Dec{})
+	require.Nil(t, err)
+	require.Equal(t, `"0.0000000000"`, string(bz))
+
+	var d Dec
+	require.NotNil(t, cdc.UnmarshalJSON([]byte(`"1/3"`), &d))
+	require.NotNil(t, cdc.UnmarshalJSON([]byte(`"0.00000000001"`), &d))
+}
+
+// random decimal with up to 8 integer digits and all its decimal places
+func randDec(r *rand.Rand) Dec {
+	return NewDecFromBigIntWithPrec(big.NewInt(r.Int63n(2e18)-1e18), Precision)
+}
+
+func TestDecProperties(t *testing.T) {
+	r := rand.New(rand.NewSource(42))
+	for n := 0; n < 1000; n++ {
+		a, b, c := randDec(r), randDec(r), randDec(r)
+
+		// addition is exact
+		require.True(DecEq(t, a, a.Add(b).Sub(b)))
+		require.True(DecEq(t, a.Add(b).Add(c), a.Add(b.Add(c))))
+		require.True(DecEq(t, a.Add(b), b.Add(a)))
+
+		// multiplication commutes and rounds to the nearest decimal
+		require.True(DecEq(t, a.Mul(b), b.Mul(a)))
+		exact := new(big.Rat).Mul(a.ToRat().Rat, b.ToRat().Rat)
+		diff := new(big.Rat).Sub(exact, a.Mul(b).ToRat().Rat)
+		require.True(t, diff.Abs(diff).Cmp(big.NewRat(1, 2e10)) <= 0, "%v * %v", a, b)
+
+		// integers are exact
+		i := NewInt(r.Int63n(1e9) - 5e8)
+		require.True(DecEq(t, a.MulInt(i), a.Mul(NewDecFromInt(i))))
+
+		// the quotient is within one decimal place of the exact value
+		if !b.IsZero() {
+			exact = new(big.Rat).Quo(a.ToRat().Rat, b.ToRat().Rat)
+			diff = new(big.Rat).Sub(exact, a.Quo(b).ToRat().Rat)
+			require.True(t, diff.Abs(diff).Cmp(big.NewRat(1, 1e10)) <= 0, "%v / %v", a, b)
+		}
+
+		// rounding and truncation are within one of the value
+		require.True(t, a.Sub(NewDecFromInt(a.TruncateInt())).Abs().LT(OneDec()))
+		require.True(t, a.Sub(NewDecFromInt(a.RoundInt())).Abs().LTE(NewDecWithPrec(5, 1)))
+
+		// parsing and encoding roundtrip
+		require.True(DecEq(t, a, MustNewDecFromStr(a.String())))
+	}
+}
+
+func TestDecToLeftPadded(t *testing.T) {
+	tests := []struct {
+		d      Dec
+		digits int8
+		exp    string
+	}{
+		{MustNewDecFromStr("33.3"), 8, "00000033"},
+		{MustNewDecFromStr("50"), 8, "00000050"},
+		{MustNewDecFromStr("50.5"), 8, "00000050"},
+		{MustNewDecFromStr("51.5"), 8, "00000052"},
+		{MustNewDecFromStr("1000000000"), 8, "1000000000"},
+	}
+	for i, tc := range tests {
+		require.Equal(t, tc.exp, tc.d.ToLeftPadded(tc.digits), "test %d", i)
+	}
+}
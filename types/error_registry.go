@@ -0,0 +1,76 @@
+package types
+
+import (
+	"fmt"
+	"sort"
+)
+
+// ErrorDescriptor describes an error registered by a module. Errors are
+// identified by their codespace and code, the description is the default
+// message of the errors created without one.
+type ErrorDescriptor struct {
+	Codespace   CodespaceType `json:"codespace"`
+	Code        CodeType      `json:"code"`
+	Description string        `json:"description"`
+}
+
+// ABCICode returns the ABCI code of the described errors
+func (desc ErrorDescriptor) ABCICode() ABCICodeType {
+	return ToABCICode(desc.Codespace, desc.Code)
+}
+
+// Error implements error, so that descriptors can be the target of the
+// standard errors.Is
+func (desc ErrorDescriptor) Error() string {
+	return fmt.Sprintf("%d:%d %s", desc.Codespace, desc.Code, desc.Description)
+}
+
+// Is returns whether err, or an error wrapped by it, is of the described
+// codespace and code
+func (desc ErrorDescriptor) Is(err error) bool {
+	for err != nil {
+		if sdkErr, ok := err.(Error); ok && sdkErr.Codespace() == desc.Codespace && sdkErr.Code() == desc.Code {
+			return true
+		}
+		causer, ok := err.(interface{ Cause() error })
+		if !ok {
+			return false
+		}
+		err = causer.Cause()
+	}
+	return false
+}
+
+// registered errors by ABCI code, only written to at init
+var errorRegistry = make(map[ABCICodeType]ErrorDescriptor)
+
+// RegisterError adds an error to the catalog of errors. Modules register each
+// of their codes once, from an init function, under their default codespace.
+// It panics if the code is already registered in the codespace.
+func RegisterError(codespace CodespaceType, code CodeType, description string) ErrorDescriptor {
+	desc := ErrorDescriptor{codespace, code, description}
+	if registered, ok := errorRegistry[desc.ABCICode()]; ok {
+		panic(fmt.Sprintf("error %d:%d is already registered as %q", codespace, code, registered.Description))
+	}
+	errorRegistry[desc.ABCICode()] = desc
+	return desc
+}
+
+// GetRegisteredError returns the registered error of a codespace and code
+func GetRegisteredError(codespace CodespaceType, code CodeType) (ErrorDescriptor, bool) {
+	desc, ok := errorRegistry[ToABCICode(codespace, code)]
+	return desc, ok
+}
+
+// RegisteredErrors returns the catalog of the registered errors, ordered by
+// codespace and code
+func RegisteredErrors() []ErrorDescriptor {
+	descs := make([]ErrorDescriptor, 0, len(errorRegistry))
+	for _, desc := range errorRegistry {
+		descs = append(descs, desc)
+	}
+	sort.Slice(descs, func(i, j int) bool {
+		return descs[i].ABCICode() < descs[j].ABCICode()
+	})
+	return descs
+}
@@ -54,6 +54,8 @@ const (
 	CodeInvalidCoins      CodeType = 11
 	CodeOutOfGas          CodeType = 12
 	CodeMemoTooLarge      CodeType = 13
+	CodeOutOfBlockGas     CodeType = 14
+	CodeInvalidHeight     CodeType = 15
 
 	// CodespaceRoot is a codespace for error codes in this file only.
 	// Notice that 0 is an "unset" codespace, which can be overridden with
@@ -65,39 +67,39 @@ const (
 	MaximumCodespace CodespaceType = 65535
 )
 
-// NOTE: Don't stringer this, we'll put better messages in later.
-// nolint: gocyclo
+func init() {
+	RegisterError(CodespaceRoot, CodeInternal, "internal error")
+	RegisterError(CodespaceRoot, CodeTxDecode, "tx parse error")
+	RegisterError(CodespaceRoot, CodeInvalidSequence, "invalid sequence")
+	RegisterError(CodespaceRoot, CodeUnauthorized, "unauthorized")
+	RegisterError(CodespaceRoot, CodeInsufficientFunds, "insufficient funds")
+	RegisterError(CodespaceRoot, CodeUnknownRequest, "unknown request")
+	RegisterError(CodespaceRoot, CodeInvalidAddress, "invalid address")
+	RegisterError(CodespaceRoot, CodeInvalidPubKey, "invalid pubkey")
+	RegisterError(CodespaceRoot, CodeUnknownAddress, "unknown address")
+	RegisterError(CodespaceRoot, CodeInsufficientCoins, "insufficient coins")
+	RegisterError(CodespaceRoot, CodeInvalidCoins, "invalid coins")
+	RegisterError(CodespaceRoot, CodeOutOfGas, "out of gas")
+	RegisterError(CodespaceRoot, CodeMemoTooLarge, "memo too large")
+	RegisterError(CodespaceRoot, CodeOutOfBlockGas, "out of block gas")
+	RegisterError(CodespaceRoot, CodeInvalidHeight, "invalid height")
+}
+
+// CodeToDefaultMsg returns the registered description of a root code
 func CodeToDefaultMsg(code CodeType) string {
-	switch code {
-	case CodeInternal:
-		return "internal error"
-	case CodeTxDecode:
-		return "tx parse error"
-	case CodeInvalidSequence:
-		return "invalid sequence"
-	case CodeUnauthorized:
-		return "unauthorized"
-	case CodeInsufficientFunds:
-		return "insufficient funds"
-	case CodeUnknownRequest:
-		return "unknown request"
-	case CodeInvalidAddress:
-		return "invalid address"
-	case CodeInvalidPubKey:
-		return "invalid pubkey"
-	case CodeUnknownAddress:
-		return "unknown address"
-	case CodeInsufficientCoins:
-		return "insufficient coins"
-	case CodeInvalidCoins:
-		return "invalid coins"
-	case CodeOutOfGas:
-		return "out of gas"
-	case CodeMemoTooLarge:
-		return "memo too large"
-	default:
-		return fmt.Sprintf("unknown code %d", code)
+	if desc, ok := GetRegisteredError(CodespaceRoot, code); ok {
+		return desc.Description
+	}
+	return fmt.Sprintf("unknown code %d", code)
+}
+
+// default message of errors created without one, codes which aren't
+// registered in the codespace fall back to the root codes
+func defaultMsg(codespace CodespaceType, code CodeType) string {
+	if desc, ok := GetRegisteredError(codespace, code); ok {
+		return desc.Description
 	}
+	return CodeToDefaultMsg(code)
 }
 
 //--------------------------------------------------------------------------------
@@ -144,6 +146,12 @@ func ErrOutOfGas(msg string) Error {
 func ErrMemoTooLarge(msg string) Error {
 	return newErrorWithRootCodespace(CodeMemoTooLarge, msg)
 }
+func ErrOutOfBlockGas(msg string) Error {
+	return newErrorWithRootCodespace(CodeOutOfBlockGas, msg)
+}
+func ErrInvalidHeight(msg string) Error {
+	return newErrorWithRootCodespace(CodeInvalidHeight, msg)
+}
 
 //----------------------------------------
 // Error & sdkError
@@ -184,7 +192,7 @@ func newErrorWithRootCodespace(code CodeType, format string, args ...interface{}
 
 func newError(codespace CodespaceType, code CodeType, format string, args ...interface{}) *sdkError {
 	if format == "" {
-		format = CodeToDefaultMsg(code)
+		format = defaultMsg(codespace, code)
 	}
 	return &sdkError{
 		codespace: codespace,
@@ -193,10 +201,32 @@ func newError(codespace CodespaceType, code CodeType, format string, args ...int
 	}
 }
 
+// WrapError returns an error of the same codespace and code as err, with the
+// formatted context prepended to its message. Errors which aren't an Error
+// are wrapped as internal errors. The wrapped error is returned by Cause.
+func WrapError(err error, format string, args ...interface{}) Error {
+	codespace, code, msg := CodespaceRoot, CodeInternal, err.Error()
+	if sdkErr, ok := err.(*sdkError); ok {
+		codespace, code, msg = sdkErr.codespace, sdkErr.code, sdkErr.message()
+	} else if sdkErr, ok := err.(Error); ok {
+		codespace, code = sdkErr.Codespace(), sdkErr.Code()
+	}
+	if context := fmt.Sprintf(format, args...); context != "" {
+		msg = context + ": " + msg
+	}
+	return &sdkError{
+		codespace: codespace,
+		code:      code,
+		cmnError:  cmn.NewError("%s", msg),
+		cause:     err,
+	}
+}
+
 type sdkError struct {
 	codespace CodespaceType
 	code      CodeType
 	cmnError
+	cause error
 }
 
 // Implements Error.
@@ -209,13 +239,45 @@ func (err *sdkError) WithDefaultCodespace(cs CodespaceType) Error {
 		codespace: cs,
 		code:      err.code,
 		cmnError:  err.cmnError,
+		cause:     err.cause,
 	}
 }
 
-// Implements ABCIError.
+// Implements ABCIError. The trace only shows up in Error(), a non-empty
+// format is also prepended to the message, see WrapError.
 func (err *sdkError) TraceSDK(format string, args ...interface{}) Error {
 	err.Trace(1, format, args...)
-	return err
+	if format == "" {
+		return err
+	}
+	return WrapError(err, format, args...)
+}
+
+// Cause returns the error wrapped by WrapError, or nil.
+func (err *sdkError) Cause() error {
+	return err.cause
+}
+
+// Unwrap is the same as Cause, for the standard errors package.
+func (err *sdkError) Unwrap() error {
+	return err.cause
+}
+
+// Is reports whether target is an Error or an ErrorDescriptor of the same
+// codespace and code.
+func (err *sdkError) Is(target error) bool {
+	switch target := target.(type) {
+	case ErrorDescriptor:
+		return err.codespace == target.Codespace && err.code == target.Code
+	case Error:
+		return err.codespace == target.Codespace() && err.code == target.Code()
+	}
+	return false
+}
+
+// the error message, without traces
+func (err *sdkError) message() string {
+	return fmt.Sprintf("%v", err.Data())
 }
 
 // Implements ABCIError.
@@ -239,15 +301,16 @@ func (err *sdkError) Code() CodeType {
 	return err.code
 }
 
-// Implements ABCIError.
+// Implements ABCIError. The log is part of the tx results, so it must not
+// contain traces, which differ between nodes.
 func (err *sdkError) ABCILog() string {
 	return fmt.Sprintf(`=== ABCI Log ===
 Codespace: %v
 Code:      %v
 ABCICode:  %v
-Error:     %#v
+Error:     %s
 === /ABCI Log ===
-`, err.codespace, err.code, err.ABCICode(), err.cmnError)
+`, err.codespace, err.code, err.ABCICode(), err.message())
 }
 
 func (err *sdkError) Result() Result {
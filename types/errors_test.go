@@ -1,6 +1,7 @@
 package types
 
 import (
+	"errors"
 	"strings"
 	"testing"
 
@@ -16,6 +17,8 @@ var codeTypes = []CodeType{
 	CodeUnknownRequest,
 	CodeUnknownAddress,
 	CodeInvalidPubKey,
+	CodeOutOfBlockGas,
+	CodeInvalidHeight,
 }
 
 type errFn func(msg string) Error
@@ -29,6 +32,8 @@ var errFns = []errFn{
 	ErrUnknownRequest,
 	ErrUnknownAddress,
 	ErrInvalidPubKey,
+	ErrOutOfBlockGas,
+	ErrInvalidHeight,
 }
 
 func TestCodeType(t *testing.T) {
@@ -48,3 +53,69 @@ func TestErrFn(t *testing.T) {
 		require.Equal(t, err.Result().Code, ToABCICode(CodespaceRoot, codeType))
 	}
 }
+
+func TestRegisteredErrors(t *testing.T) {
+	desc, ok := GetRegisteredError(CodespaceRoot, CodeOutOfGas)
+	require.True(t, ok)
+	require.Equal(t, "out of gas", desc.Description)
+	require.Equal(t, ToABCICode(CodespaceRoot, CodeOutOfGas), desc.ABCICode())
+	_, ok = GetRegisteredError(CodespaceRoot, CodeType(999))
+	require.False(t, ok)
+
+	// registering a code twice panics
+	require.Panics(t, func() { RegisterError(CodespaceRoot, CodeOutOfGas, "out of gas") })
+
+	// the catalog is ordered by codespace and code
+	descs := RegisteredErrors()
+	require.True(t, len(descs) >= len(codeTypes))
+	for i := 1; i < len(descs); i++ {
+		require.True(t, descs[i-1].ABCICode() < descs[i].ABCICode())
+	}
+
+	// registered descriptions are the default messages of their codespace
+	codespace := CodespaceType(MaximumCodespace - 1)
+	if _, ok := GetRegisteredError(codespace, CodeType(100)); !ok {
+		RegisterError(codespace, CodeType(100), "custom error")
+	}
+	require.Equal(t, "custom error", NewError(codespace, CodeType(100), "").(*sdkError).message())
+	require.Equal(t, "unauthorized", NewError(codespace, CodeUnauthorized, "").(*sdkError).message())
+}
+
+func TestWrapError(t *testing.T) {
+	err := ErrInsufficientCoins("10steak < 20steak")
+	wrapped := WrapError(err, "delegation of %s", "20steak")
+	require.Equal(t, CodespaceRoot, wrapped.Codespace())
+	require.Equal(t, CodeInsufficientCoins, wrapped.Code())
+	require.Equal(t, "delegation of 20steak: 10steak < 20steak", wrapped.(*sdkError).message())
+	require.Equal(t, err, wrapped.(*sdkError).Cause())
+	require.Equal(t, err, wrapped.(*sdkError).Unwrap())
+
+	// the wrapped error keeps being of the same kind
+	desc, _ := GetRegisteredError(CodespaceRoot, CodeInsufficientCoins)
+	require.True(t, desc.Is(wrapped))
+	require.True(t, wrapped.(*sdkError).Is(desc))
+	require.True(t, wrapped.(*sdkError).Is(ErrInsufficientCoins("")))
+	require.False(t, wrapped.(*sdkError).Is(ErrInvalidCoins("")))
+	other, _ := GetRegisteredError(CodespaceRoot, CodeInvalidCoins)
+	require.False(t, other.Is(wrapped))
+
+	// other errors are wrapped as internal errors
+	wrapped = WrapError(errors.New("disk full"), "")
+	require.Equal(t, CodeInternal, wrapped.Code())
+	require.Equal(t, "disk full", wrapped.(*sdkError).message())
+
+	// traces with a message wrap the error
+	traced := ErrInvalidCoins("0steak").TraceSDK("inputs and outputs don't match")
+	require.Equal(t, CodeInvalidCoins, traced.Code())
+	require.Equal(t, "inputs and outputs don't match: 0steak", traced.(*sdkError).message())
+}
+
+func TestABCILogHasNoTraces(t *testing.T) {
+	err := ErrUnauthorized("wrong signer").TraceSDK("")
+	err.Stacktrace()
+	log := err.ABCILog()
+	require.Contains(t, log, "Error:     wrong signer\n")
+	require.NotContains(t, log, "Stack Trace")
+	require.NotContains(t, log, "errors_test.go")
+	require.Equal(t, log, ErrUnauthorized("wrong signer").ABCILog())
+}
@@ -0,0 +1,153 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Attribute is a single key/value pair describing an event.
+type Attribute struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+// NewAttribute returns a new key/value attribute
+func NewAttribute(key, value string) Attribute {
+	return Attribute{Key: key, Value: value}
+}
+
+// String implements fmt.Stringer
+func (a Attribute) String() string {
+	return fmt.Sprintf("%s: %s", a.Key, a.Value)
+}
+
+// Event is a typed occurrence during the execution of a message or a block,
+// eg. a transfer of coins, described by a list of attributes.
+type Event struct {
+	Type       string      `json:"type"`
+	Attributes []Attribute `json:"attributes"`
+}
+
+// NewEvent returns a new event of the given type with the given attributes
+func NewEvent(ty string, attrs ...Attribute) Event {
+	return Event{Type: ty, Attributes: attrs}
+}
+
+// AppendAttributes returns the event with the attributes appended
+func (e Event) AppendAttributes(attrs ...Attribute) Event {
+	e.Attributes = append(e.Attributes, attrs...)
+	return e
+}
+
+// String implements fmt.Stringer
+func (e Event) String() string {
+	attrs := make([]string, len(e.Attributes))
+	for i, attr := range e.Attributes {
+		attrs[i] = attr.String()
+	}
+	return fmt.Sprintf("%s{%s}", e.Type, strings.Join(attrs, ", "))
+}
+
+// Events is a list of events, in the order they were emitted
+type Events []Event
+
+// EmptyEvents returns an empty list of events
+func EmptyEvents() Events {
+	return make(Events, 0)
+}
+
+// AppendEvent appends a single event
+func (e Events) AppendEvent(event Event) Events {
+	return append(e, event)
+}
+
+// AppendEvents appends a list of events
+func (e Events) AppendEvents(events Events) Events {
+	return append(e, events...)
+}
+
+// ToTags flattens the events into ABCI tags so they can be indexed and
+// subscribed to by Tendermint. Each attribute becomes one tag keyed by
+// "<event type>.<attribute key>", eg. "transfer.recipient".
+func (e Events) ToTags() Tags {
+	tags := EmptyTags()
+	for _, event := range e {
+		for _, attr := range event.Attributes {
+			tags = tags.AppendTag(event.Type+"."+attr.Key, []byte(attr.Value))
+		}
+	}
+	return tags
+}
+
+// EventsFromTags rebuilds the events flattened by ToTags. Consecutive tags of
+// the same event type are grouped into one event, unless an attribute key is
+// repeated, which starts a new event. Tags which are not of the form
+// "<event type>.<attribute key>" are ignored.
+func EventsFromTags(tags Tags) Events {
+	events := EmptyEvents()
+	for _, tag := range tags {
+		key := string(tag.Key)
+		i := strings.Index(key, ".")
+		if i <= 0 || i == len(key)-1 {
+			continue
+		}
+		ty, attr := key[:i], NewAttribute(key[i+1:], string(tag.Value))
+		if n := len(events); n > 0 && events[n-1].Type == ty && !events[n-1].hasKey(attr.Key) {
+			events[n-1] = events[n-1].AppendAttributes(attr)
+			continue
+		}
+		events = events.AppendEvent(NewEvent(ty, attr))
+	}
+	return events
+}
+
+func (e Event) hasKey(key string) bool {
+	for _, attr := range e.Attributes {
+		if attr.Key == key {
+			return true
+		}
+	}
+	return false
+}
+
+//__________________________________________________
+
+// EventManager collects the events emitted while executing a message or a
+// block. It is carried by the Context and shared by all contexts derived
+// from it, so that keepers can emit events without returning them.
+type EventManager struct {
+	events Events
+}
+
+// NewEventManager returns an event manager with no events
+func NewEventManager() *EventManager {
+	return &EventManager{EmptyEvents()}
+}
+
+// EmitEvent records a single event
+func (em *EventManager) EmitEvent(event Event) {
+	em.events = em.events.AppendEvent(event)
+}
+
+// EmitEvents records a list of events
+func (em *EventManager) EmitEvents(events Events) {
+	em.events = em.events.AppendEvents(events)
+}
+
+// Events returns the events emitted so far
+func (em *EventManager) Events() Events {
+	return em.events
+}
+
+//__________________________________________________
+
+// Common event types and attribute keys emitted by BaseApp and shared by
+// modules. Module specific events are documented by the module.
+const (
+	// EventTypeMessage is emitted by BaseApp before the events of each
+	// message of a transaction, so events can be attributed to a message.
+	EventTypeMessage = "message"
+
+	AttributeKeyMsgIndex = "index"  // index of the message in the tx
+	AttributeKeyModule   = "module" // route of the message
+)
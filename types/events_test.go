@@ -0,0 +1,61 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestEventsToTags(t *testing.T) {
+	events := Events{
+		NewEvent("transfer", NewAttribute("sender", "a"), NewAttribute("recipient", "b")),
+		NewEvent("issue", NewAttribute("issuer", "c")),
+	}
+	require.Equal(t, Tags{
+		MakeTag("transfer.sender", []byte("a")),
+		MakeTag("transfer.recipient", []byte("b")),
+		MakeTag("issue.issuer", []byte("c")),
+	}, events.ToTags())
+	require.Equal(t, Tags{}, EmptyEvents().ToTags())
+}
+
+func TestEventsFromTags(t *testing.T) {
+	cases := []struct {
+		events Events
+	}{
+		{Events{}},
+		{Events{NewEvent("transfer", NewAttribute("sender", "a"), NewAttribute("amount", "10steak"))}},
+		// consecutive events of the same type are told apart by a repeated key
+		{Events{
+			NewEvent("transfer", NewAttribute("sender", "a"), NewAttribute("recipient", "b")),
+			NewEvent("transfer", NewAttribute("sender", "b"), NewAttribute("recipient", "c")),
+			NewEvent("issue", NewAttribute("issuer", "c")),
+			NewEvent("transfer", NewAttribute("sender", "c")),
+		}},
+		// values may contain dots and be empty
+		{Events{NewEvent("edit", NewAttribute("moniker", "a.b"), NewAttribute("identity", ""))}},
+	}
+
+	for tcIndex, tc := range cases {
+		require.Equal(t, tc.events, EventsFromTags(tc.events.ToTags()), "tc #%d", tcIndex)
+	}
+
+	// tags which aren't flattened events are skipped
+	tags := Tags{
+		MakeTag("block-gas-used", []byte("10")),
+		MakeTag(".key", []byte("a")),
+		MakeTag("type.", []byte("b")),
+		MakeTag("message.index", []byte("0")),
+	}
+	require.Equal(t, Events{NewEvent("message", NewAttribute("index", "0"))}, EventsFromTags(tags))
+}
+
+func TestEventManager(t *testing.T) {
+	em := NewEventManager()
+	require.Equal(t, Events{}, em.Events())
+
+	event := NewEvent("transfer", NewAttribute("sender", "a"))
+	em.EmitEvent(event)
+	em.EmitEvents(Events{event, event})
+	require.Equal(t, Events{event, event, event}, em.Events())
+}
@@ -11,6 +11,8 @@ type ErrorOutOfGas struct {
 // GasMeter interface to track gas consumption
 type GasMeter interface {
 	GasConsumed() Gas
+	Limit() Gas
+	IsPastLimit() bool
 	ConsumeGas(amount Gas, descriptor string)
 }
 
@@ -30,6 +32,14 @@ func (g *basicGasMeter) GasConsumed() Gas {
 	return g.consumed
 }
 
+func (g *basicGasMeter) Limit() Gas {
+	return g.limit
+}
+
+func (g *basicGasMeter) IsPastLimit() bool {
+	return g.consumed > g.limit
+}
+
 func (g *basicGasMeter) ConsumeGas(amount Gas, descriptor string) {
 	g.consumed += amount
 	if g.consumed > g.limit {
@@ -51,6 +61,15 @@ func (g *infiniteGasMeter) GasConsumed() Gas {
 	return g.consumed
 }
 
+// Limit is always zero for an infinite gas meter.
+func (g *infiniteGasMeter) Limit() Gas {
+	return 0
+}
+
+func (g *infiniteGasMeter) IsPastLimit() bool {
+	return false
+}
+
 func (g *infiniteGasMeter) ConsumeGas(amount Gas, descriptor string) {
 	g.consumed += amount
 }
@@ -1,8 +1,17 @@
 package types
 
+import (
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
 // Handler defines the core of the state transition function of an application.
 type Handler func(ctx Context, msg Msg) Result
 
 // AnteHandler authenticates transactions, before their internal messages are handled.
 // If newCtx.IsZero(), ctx is used instead.
 type AnteHandler func(ctx Context, tx Tx) (newCtx Context, result Result, abort bool)
+
+// Querier answers the custom queries routed to a module, eg. computed values
+// which can't be read directly from the store. The path doesn't include the
+// route of the module.
+type Querier func(ctx Context, path []string, req abci.RequestQuery) (res []byte, err Error)
@@ -0,0 +1,79 @@
+package types
+
+import "fmt"
+
+// nolint
+const (
+	DefaultPageLimit = 30
+	MaxPageLimit     = 100
+)
+
+// PaginationParams selects a page of the items of a list query. Pages start at
+// 1 and hold up to Limit items.
+type PaginationParams struct {
+	Page  int `json:"page"`
+	Limit int `json:"limit"`
+}
+
+// NewPaginationParams creates the params of a page, using the first page and
+// the default limit for the zero values
+func NewPaginationParams(page, limit int) PaginationParams {
+	if page == 0 {
+		page = 1
+	}
+	if limit == 0 {
+		limit = DefaultPageLimit
+	}
+	return PaginationParams{
+		Page:  page,
+		Limit: limit,
+	}
+}
+
+// ValidateBasic checks the page and the limit are within bounds
+func (p PaginationParams) ValidateBasic() Error {
+	if p.Page < 1 {
+		return ErrUnknownRequest(fmt.Sprintf("page must be positive, got %d", p.Page))
+	}
+	if p.Limit < 1 || p.Limit > MaxPageLimit {
+		return ErrUnknownRequest(fmt.Sprintf("limit must be between 1 and %d, got %d", MaxPageLimit, p.Limit))
+	}
+	return nil
+}
+
+// Start returns the index of the first item of the page among all the items
+// matching the query
+func (p PaginationParams) Start() int {
+	return (p.Page - 1) * p.Limit
+}
+
+// End returns the index following the last item of the page
+func (p PaginationParams) End() int {
+	return p.Page * p.Limit
+}
+
+// Paginator walks the items matching a list query in store order and tells
+// which of them belong to the requested page, so that store iterations can
+// stop as soon as the page is full
+type Paginator struct {
+	params PaginationParams
+	count  int
+}
+
+// NewPaginator creates a Paginator for the given page
+func NewPaginator(params PaginationParams) *Paginator {
+	return &Paginator{params: params}
+}
+
+// Next counts a matching item and returns whether it is on the page
+func (p *Paginator) Next() bool {
+	onPage := p.count >= p.params.Start() && p.count < p.params.End()
+	p.count++
+	return onPage
+}
+
+// Done returns whether the page is full, after which the remaining items
+// don't need to be read
+func (p *Paginator) Done() bool {
+	return p.count >= p.params.End()
+}
@@ -0,0 +1,53 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPaginationParams(t *testing.T) {
+	params := NewPaginationParams(0, 0)
+	require.Equal(t, PaginationParams{1, DefaultPageLimit}, params)
+	require.Nil(t, params.ValidateBasic())
+
+	cases := []struct {
+		page, limit int
+		valid       bool
+	}{
+		{1, 1, true},
+		{3, MaxPageLimit, true},
+		{-1, 10, false},
+		{1, -1, false},
+		{1, MaxPageLimit + 1, false},
+	}
+	for i, tc := range cases {
+		err := NewPaginationParams(tc.page, tc.limit).ValidateBasic()
+		require.Equal(t, tc.valid, err == nil, "case %d", i)
+	}
+}
+
+func TestPaginator(t *testing.T) {
+	cases := []struct {
+		page, limit, items int
+		expected           []int
+		done               bool
+	}{
+		{1, 2, 5, []int{0, 1}, true},
+		{2, 2, 5, []int{2, 3}, true},
+		{3, 2, 5, []int{4}, false},
+		{4, 2, 5, nil, false},
+		{1, 10, 0, nil, false},
+	}
+	for i, tc := range cases {
+		paginator := NewPaginator(NewPaginationParams(tc.page, tc.limit))
+		var onPage []int
+		for item := 0; item < tc.items && !paginator.Done(); item++ {
+			if paginator.Next() {
+				onPage = append(onPage, item)
+			}
+		}
+		require.Equal(t, tc.expected, onPage, "case %d", i)
+		require.Equal(t, tc.done, paginator.Done(), "case %d", i)
+	}
+}
@@ -24,9 +24,38 @@ type Result struct {
 
 	// Tags are used for transaction indexing and pubsub.
 	Tags Tags
+
+	// Events are the typed events emitted while processing the tx. They are
+	// flattened into Tags when returned to Tendermint.
+	Events Events
 }
 
 // TODO: In the future, more codes may be OK.
 func (res Result) IsOK() bool {
 	return res.Code.IsOK()
 }
+
+// MsgResult is the outcome of a single msg of a tx. It is part of the tx
+// Data, which is hashed into the block results, so it only holds
+// deterministic fields. The logs of the msgs are in the Log of the tx and
+// their events are told apart by the msg index of their message event.
+type MsgResult struct {
+
+	// Index is the position of the msg in the tx.
+	Index int `json:"index"`
+
+	// Code is the response code of the msg, non-zero if it failed.
+	Code ABCICodeType `json:"code"`
+
+	// Data is any data returned by the msg handler.
+	Data []byte `json:"data"`
+
+	// GasUsed is the gas consumed by the msg handler.
+	GasUsed int64 `json:"gas_used"`
+}
+
+// TxData is encoded into the Data of a tx result. It lists the results of
+// the msgs of the tx in order, up to and including the first failed msg.
+type TxData struct {
+	MsgResults []MsgResult `json:"msg_results"`
+}
@@ -42,8 +42,9 @@ type Validator interface {
 	GetStatus() BondStatus    // status of the validator
 	GetOwner() AccAddress     // owner AccAddress to receive/return validators coins
 	GetPubKey() crypto.PubKey // validation pubkey
-	GetPower() Rat            // validation power
-	GetDelegatorShares() Rat  // Total out standing delegator shares
+	GetConsAddr() ConsAddress // validation consensus address
+	GetPower() Dec            // validation power
+	GetDelegatorShares() Dec  // Total out standing delegator shares
 	GetBondHeight() int64     // height in which the validator became active
 }
 
@@ -65,13 +66,14 @@ type ValidatorSet interface {
 	IterateValidatorsBonded(Context,
 		func(index int64, validator Validator) (stop bool))
 
-	Validator(Context, AccAddress) Validator // get a particular validator by owner AccAddress
-	TotalPower(Context) Rat                  // total power of the validator set
+	Validator(Context, AccAddress) Validator            // get a particular validator by owner AccAddress
+	ValidatorByConsAddr(Context, ConsAddress) Validator // get a particular validator by consensus address
+	TotalPower(Context) Dec                             // total power of the validator set
 
 	// slash the validator and delegators of the validator, specifying offence height, offence power, and slash fraction
-	Slash(Context, crypto.PubKey, int64, int64, Rat)
-	Revoke(Context, crypto.PubKey)   // revoke a validator
-	Unrevoke(Context, crypto.PubKey) // unrevoke a validator
+	Slash(Context, ConsAddress, int64, int64, Dec)
+	Revoke(Context, ConsAddress)   // revoke a validator
+	Unrevoke(Context, ConsAddress) // unrevoke a validator
 }
 
 //_______________________________________________________________________________
@@ -80,7 +82,7 @@ type ValidatorSet interface {
 type Delegation interface {
 	GetDelegator() AccAddress // delegator AccAddress for the bond
 	GetValidator() AccAddress // validator owner AccAddress for the bond
-	GetBondShares() Rat       // amount of validator's shares
+	GetBondShares() Dec       // amount of validator's shares
 }
 
 // properties for the set of all delegations for a particular
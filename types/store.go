@@ -87,6 +87,16 @@ type MultiStore interface { //nolint
 type CacheMultiStore interface {
 	MultiStore
 	Write() // Writes operations to underlying KVStore
+
+	// ListeningEnabled returns if listening is enabled for the KVStore of the
+	// given key.
+	ListeningEnabled(key StoreKey) bool
+
+	// SetListeners sets the WriteListeners for the KVStore of the given key.
+	// They observe the writes made directly to this CacheMultiStore as well as
+	// the writes flushed into it by its own cache-wraps, but not the writes of
+	// a cache-wrap that is never written.
+	SetListeners(key StoreKey, listeners []WriteListener)
 }
 
 // A non-cache MultiStore.
@@ -108,6 +118,11 @@ type CommitMultiStore interface {
 	// calls to Mount*Store() are complete.
 	LoadLatestVersion() error
 
+	// CacheMultiStoreWithVersion cache-wraps the stores read-only at a
+	// committed version, e.g. to serve queries at a past height. It fails
+	// with ErrInvalidHeight if the version was pruned or isn't committed.
+	CacheMultiStoreWithVersion(version int64) (CacheMultiStore, Error)
+
 	// Load a specific persisted version.  When you load an old
 	// version, or when the last commit attempt didn't complete,
 	// the next commit after loading must be idempotent (return the
@@ -157,6 +172,12 @@ type KVStore interface {
 	// GetSubKVStore(key *storeKey) KVStore
 }
 
+// WriteListener is notified of the sets and deletes made to a listening
+// KVStore. For deletes, value is nil and delete is true.
+type WriteListener interface {
+	OnWrite(storeKey StoreKey, key []byte, value []byte, delete bool)
+}
+
 // Alias iterator to db's Iterator for convenience.
 type Iterator = dbm.Iterator
 
@@ -246,6 +267,7 @@ const (
 	StoreTypeDB
 	StoreTypeIAVL
 	StoreTypePrefix
+	StoreTypeTransient
 )
 
 //----------------------------------------
@@ -284,6 +306,34 @@ func (key *KVStoreKey) KVStore(ctx Context) KVStore {
 	return ctx.KVStore(key)
 }
 
+// TransientStoreKey is used for indexing transient stores in a MultiStore
+type TransientStoreKey struct {
+	name string
+}
+
+// Constructs new TransientStoreKey
+// Must return a pointer according to the ocap principle
+func NewTransientStoreKey(name string) *TransientStoreKey {
+	return &TransientStoreKey{
+		name: name,
+	}
+}
+
+// Implements StoreKey
+func (key *TransientStoreKey) Name() string {
+	return key.name
+}
+
+// Implements StoreKey
+func (key *TransientStoreKey) String() string {
+	return fmt.Sprintf("TransientStoreKey{%p, %s}", key, key.name)
+}
+
+// Implements KVStoreGetter
+func (key *TransientStoreKey) KVStore(ctx Context) KVStore {
+	return ctx.KVStore(key)
+}
+
 // PrefixEndBytes returns the []byte that would end a
 // range query for all []byte with a certain prefix
 // Deals with last byte of prefix being FF without overflowing
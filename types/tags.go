@@ -56,8 +56,5 @@ func MakeTag(k string, v []byte) Tag {
 
 // common tags
 var (
-	TagAction       = "action"
-	TagSrcValidator = "source-validator"
-	TagDstValidator = "destination-validator"
-	TagDelegator    = "delegator"
+	TagBlockGasUsed = "block-gas-used"
 )
@@ -90,7 +90,7 @@ func NewAnteHandler(am AccountMapper, fck FeeCollectionKeeper) sdk.AnteHandler {
 
 		// TODO: tx tags (?)
 
-		return ctx, sdk.Result{}, false // continue...
+		return ctx, sdk.Result{GasWanted: stdTx.Fee.Gas}, false // continue...
 	}
 }
 
@@ -5,7 +5,9 @@ import (
 	"fmt"
 
 	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
 
+	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/context"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/wire"
@@ -75,3 +77,42 @@ func GetAccountCmd(storeName string, cdc *wire.Codec, decoder auth.AccountDecode
 		},
 	}
 }
+
+// GetAccountsCmd returns a query command listing a page of the accounts in
+// the state
+func GetAccountsCmd(queryRoute string, cdc *wire.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "accounts",
+		Short: "Query a page of all the accounts",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			params := auth.QueryAccountsParams{
+				Pagination: sdk.NewPaginationParams(viper.GetInt(client.FlagPage), viper.GetInt(client.FlagLimit)),
+			}
+			bz, err := cdc.MarshalJSON(params)
+			if err != nil {
+				return err
+			}
+
+			// perform query
+			ctx := context.NewCoreContextFromViper()
+			res, _, err := ctx.QueryWithData(fmt.Sprintf("/custom/%s/%s", queryRoute, auth.QueryAccounts), bz)
+			if err != nil {
+				return err
+			}
+
+			var accounts []auth.Account
+			cdc.MustUnmarshalJSON(res, &accounts)
+
+			output, err := wire.MarshalJSONIndent(cdc, accounts)
+			if err != nil {
+				return err
+			}
+			fmt.Println(string(output))
+			return nil
+		},
+	}
+
+	client.AddPaginationFlags(cmd)
+	return cmd
+}
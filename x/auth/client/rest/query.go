@@ -7,6 +7,7 @@ import (
 	"github.com/gorilla/mux"
 
 	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/utils"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/wire"
 	"github.com/cosmos/cosmos-sdk/x/auth"
@@ -19,6 +20,10 @@ func RegisterRoutes(ctx context.CoreContext, r *mux.Router, cdc *wire.Codec, sto
 		"/accounts/{address}",
 		QueryAccountRequestHandlerFn(storeName, cdc, authcmd.GetAccountDecoder(cdc), ctx),
 	).Methods("GET")
+	r.HandleFunc(
+		"/accounts",
+		QueryAccountsRequestHandlerFn(storeName, cdc, ctx),
+	).Methods("GET")
 }
 
 // query accountREST Handler
@@ -34,12 +39,17 @@ func QueryAccountRequestHandlerFn(storeName string, cdc *wire.Codec, decoder aut
 			return
 		}
 
-		res, err := ctx.QueryStore(auth.AddressStoreKey(addr), storeName)
+		ctx, ok := utils.ParseQueryHeight(w, r, ctx)
+		if !ok {
+			return
+		}
+
+		res, height, err := ctx.QueryStoreWithHeight(auth.AddressStoreKey(addr), storeName)
 		if err != nil {
-			w.WriteHeader(http.StatusInternalServerError)
-			w.Write([]byte(fmt.Sprintf("couldn't query account. Error: %s", err.Error())))
+			utils.WriteQueryError(w, "couldn't query account", err)
 			return
 		}
+		utils.WriteQueryHeight(w, height)
 
 		// the query will return empty if there is no data for this account
 		if len(res) == 0 {
@@ -66,3 +76,36 @@ func QueryAccountRequestHandlerFn(storeName string, cdc *wire.Codec, decoder aut
 		w.Write(output)
 	}
 }
+
+// query a page of the accounts REST Handler. The accounts are listed by the
+// querier registered on the route named after the account store.
+func QueryAccountsRequestHandlerFn(storeName string, cdc *wire.Codec, ctx context.CoreContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		pagination, ok := utils.ParsePaginationParams(w, r)
+		if !ok {
+			return
+		}
+
+		ctx, ok := utils.ParseQueryHeight(w, r, ctx)
+		if !ok {
+			return
+		}
+
+		bz, err := cdc.MarshalJSON(auth.QueryAccountsParams{Pagination: pagination})
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(err.Error()))
+			return
+		}
+
+		res, height, err := ctx.QueryWithData(fmt.Sprintf("/custom/%s/%s", storeName, auth.QueryAccounts), bz)
+		if err != nil {
+			utils.WriteQueryError(w, "couldn't query accounts", err)
+			return
+		}
+		utils.WriteQueryHeight(w, height)
+
+		// the querier already returns the JSON encoded accounts
+		w.Write(res)
+	}
+}
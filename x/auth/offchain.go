@@ -0,0 +1,81 @@
+package auth
+
+import (
+	"bytes"
+
+	"github.com/tendermint/tendermint/crypto"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// OffChainChainID is the chain ID of the sign docs of off-chain messages. It
+// separates their signatures from the ones of txs, which are signed for the ID
+// of a real chain.
+const OffChainChainID = "cosmos-sdk/off-chain-message/not-a-transaction"
+
+// MsgSignData is the single msg of the sign doc of an off-chain message.
+// It isn't registered on the codec of apps and fails ValidateBasic, so a
+// signature of an off-chain message can never authorize a tx.
+type MsgSignData struct {
+	Signer sdk.AccAddress `json:"signer"`
+	Data   []byte         `json:"data"`
+}
+
+var _ sdk.Msg = MsgSignData{}
+
+// NewMsgSignData creates the msg of the off-chain message data signed by signer
+func NewMsgSignData(signer sdk.AccAddress, data []byte) MsgSignData {
+	return MsgSignData{
+		Signer: signer,
+		Data:   data,
+	}
+}
+
+// nolint
+func (msg MsgSignData) Type() string                 { return "offchain" }
+func (msg MsgSignData) GetSigners() []sdk.AccAddress { return []sdk.AccAddress{msg.Signer} }
+
+// ValidateBasic always fails, an off-chain message can't be included in a tx
+func (msg MsgSignData) ValidateBasic() sdk.Error {
+	return sdk.ErrUnknownRequest("off-chain messages can't be included in transactions")
+}
+
+// GetSignBytes returns the sorted JSON of the msg
+func (msg MsgSignData) GetSignBytes() []byte {
+	bz, err := msgCdc.MarshalJSON(msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(bz)
+}
+
+// OffChainSignBytes returns the bytes to sign for an off-chain message: the
+// bytes of a StdSignDoc for the OffChainChainID, with a zero fee and account
+// and sequence numbers, whose only msg is a MsgSignData.
+func OffChainSignBytes(signer sdk.AccAddress, data []byte) []byte {
+	msgs := []sdk.Msg{NewMsgSignData(signer, data)}
+	return StdSignBytes(OffChainChainID, 0, 0, NewStdFee(0), msgs, "")
+}
+
+// StdSignedMessage is an off-chain message signed with the key of an account
+type StdSignedMessage struct {
+	Message   string           `json:"message"`
+	Signer    sdk.AccAddress   `json:"signer"`
+	PubKey    crypto.PubKey    `json:"pub_key"`
+	Signature crypto.Signature `json:"signature"`
+}
+
+// Verify checks the pub key is the one of the signer and the signature is the
+// one of the message by it
+func (m StdSignedMessage) Verify() sdk.Error {
+	if m.PubKey == nil || m.Signature == nil {
+		return sdk.ErrUnauthorized("missing pub key or signature")
+	}
+	if !bytes.Equal(m.PubKey.Address(), m.Signer) {
+		return sdk.ErrInvalidPubKey("pub key does not match the signer address")
+	}
+	if !m.PubKey.VerifyBytes(OffChainSignBytes(m.Signer, []byte(m.Message)), m.Signature) {
+		return sdk.ErrUnauthorized("signature verification failed")
+	}
+	return nil
+}
@@ -0,0 +1,39 @@
+package auth
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestStdSignedMessage(t *testing.T) {
+	priv1, priv2 := crypto.GenPrivKeySecp256k1(), crypto.GenPrivKeySecp256k1()
+	addr1 := sdk.AccAddress(priv1.PubKey().Address())
+	message := "I own this account"
+
+	sig, err := priv1.Sign(OffChainSignBytes(addr1, []byte(message)))
+	require.NoError(t, err)
+	signed := StdSignedMessage{message, addr1, priv1.PubKey(), sig}
+	require.Nil(t, signed.Verify())
+
+	// the message, the signer and the pub key are all covered
+	tampered := signed
+	tampered.Message = "I own another account"
+	require.NotNil(t, tampered.Verify())
+	tampered = signed
+	tampered.PubKey = priv2.PubKey()
+	require.NotNil(t, tampered.Verify())
+	tampered = signed
+	tampered.Signer = sdk.AccAddress(priv2.PubKey().Address())
+	require.NotNil(t, tampered.Verify())
+	require.NotNil(t, StdSignedMessage{Message: message, Signer: addr1}.Verify())
+
+	// the signature isn't the one of a tx with the same content
+	msg := NewMsgSignData(addr1, []byte(message))
+	require.NotNil(t, msg.ValidateBasic())
+	txSignBytes := StdSignBytes("test-chain", 0, 0, NewStdFee(0), []sdk.Msg{msg}, "")
+	require.False(t, priv1.PubKey().VerifyBytes(txSignBytes, sig))
+}
@@ -0,0 +1,64 @@
+package auth
+
+import (
+	"fmt"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// query endpoints supported by the auth Querier
+const (
+	QueryAccounts = "accounts"
+)
+
+// NewQuerier returns the querier of the accounts, to be registered on the
+// custom query router of the app
+func NewQuerier(am AccountMapper) sdk.Querier {
+	return func(ctx sdk.Context, path []string, req abci.RequestQuery) (res []byte, err sdk.Error) {
+		if len(path) == 0 {
+			return nil, sdk.ErrUnknownRequest("no auth query endpoint specified")
+		}
+		switch path[0] {
+		case QueryAccounts:
+			return queryAccounts(ctx, req, am)
+		default:
+			return nil, sdk.ErrUnknownRequest(fmt.Sprintf("unknown auth query endpoint %s", path[0]))
+		}
+	}
+}
+
+// QueryAccountsParams are the params of the accounts query
+type QueryAccountsParams struct {
+	Pagination sdk.PaginationParams `json:"pagination"`
+}
+
+// queryAccounts returns a page of the accounts, in the order of their
+// addresses
+func queryAccounts(ctx sdk.Context, req abci.RequestQuery, am AccountMapper) (res []byte, err sdk.Error) {
+	var params QueryAccountsParams
+	errRes := am.cdc.UnmarshalJSON(req.Data, &params)
+	if errRes != nil {
+		return nil, sdk.ErrUnknownRequest(fmt.Sprintf("incorrectly formatted request data - %s", errRes.Error()))
+	}
+	err = params.Pagination.ValidateBasic()
+	if err != nil {
+		return nil, err
+	}
+
+	paginator := sdk.NewPaginator(params.Pagination)
+	accounts := []Account{}
+	am.IterateAccounts(ctx, func(acc Account) (stop bool) {
+		if paginator.Next() {
+			accounts = append(accounts, acc)
+		}
+		return paginator.Done()
+	})
+
+	bz, errRes := am.cdc.MarshalJSON(accounts)
+	if errRes != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("could not marshal the accounts - %s", errRes.Error()))
+	}
+	return bz, nil
+}
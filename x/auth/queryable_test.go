@@ -0,0 +1,58 @@
+package auth
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	wire "github.com/cosmos/cosmos-sdk/wire"
+)
+
+func TestQueryAccounts(t *testing.T) {
+	ms, capKey, _ := setupMultiStore()
+	cdc := wire.NewCodec()
+	RegisterBaseAccount(cdc)
+
+	ctx := sdk.NewContext(ms, abci.Header{}, false, log.NewNopLogger())
+	mapper := NewAccountMapper(cdc, capKey, ProtoBaseAccount)
+	querier := NewQuerier(mapper)
+
+	for i := 0; i < 5; i++ {
+		acc := mapper.NewAccountWithAddress(ctx, sdk.AccAddress([]byte(fmt.Sprintf("address-%d", i))))
+		mapper.SetAccount(ctx, acc)
+	}
+
+	queryPage := func(page, limit int) []Account {
+		bz, err := cdc.MarshalJSON(QueryAccountsParams{sdk.NewPaginationParams(page, limit)})
+		require.Nil(t, err)
+		res, sdkErr := querier(ctx, []string{QueryAccounts}, abci.RequestQuery{Data: bz})
+		require.Nil(t, sdkErr)
+
+		var accounts []Account
+		require.Nil(t, cdc.UnmarshalJSON(res, &accounts))
+		return accounts
+	}
+
+	accounts := queryPage(1, 2)
+	require.Len(t, accounts, 2)
+	require.Equal(t, sdk.AccAddress([]byte("address-0")), accounts[0].GetAddress())
+	require.Equal(t, sdk.AccAddress([]byte("address-1")), accounts[1].GetAddress())
+
+	accounts = queryPage(3, 2)
+	require.Len(t, accounts, 1)
+	require.Equal(t, sdk.AccAddress([]byte("address-4")), accounts[0].GetAddress())
+
+	require.Len(t, queryPage(4, 2), 0)
+	require.Len(t, queryPage(1, 0), 5)
+
+	// invalid limit
+	bz, err := cdc.MarshalJSON(QueryAccountsParams{sdk.NewPaginationParams(1, sdk.MaxPageLimit+1)})
+	require.Nil(t, err)
+	_, sdkErr := querier(ctx, []string{QueryAccounts}, abci.RequestQuery{Data: bz})
+	require.NotNil(t, sdkErr)
+}
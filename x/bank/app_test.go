@@ -77,14 +77,30 @@ var (
 )
 
 // initialize the mock application for this module
-func getMockApp(t *testing.T) *mock.App {
-	mapp, err := getBenchmarkMockApp()
+func getMockApp(t *testing.T) (*mock.App, Keeper) {
+	mapp, keeper, err := getBenchmarkMockApp()
 	require.NoError(t, err)
-	return mapp
+	return mapp, keeper
+}
+
+// getInitChainer initializes the chainer of the mock app and sets the genesis
+// supply to the sum of the genesis accounts, addr1 is the issuer of issuecoin.
+func getInitChainer(mapp *mock.App, keeper Keeper) sdk.InitChainer {
+	return func(ctx sdk.Context, req abci.RequestInitChain) abci.ResponseInitChain {
+		mapp.InitChainer(ctx, req)
+
+		supply := sdk.Coins{}
+		for _, acc := range mapp.GenesisAccounts {
+			supply = supply.Plus(acc.GetCoins())
+		}
+		InitGenesis(ctx, keeper, NewGenesisState(supply, []Issuer{NewIssuer("issuecoin", addr1)}, nil, nil))
+
+		return abci.ResponseInitChain{}
+	}
 }
 
 func TestBankWithRandomMessages(t *testing.T) {
-	mapp := getMockApp(t)
+	mapp, keeper := getMockApp(t)
 	setup := func(r *rand.Rand, keys []crypto.PrivKey) {
 		return
 	}
@@ -93,13 +109,13 @@ func TestBankWithRandomMessages(t *testing.T) {
 		t,
 		[]mock.TestAndRunTx{TestAndRunSingleInputMsgSend},
 		[]mock.RandSetup{setup},
-		[]mock.Invariant{ModuleInvariants},
+		[]mock.Invariant{ModuleInvariants(keeper)},
 		100, 30, 30,
 	)
 }
 
 func TestMsgSendWithAccounts(t *testing.T) {
-	mapp := getMockApp(t)
+	mapp, _ := getMockApp(t)
 
 	// Add an account at genesis
 	acc := &auth.BaseAccount{
@@ -140,7 +156,7 @@ func TestMsgSendWithAccounts(t *testing.T) {
 }
 
 func TestMsgSendMultipleOut(t *testing.T) {
-	mapp := getMockApp(t)
+	mapp, _ := getMockApp(t)
 
 	acc1 := &auth.BaseAccount{
 		Address: addr1,
@@ -165,7 +181,7 @@ func TestMsgSendMultipleOut(t *testing.T) {
 }
 
 func TestSengMsgMultipleInOut(t *testing.T) {
-	mapp := getMockApp(t)
+	mapp, _ := getMockApp(t)
 
 	acc1 := &auth.BaseAccount{
 		Address: addr1,
@@ -194,7 +210,7 @@ func TestSengMsgMultipleInOut(t *testing.T) {
 }
 
 func TestMsgSendDependent(t *testing.T) {
-	mapp := getMockApp(t)
+	mapp, _ := getMockApp(t)
 
 	acc1 := &auth.BaseAccount{
 		Address: addr1,
@@ -217,3 +233,37 @@ func TestMsgSendDependent(t *testing.T) {
 	// Check balances
 	mock.CheckBalance(t, mapp, addr1, sdk.Coins{sdk.NewCoin("foocoin", 42)})
 }
+
+func TestMsgIssue(t *testing.T) {
+	mapp, keeper := getMockApp(t)
+
+	acc1 := &auth.BaseAccount{
+		Address: addr1,
+		Coins:   sdk.Coins{sdk.NewCoin("foocoin", 42)},
+	}
+	acc2 := &auth.BaseAccount{
+		Address: addr2,
+		Coins:   sdk.Coins{sdk.NewCoin("foocoin", 42)},
+	}
+	mock.SetGenesis(mapp, []auth.Account{acc1, acc2})
+
+	issued := sdk.Coins{sdk.NewCoin("issuecoin", 10)}
+	issueMsg := NewMsgIssue(addr1, []Output{NewOutput(addr2, issued), NewOutput(addr3, issued)})
+
+	// only the registered issuer can issue
+	mock.SignCheckDeliver(t, mapp.BaseApp, []sdk.Msg{NewMsgIssue(addr2, issueMsg.Outputs)}, []int64{1}, []int64{0}, false, priv2)
+	mock.CheckBalance(t, mapp, addr2, sdk.Coins{sdk.NewCoin("foocoin", 42)})
+
+	// denoms without an issuer can't be issued
+	fooMsg := NewMsgIssue(addr1, []Output{NewOutput(addr2, sdk.Coins{sdk.NewCoin("foocoin", 10)})})
+	mock.SignCheckDeliver(t, mapp.BaseApp, []sdk.Msg{fooMsg}, []int64{0}, []int64{0}, false, priv1)
+
+	mock.SignCheckDeliver(t, mapp.BaseApp, []sdk.Msg{issueMsg}, []int64{0}, []int64{1}, true, priv1)
+	mock.CheckBalance(t, mapp, addr2, sdk.Coins{sdk.NewCoin("foocoin", 42), sdk.NewCoin("issuecoin", 10)})
+	mock.CheckBalance(t, mapp, addr3, issued)
+
+	ctx := mapp.BaseApp.NewContext(true, abci.Header{})
+	require.True(t, sdk.NewInt(20).Equal(keeper.GetSupply(ctx, "issuecoin")))
+	require.True(t, sdk.NewInt(84).Equal(keeper.GetSupply(ctx, "foocoin")))
+	SupplyInvariant(keeper)(t, mapp, "")
+}
@@ -12,19 +12,21 @@ import (
 
 // getBenchmarkMockApp initializes a mock application for this module, for purposes of benchmarking
 // Any long term API support commitments do not apply to this function.
-func getBenchmarkMockApp() (*mock.App, error) {
+func getBenchmarkMockApp() (*mock.App, Keeper, error) {
 	mapp := mock.NewApp()
 
 	RegisterWire(mapp.Cdc)
-	coinKeeper := NewKeeper(mapp.AccountMapper)
+	keySupply := sdk.NewKVStoreKey("supply")
+	coinKeeper := NewKeeper(mapp.AccountMapper, NewSupplyKeeper(mapp.Cdc, keySupply))
 	mapp.Router().AddRoute("bank", NewHandler(coinKeeper))
+	mapp.SetInitChainer(getInitChainer(mapp, coinKeeper))
 
-	err := mapp.CompleteSetup([]*sdk.KVStoreKey{})
-	return mapp, err
+	err := mapp.CompleteSetup(keySupply)
+	return mapp, coinKeeper, err
 }
 
 func BenchmarkOneBankSendTxPerBlock(b *testing.B) {
-	benchmarkApp, _ := getBenchmarkMockApp()
+	benchmarkApp, _, _ := getBenchmarkMockApp()
 
 	// Add an account at genesis
 	acc := &auth.BaseAccount{
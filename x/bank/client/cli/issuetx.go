@@ -0,0 +1,50 @@
+package cli
+
+import (
+	"github.com/cosmos/cosmos-sdk/client/context"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/wire"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	"github.com/cosmos/cosmos-sdk/x/bank/client"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+// IssueTxCmd will create an issue tx and sign it with the given key, the key
+// must be the registered issuer of the issued denoms
+func IssueTxCmd(storeName string, cdc *wire.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "issue",
+		Short: "Create and sign an issue tx, creating new coins",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.NewCoreContextFromViper()
+
+			// get the issuer and receiver addresses
+			from, err := ctx.GetFromAddress()
+			if err != nil {
+				return err
+			}
+
+			to, err := sdk.AccAddressFromBech32(viper.GetString(flagTo))
+			if err != nil {
+				return err
+			}
+
+			// parse coins trying to be issued
+			coins, err := client.ParseCoins(ctx, cdc, storeName, viper.GetString(flagAmount))
+			if err != nil {
+				return err
+			}
+
+			// build and sign the transaction, then broadcast to Tendermint
+			msg := bank.NewMsgIssue(from, []bank.Output{bank.NewOutput(to, coins)})
+
+			return ctx.EnsureSignBuildBroadcast(ctx.FromAddressName, []sdk.Msg{msg}, cdc)
+		},
+	}
+
+	cmd.Flags().String(flagTo, "", "Address to issue coins to")
+	cmd.Flags().String(flagAmount, "", "Amount of coins to issue")
+
+	return cmd
+}
@@ -0,0 +1,162 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/wire"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	authcmd "github.com/cosmos/cosmos-sdk/x/auth/client/cli"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	"github.com/cosmos/cosmos-sdk/x/bank/client"
+)
+
+const flagDisplay = "display"
+
+// GetCmdQuerySupply returns a command querying the total supply of a denom,
+// or of all denoms if none is given
+func GetCmdQuerySupply(storeName string, cdc *wire.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "supply [denom]",
+		Short: "Query the total supply of a denom or of all denoms",
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.NewCoreContextFromViper()
+
+			var supply interface{}
+			if len(args) == 1 {
+				res, err := ctx.QueryStore(bank.GetSupplyKey(args[0]), storeName)
+				if err != nil {
+					return err
+				}
+				supply, err = client.DecodeSupply(cdc, args[0], res)
+				if err != nil {
+					return err
+				}
+			} else {
+				kvs, err := ctx.QuerySubspace(cdc, bank.SupplyKeyPrefix, storeName)
+				if err != nil {
+					return err
+				}
+				supply, err = client.DecodeTotalSupply(cdc, kvs)
+				if err != nil {
+					return err
+				}
+			}
+
+			// print the supply in display units
+			if viper.GetBool(flagDisplay) {
+				metadata, err := client.QueryDenomMetadata(ctx, cdc, storeName)
+				if err != nil {
+					return err
+				}
+				switch supply := supply.(type) {
+				case sdk.Coin:
+					fmt.Println(bank.FormatDisplayCoins(metadata, sdk.Coins{supply}))
+				case sdk.Coins:
+					fmt.Println(bank.FormatDisplayCoins(metadata, supply))
+				}
+				return nil
+			}
+
+			output, err := wire.MarshalJSONIndent(cdc, supply)
+			if err != nil {
+				return err
+			}
+			fmt.Println(string(output))
+			return nil
+		},
+	}
+	cmd.Flags().Bool(flagDisplay, false, "Print the supply in display units")
+	return cmd
+}
+
+// GetCmdQueryBalance returns a command querying the coins of an account,
+// printed in display units with the denom metadata of the supply store
+func GetCmdQueryBalance(storeName string, cdc *wire.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "balance [address]",
+		Short: "Query the coins of an account in display units",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			addr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			ctx := context.NewCoreContextFromViper().WithDecoder(authcmd.GetAccountDecoder(cdc))
+			res, err := ctx.QueryStore(auth.AddressStoreKey(addr), ctx.AccountStore)
+			if err != nil {
+				return err
+			}
+
+			// Check if account was found
+			if res == nil {
+				return errors.Errorf("No account with address %s was found in the state.\nAre you sure there has been a transaction involving it?", addr)
+			}
+
+			account, err := ctx.Decoder(res)
+			if err != nil {
+				return err
+			}
+
+			metadata, err := client.QueryDenomMetadata(ctx, cdc, storeName)
+			if err != nil {
+				return err
+			}
+			fmt.Println(bank.FormatDisplayCoins(metadata, account.GetCoins()))
+			return nil
+		},
+	}
+}
+
+// GetCmdQueryDenomMetadata returns a command querying the metadata of a base
+// denom, or of all registered denoms if none is given
+func GetCmdQueryDenomMetadata(storeName string, cdc *wire.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "denom-metadata [base-denom]",
+		Short: "Query the display units of a denom or of all registered denoms",
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.NewCoreContextFromViper()
+
+			var metadata interface{}
+			if len(args) == 1 {
+				res, err := ctx.QueryStore(bank.GetMetadataKey(args[0]), storeName)
+				if err != nil {
+					return err
+				}
+				if len(res) == 0 {
+					return errors.Errorf("No metadata registered for denom %s", args[0])
+				}
+				var m bank.Metadata
+				err = cdc.UnmarshalBinary(res, &m)
+				if err != nil {
+					return err
+				}
+				metadata = m
+			} else {
+				kvs, err := ctx.QuerySubspace(cdc, bank.MetadataKeyPrefix, storeName)
+				if err != nil {
+					return err
+				}
+				metadata, err = client.DecodeDenomMetadata(cdc, kvs)
+				if err != nil {
+					return err
+				}
+			}
+
+			output, err := wire.MarshalJSONIndent(cdc, metadata)
+			if err != nil {
+				return err
+			}
+			fmt.Println(string(output))
+			return nil
+		},
+	}
+}
@@ -18,8 +18,9 @@ const (
 	flagAmount = "amount"
 )
 
-// SendTxCmd will create a send tx and sign it with the given key
-func SendTxCmd(cdc *wire.Codec) *cobra.Command {
+// SendTxCmd will create a send tx and sign it with the given key, amounts in
+// display units are converted with the denom metadata of the supply store
+func SendTxCmd(storeName string, cdc *wire.Codec) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "send",
 		Short: "Create and sign a send tx",
@@ -50,7 +51,7 @@ func SendTxCmd(cdc *wire.Codec) *cobra.Command {
 			}
 			// parse coins trying to be sent
 			amount := viper.GetString(flagAmount)
-			coins, err := sdk.ParseCoins(amount)
+			coins, err := client.ParseCoins(ctx, cdc, storeName, amount)
 			if err != nil {
 				return err
 			}
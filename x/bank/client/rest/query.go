@@ -0,0 +1,166 @@
+package rest
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gorilla/mux"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/utils"
+	"github.com/cosmos/cosmos-sdk/wire"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	"github.com/cosmos/cosmos-sdk/x/bank/client"
+)
+
+// RegisterQueryRoutes registers the REST routes querying the bank state in the
+// supply store
+func RegisterQueryRoutes(ctx context.CoreContext, r *mux.Router, cdc *wire.Codec, storeName string) {
+	r.HandleFunc("/supply", totalSupplyHandlerFn(ctx, cdc, storeName)).Methods("GET")
+	r.HandleFunc("/supply/{denom}", supplyHandlerFn(ctx, cdc, storeName)).Methods("GET")
+	r.HandleFunc("/denoms/metadata", allDenomMetadataHandlerFn(ctx, cdc, storeName)).Methods("GET")
+	r.HandleFunc("/denoms/{denom}/metadata", denomMetadataHandlerFn(ctx, cdc, storeName)).Methods("GET")
+}
+
+// http request handler to query the total supply of all denoms
+func totalSupplyHandlerFn(ctx context.CoreContext, cdc *wire.Codec, storeName string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx, ok := utils.ParseQueryHeight(w, r, ctx)
+		if !ok {
+			return
+		}
+
+		kvs, height, err := ctx.QuerySubspaceWithHeight(cdc, bank.SupplyKeyPrefix, storeName)
+		if err != nil {
+			utils.WriteQueryError(w, "couldn't query supply", err)
+			return
+		}
+		utils.WriteQueryHeight(w, height)
+
+		supply, err := client.DecodeTotalSupply(cdc, kvs)
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte(fmt.Sprintf("couldn't decode supply. Error: %s", err.Error())))
+			return
+		}
+
+		output, err := cdc.MarshalJSON(supply)
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte(err.Error()))
+			return
+		}
+
+		w.Write(output)
+	}
+}
+
+// http request handler to query the total supply of a denom
+func supplyHandlerFn(ctx context.CoreContext, cdc *wire.Codec, storeName string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		denom := mux.Vars(r)["denom"]
+
+		ctx, ok := utils.ParseQueryHeight(w, r, ctx)
+		if !ok {
+			return
+		}
+
+		res, height, err := ctx.QueryStoreWithHeight(bank.GetSupplyKey(denom), storeName)
+		if err != nil {
+			utils.WriteQueryError(w, "couldn't query supply", err)
+			return
+		}
+		utils.WriteQueryHeight(w, height)
+
+		supply, err := client.DecodeSupply(cdc, denom, res)
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte(fmt.Sprintf("couldn't decode supply. Error: %s", err.Error())))
+			return
+		}
+
+		output, err := cdc.MarshalJSON(supply)
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte(err.Error()))
+			return
+		}
+
+		w.Write(output)
+	}
+}
+
+// http request handler to query the metadata of all the registered denoms
+func allDenomMetadataHandlerFn(ctx context.CoreContext, cdc *wire.Codec, storeName string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx, ok := utils.ParseQueryHeight(w, r, ctx)
+		if !ok {
+			return
+		}
+
+		kvs, height, err := ctx.QuerySubspaceWithHeight(cdc, bank.MetadataKeyPrefix, storeName)
+		if err != nil {
+			utils.WriteQueryError(w, "couldn't query denom metadata", err)
+			return
+		}
+		utils.WriteQueryHeight(w, height)
+
+		metadata, err := client.DecodeDenomMetadata(cdc, kvs)
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte(fmt.Sprintf("couldn't decode denom metadata. Error: %s", err.Error())))
+			return
+		}
+
+		output, err := cdc.MarshalJSON(metadata)
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte(err.Error()))
+			return
+		}
+
+		w.Write(output)
+	}
+}
+
+// http request handler to query the metadata of a base denom
+func denomMetadataHandlerFn(ctx context.CoreContext, cdc *wire.Codec, storeName string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		denom := mux.Vars(r)["denom"]
+
+		ctx, ok := utils.ParseQueryHeight(w, r, ctx)
+		if !ok {
+			return
+		}
+
+		res, height, err := ctx.QueryStoreWithHeight(bank.GetMetadataKey(denom), storeName)
+		if err != nil {
+			utils.WriteQueryError(w, "couldn't query denom metadata", err)
+			return
+		}
+		utils.WriteQueryHeight(w, height)
+
+		// the denom has no metadata
+		if len(res) == 0 {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		var metadata bank.Metadata
+		err = cdc.UnmarshalBinary(res, &metadata)
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte(fmt.Sprintf("couldn't decode denom metadata. Error: %s", err.Error())))
+			return
+		}
+
+		output, err := cdc.MarshalJSON(metadata)
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte(err.Error()))
+			return
+		}
+
+		w.Write(output)
+	}
+}
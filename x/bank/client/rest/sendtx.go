@@ -8,6 +8,7 @@ import (
 	"github.com/gorilla/mux"
 
 	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/utils"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/wire"
 	"github.com/cosmos/cosmos-sdk/x/bank"
@@ -15,7 +16,14 @@ import (
 )
 
 // RegisterRoutes - Central function to define routes that get registered by the main application
-func RegisterRoutes(ctx context.CoreContext, r *mux.Router, cdc *wire.Codec, kb keys.Keybase) {
+func RegisterRoutes(ctx context.CoreContext, r *mux.Router, cdc *wire.Codec, kb keys.Keybase, storeName string) {
+	RegisterTxRoutes(ctx, r, cdc, kb)
+	RegisterQueryRoutes(ctx, r, cdc, storeName)
+}
+
+// RegisterTxRoutes registers the REST routes signing bank txs with the keybase.
+// Without a keybase they only generate unsigned txs.
+func RegisterTxRoutes(ctx context.CoreContext, r *mux.Router, cdc *wire.Codec, kb keys.Keybase) {
 	r.HandleFunc("/accounts/{address}/send", SendRequestHandlerFn(cdc, kb, ctx)).Methods("POST")
 }
 
@@ -29,6 +37,8 @@ type sendBody struct {
 	AccountNumber    int64     `json:"account_number"`
 	Sequence         int64     `json:"sequence"`
 	Gas              int64     `json:"gas"`
+	// address of the sender, used instead of the key name with generate_only
+	From string `json:"from"`
 }
 
 var msgCdc = wire.NewCodec()
@@ -65,29 +75,46 @@ func SendRequestHandlerFn(cdc *wire.Codec, kb keys.Keybase, ctx context.CoreCont
 			return
 		}
 
-		info, err := kb.Get(m.LocalAccountName)
-		if err != nil {
-			w.WriteHeader(http.StatusUnauthorized)
-			w.Write([]byte(err.Error()))
+		generateOnly := utils.HasGenerateOnlyArg(r)
+		var from sdk.AccAddress
+		switch {
+		case generateOnly:
+			from, err = sdk.AccAddressFromBech32(m.From)
+			if err != nil {
+				w.WriteHeader(http.StatusBadRequest)
+				w.Write([]byte(err.Error()))
+				return
+			}
+		case kb == nil:
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(utils.ErrKeybaseDisabled.Error()))
 			return
+		default:
+			info, err := kb.Get(m.LocalAccountName)
+			if err != nil {
+				w.WriteHeader(http.StatusUnauthorized)
+				w.Write([]byte(err.Error()))
+				return
+			}
+			from = sdk.AccAddress(info.GetPubKey().Address())
 		}
 
 		// build message
-		msg := client.BuildMsg(sdk.AccAddress(info.GetPubKey().Address()), to, m.Amount)
-		if err != nil { // XXX rechecking same error ?
-			w.WriteHeader(http.StatusInternalServerError)
-			w.Write([]byte(err.Error()))
-			return
-		}
+		msg := client.BuildMsg(from, to, m.Amount)
 
 		// add gas to context
 		ctx = ctx.WithGas(m.Gas)
 		// add chain-id to context
 		ctx = ctx.WithChainID(m.ChainID)
 
-		// sign
 		ctx = ctx.WithAccountNumber(m.AccountNumber)
 		ctx = ctx.WithSequence(m.Sequence)
+		if generateOnly {
+			utils.WriteGenerateOnlyResponse(w, ctx, cdc, []sdk.Msg{msg})
+			return
+		}
+
+		// sign
 		txBytes, err := ctx.SignAndBuild(m.LocalAccountName, m.Password, []sdk.Msg{msg}, cdc)
 		if err != nil {
 			w.WriteHeader(http.StatusUnauthorized)
@@ -1,7 +1,9 @@
 package client
 
 import (
+	"github.com/cosmos/cosmos-sdk/client/context"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/wire"
 	bank "github.com/cosmos/cosmos-sdk/x/bank"
 )
 
@@ -12,3 +14,70 @@ func BuildMsg(from sdk.AccAddress, to sdk.AccAddress, coins sdk.Coins) sdk.Msg {
 	msg := bank.NewMsgSend([]bank.Input{input}, []bank.Output{output})
 	return msg
 }
+
+// parse the supply of a denom from the supply store, a missing value means the
+// denom has no supply
+func DecodeSupply(cdc *wire.Codec, denom string, res []byte) (sdk.Coin, error) {
+	coin := sdk.Coin{Denom: denom, Amount: sdk.ZeroInt()}
+	if len(res) == 0 {
+		return coin, nil
+	}
+	err := cdc.UnmarshalBinary(res, &coin.Amount)
+	return coin, err
+}
+
+// parse the total supply from the supply subspace of the supply store
+func DecodeTotalSupply(cdc *wire.Codec, kvs []sdk.KVPair) (sdk.Coins, error) {
+	supply := make(sdk.Coins, len(kvs))
+	for i, kv := range kvs {
+		denom := string(kv.Key[len(bank.SupplyKeyPrefix):])
+		coin, err := DecodeSupply(cdc, denom, kv.Value)
+		if err != nil {
+			return nil, err
+		}
+		supply[i] = coin
+	}
+	return supply, nil
+}
+
+// parse the denom metadata from the metadata subspace of the supply store
+func DecodeDenomMetadata(cdc *wire.Codec, kvs []sdk.KVPair) ([]bank.Metadata, error) {
+	metadata := make([]bank.Metadata, len(kvs))
+	for i, kv := range kvs {
+		err := cdc.UnmarshalBinary(kv.Value, &metadata[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return metadata, nil
+}
+
+// query the metadata of all the registered denoms from the supply store
+func QueryDenomMetadata(ctx context.CoreContext, cdc *wire.Codec, storeName string) ([]bank.Metadata, error) {
+	kvs, err := ctx.QuerySubspace(cdc, bank.MetadataKeyPrefix, storeName)
+	if err != nil {
+		return nil, err
+	}
+	return DecodeDenomMetadata(cdc, kvs)
+}
+
+// ParseCoins parses coins written in base units, eg. "1500000uatom", or in
+// display units with a decimal point, eg. "1.5atom". The denom metadata
+// registry in the supply store of the node is queried to convert display units
+// exactly to base units, and to reject integer amounts of display denoms.
+func ParseCoins(ctx context.CoreContext, cdc *wire.Codec, storeName string, coinsStr string) (sdk.Coins, error) {
+	metadata, err := QueryDenomMetadata(ctx, cdc, storeName)
+	if err != nil {
+		return nil, err
+	}
+	return bank.ParseDisplayCoins(metadata, coinsStr)
+}
+
+// ParseCoin parses a single coin like ParseCoins
+func ParseCoin(ctx context.CoreContext, cdc *wire.Codec, storeName string, coinStr string) (sdk.Coin, error) {
+	metadata, err := QueryDenomMetadata(ctx, cdc, storeName)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	return bank.ParseDisplayCoin(metadata, coinStr)
+}
@@ -11,49 +11,59 @@ const (
 
 	CodeInvalidInput  sdk.CodeType = 101
 	CodeInvalidOutput sdk.CodeType = 102
+
+	CodeUnauthorizedIssuer sdk.CodeType = 103
+	CodeInvalidMetadata    sdk.CodeType = 104
+	CodeSendDisabled       sdk.CodeType = 105
+	CodeBlockedAddr        sdk.CodeType = 106
+	CodeInvalidSupply      sdk.CodeType = 107
 )
 
-// NOTE: Don't stringer this, we'll put better messages in later.
-func codeToDefaultMsg(code sdk.CodeType) string {
-	switch code {
-	case CodeInvalidInput:
-		return "invalid input coins"
-	case CodeInvalidOutput:
-		return "invalid output coins"
-	default:
-		return sdk.CodeToDefaultMsg(code)
-	}
+func init() {
+	sdk.RegisterError(DefaultCodespace, CodeInvalidInput, "invalid input coins")
+	sdk.RegisterError(DefaultCodespace, CodeInvalidOutput, "invalid output coins")
+	sdk.RegisterError(DefaultCodespace, CodeUnauthorizedIssuer, "not the issuer of the denom")
+	sdk.RegisterError(DefaultCodespace, CodeInvalidMetadata, "invalid denom metadata")
+	sdk.RegisterError(DefaultCodespace, CodeSendDisabled, "transfers of the denom are disabled")
+	sdk.RegisterError(DefaultCodespace, CodeBlockedAddr, "recipient is not allowed to receive transfers")
+	sdk.RegisterError(DefaultCodespace, CodeInvalidSupply, "invalid supply")
 }
 
 //----------------------------------------
 // Error constructors
 
 func ErrInvalidInput(codespace sdk.CodespaceType, msg string) sdk.Error {
-	return newError(codespace, CodeInvalidInput, msg)
+	return sdk.NewError(codespace, CodeInvalidInput, msg)
 }
 
 func ErrNoInputs(codespace sdk.CodespaceType) sdk.Error {
-	return newError(codespace, CodeInvalidInput, "")
+	return sdk.NewError(codespace, CodeInvalidInput, "")
 }
 
 func ErrInvalidOutput(codespace sdk.CodespaceType, msg string) sdk.Error {
-	return newError(codespace, CodeInvalidOutput, msg)
+	return sdk.NewError(codespace, CodeInvalidOutput, msg)
 }
 
 func ErrNoOutputs(codespace sdk.CodespaceType) sdk.Error {
-	return newError(codespace, CodeInvalidOutput, "")
+	return sdk.NewError(codespace, CodeInvalidOutput, "")
 }
 
-//----------------------------------------
+func ErrUnauthorizedIssuer(codespace sdk.CodespaceType, msg string) sdk.Error {
+	return sdk.NewError(codespace, CodeUnauthorizedIssuer, msg)
+}
+
+func ErrInvalidMetadata(codespace sdk.CodespaceType, msg string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidMetadata, msg)
+}
+
+func ErrSendDisabled(codespace sdk.CodespaceType, msg string) sdk.Error {
+	return sdk.NewError(codespace, CodeSendDisabled, msg)
+}
 
-func msgOrDefaultMsg(msg string, code sdk.CodeType) string {
-	if msg != "" {
-		return msg
-	}
-	return codeToDefaultMsg(code)
+func ErrBlockedAddr(codespace sdk.CodespaceType, msg string) sdk.Error {
+	return sdk.NewError(codespace, CodeBlockedAddr, msg)
 }
 
-func newError(codespace sdk.CodespaceType, code sdk.CodeType, msg string) sdk.Error {
-	msg = msgOrDefaultMsg(msg, code)
-	return sdk.NewError(codespace, code, msg)
+func ErrInvalidSupply(codespace sdk.CodespaceType, msg string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidSupply, msg)
 }
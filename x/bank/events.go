@@ -0,0 +1,19 @@
+// nolint
+package bank
+
+// Events emitted by the bank module. Every change to an account balance made
+// through the bank emits a coin_spent or coin_received event, transfers and
+// issuances additionally emit a transfer or an issue event.
+const (
+	EventTypeCoinSpent    = "coin_spent"
+	EventTypeCoinReceived = "coin_received"
+	EventTypeTransfer     = "transfer"
+	EventTypeIssue        = "issue"
+
+	AttributeKeySpender   = "spender"
+	AttributeKeyReceiver  = "receiver"
+	AttributeKeySender    = "sender"
+	AttributeKeyRecipient = "recipient"
+	AttributeKeyIssuer    = "issuer"
+	AttributeKeyAmount    = "amount"
+)
@@ -0,0 +1,76 @@
+package bank
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GenesisState - all bank state that must be provided at genesis
+type GenesisState struct {
+	Supply        sdk.Coins     `json:"supply"`         // total supply of every denom, including the coins held outside of accounts
+	Issuers       []Issuer      `json:"issuers"`        // accounts allowed to issue new coins
+	DenomMetadata []Metadata    `json:"denom_metadata"` // display units of the base denoms
+	SendEnabled   []SendEnabled `json:"send_enabled"`   // whether denoms are transferable, denoms without a flag are
+}
+
+// NewGenesisState creates a new genesis state
+func NewGenesisState(supply sdk.Coins, issuers []Issuer, metadata []Metadata, sendEnabled []SendEnabled) GenesisState {
+	return GenesisState{
+		Supply:        supply,
+		Issuers:       issuers,
+		DenomMetadata: metadata,
+		SendEnabled:   sendEnabled,
+	}
+}
+
+// DefaultGenesisState returns a default genesis state
+func DefaultGenesisState() GenesisState {
+	return NewGenesisState(sdk.Coins{}, nil, nil, nil)
+}
+
+// ValidateGenesis checks the send-enabled flags of the genesis state, every
+// flag must have a valid denom which has no other flag
+func ValidateGenesis(data GenesisState) sdk.Error {
+	seen := make(map[string]bool, len(data.SendEnabled))
+	for _, se := range data.SendEnabled {
+		if err := se.ValidateBasic(); err != nil {
+			return err
+		}
+		if seen[se.Denom] {
+			return ErrInvalidInput(DefaultCodespace, fmt.Sprintf("duplicate send-enabled flag for %s", se.Denom))
+		}
+		seen[se.Denom] = true
+	}
+	return nil
+}
+
+// InitGenesis sets the supply, the issuers, the denom metadata and the
+// send-enabled flags from the genesis state
+func InitGenesis(ctx sdk.Context, keeper Keeper, data GenesisState) {
+	if err := ValidateGenesis(data); err != nil {
+		panic(err)
+	}
+	keeper.sk.SetTotalSupply(ctx, data.Supply)
+	for _, issuer := range data.Issuers {
+		keeper.sk.SetIssuer(ctx, issuer)
+	}
+	for _, metadata := range data.DenomMetadata {
+		if err := keeper.sk.SetDenomMetadata(ctx, metadata); err != nil {
+			panic(err)
+		}
+	}
+	for _, se := range data.SendEnabled {
+		keeper.sk.SetSendEnabled(ctx, se)
+	}
+}
+
+// WriteGenesis returns a GenesisState for a given context and keeper
+func WriteGenesis(ctx sdk.Context, keeper Keeper) GenesisState {
+	return NewGenesisState(
+		keeper.sk.GetTotalSupply(ctx),
+		keeper.sk.GetIssuers(ctx),
+		keeper.sk.GetAllDenomMetadata(ctx),
+		keeper.sk.GetAllSendEnabled(ctx),
+	)
+}
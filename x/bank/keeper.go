@@ -1,6 +1,7 @@
 package bank
 
 import (
+	"bytes"
 	"fmt"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -15,14 +16,19 @@ const (
 	costAddCoins      sdk.Gas = 10
 )
 
-// Keeper manages transfers between accounts
+// Keeper manages transfers between accounts and the creation and destruction
+// of coins
 type Keeper struct {
-	am auth.AccountMapper
+	am      auth.AccountMapper
+	sk      SupplyKeeper
+	blocked blockedAddrs
 }
 
-// NewKeeper returns a new Keeper
-func NewKeeper(am auth.AccountMapper) Keeper {
-	return Keeper{am: am}
+// NewKeeper returns a new Keeper. The blockedAddrs can't receive transfers,
+// they are meant for accounts the app itself controls, whose coins must only
+// move through its own logic.
+func NewKeeper(am auth.AccountMapper, sk SupplyKeeper, blockedAddrs ...sdk.AccAddress) Keeper {
+	return Keeper{am: am, sk: sk, blocked: newBlockedAddrs(blockedAddrs)}
 }
 
 // GetCoins returns the coins at the addr.
@@ -41,35 +47,133 @@ func (keeper Keeper) HasCoins(ctx sdk.Context, addr sdk.AccAddress, amt sdk.Coin
 }
 
 // SubtractCoins subtracts amt from the coins at the addr.
-func (keeper Keeper) SubtractCoins(ctx sdk.Context, addr sdk.AccAddress, amt sdk.Coins) (sdk.Coins, sdk.Tags, sdk.Error) {
+func (keeper Keeper) SubtractCoins(ctx sdk.Context, addr sdk.AccAddress, amt sdk.Coins) (sdk.Coins, sdk.Error) {
 	return subtractCoins(ctx, keeper.am, addr, amt)
 }
 
 // AddCoins adds amt to the coins at the addr.
-func (keeper Keeper) AddCoins(ctx sdk.Context, addr sdk.AccAddress, amt sdk.Coins) (sdk.Coins, sdk.Tags, sdk.Error) {
+func (keeper Keeper) AddCoins(ctx sdk.Context, addr sdk.AccAddress, amt sdk.Coins) (sdk.Coins, sdk.Error) {
 	return addCoins(ctx, keeper.am, addr, amt)
 }
 
-// SendCoins moves coins from one account to another
-func (keeper Keeper) SendCoins(ctx sdk.Context, fromAddr sdk.AccAddress, toAddr sdk.AccAddress, amt sdk.Coins) (sdk.Tags, sdk.Error) {
+// MintCoins creates amt at the addr and adds it to the supply.
+func (keeper Keeper) MintCoins(ctx sdk.Context, addr sdk.AccAddress, amt sdk.Coins) (sdk.Coins, sdk.Error) {
+	newCoins, err := addCoins(ctx, keeper.am, addr, amt)
+	if err != nil {
+		return amt, err
+	}
+	keeper.sk.InflateSupply(ctx, amt)
+	return newCoins, nil
+}
+
+// BurnCoins destroys amt at the addr and removes it from the supply.
+func (keeper Keeper) BurnCoins(ctx sdk.Context, addr sdk.AccAddress, amt sdk.Coins) (sdk.Coins, sdk.Error) {
+	newCoins, err := subtractCoins(ctx, keeper.am, addr, amt)
+	if err != nil {
+		return amt, err
+	}
+	err = keeper.sk.DeflateSupply(ctx, amt)
+	if err != nil {
+		return amt, err
+	}
+	return newCoins, nil
+}
+
+// SendCoins moves coins from one account to another, the coins must be
+// transferable and the recipient must not be blocked
+func (keeper Keeper) SendCoins(ctx sdk.Context, fromAddr sdk.AccAddress, toAddr sdk.AccAddress, amt sdk.Coins) sdk.Error {
+	if err := checkSend(ctx, keeper.sk, keeper.blocked, toAddr, amt); err != nil {
+		return err
+	}
 	return sendCoins(ctx, keeper.am, fromAddr, toAddr, amt)
 }
 
-// InputOutputCoins handles a list of inputs and outputs
-func (keeper Keeper) InputOutputCoins(ctx sdk.Context, inputs []Input, outputs []Output) (sdk.Tags, sdk.Error) {
+// InputOutputCoins handles a list of inputs and outputs, the coins must be
+// transferable and the recipients must not be blocked
+func (keeper Keeper) InputOutputCoins(ctx sdk.Context, inputs []Input, outputs []Output) sdk.Error {
+	for _, out := range outputs {
+		if err := checkSend(ctx, keeper.sk, keeper.blocked, out.Address, out.Coins); err != nil {
+			return err
+		}
+	}
 	return inputOutputCoins(ctx, keeper.am, inputs, outputs)
 }
 
+// BlockedAddr returns whether an address is not allowed to receive transfers
+func (keeper Keeper) BlockedAddr(addr sdk.AccAddress) bool {
+	return keeper.blocked[addr.String()]
+}
+
+// IssueCoins creates new coins in the outputs on behalf of the issuer, the
+// issuer must be registered for every issued denom
+func (keeper Keeper) IssueCoins(ctx sdk.Context, issuer sdk.AccAddress, outputs []Output) sdk.Error {
+	for _, out := range outputs {
+		if keeper.blocked[out.Address.String()] {
+			return ErrBlockedAddr(DefaultCodespace, fmt.Sprintf("%s is not allowed to receive issued coins", out.Address))
+		}
+	}
+	return issueCoins(ctx, keeper.am, keeper.sk, issuer, outputs)
+}
+
+// GetSupply returns the total supply of a denom
+func (keeper Keeper) GetSupply(ctx sdk.Context, denom string) sdk.Int {
+	return keeper.sk.GetSupply(ctx, denom)
+}
+
+// GetTotalSupply returns the total supply of all the denoms
+func (keeper Keeper) GetTotalSupply(ctx sdk.Context) sdk.Coins {
+	return keeper.sk.GetTotalSupply(ctx)
+}
+
+// InflateSupply records coins minted outside of the bank, e.g. provisions
+func (keeper Keeper) InflateSupply(ctx sdk.Context, amt sdk.Coins) {
+	keeper.sk.InflateSupply(ctx, amt)
+}
+
+// DeflateSupply records coins burned outside of the bank, e.g. by slashing.
+// It fails if the supply of a denom would become negative.
+func (keeper Keeper) DeflateSupply(ctx sdk.Context, amt sdk.Coins) sdk.Error {
+	return keeper.sk.DeflateSupply(ctx, amt)
+}
+
+// GetDenomMetadata returns the metadata of a base denom
+func (keeper Keeper) GetDenomMetadata(ctx sdk.Context, denom string) (Metadata, bool) {
+	return keeper.sk.GetDenomMetadata(ctx, denom)
+}
+
+// SetDenomMetadata registers or replaces the metadata of a base denom
+func (keeper Keeper) SetDenomMetadata(ctx sdk.Context, metadata Metadata) sdk.Error {
+	return keeper.sk.SetDenomMetadata(ctx, metadata)
+}
+
+// GetAllDenomMetadata returns the metadata of all the registered denoms
+func (keeper Keeper) GetAllDenomMetadata(ctx sdk.Context) []Metadata {
+	return keeper.sk.GetAllDenomMetadata(ctx)
+}
+
+// GetSendEnabled returns whether the coins of a denom can be transferred
+func (keeper Keeper) GetSendEnabled(ctx sdk.Context, denom string) bool {
+	return keeper.sk.GetSendEnabled(ctx, denom)
+}
+
+// SetSendEnabled sets whether the coins of a denom can be transferred
+func (keeper Keeper) SetSendEnabled(ctx sdk.Context, se SendEnabled) {
+	keeper.sk.SetSendEnabled(ctx, se)
+}
+
 //______________________________________________________________________________________________
 
 // SendKeeper only allows transfers between accounts, without the possibility of creating coins
 type SendKeeper struct {
-	am auth.AccountMapper
+	am      auth.AccountMapper
+	sk      SupplyKeeper
+	blocked blockedAddrs
 }
 
-// NewSendKeeper returns a new Keeper
-func NewSendKeeper(am auth.AccountMapper) SendKeeper {
-	return SendKeeper{am: am}
+// NewSendKeeper returns a new Keeper, the blockedAddrs can't receive transfers
+// as with NewKeeper
+func NewSendKeeper(am auth.AccountMapper, sk SupplyKeeper, blockedAddrs ...sdk.AccAddress) SendKeeper {
+	return SendKeeper{am: am, sk: sk, blocked: newBlockedAddrs(blockedAddrs)}
 }
 
 // GetCoins returns the coins at the addr.
@@ -82,13 +186,23 @@ func (keeper SendKeeper) HasCoins(ctx sdk.Context, addr sdk.AccAddress, amt sdk.
 	return hasCoins(ctx, keeper.am, addr, amt)
 }
 
-// SendCoins moves coins from one account to another
-func (keeper SendKeeper) SendCoins(ctx sdk.Context, fromAddr sdk.AccAddress, toAddr sdk.AccAddress, amt sdk.Coins) (sdk.Tags, sdk.Error) {
+// SendCoins moves coins from one account to another, the coins must be
+// transferable and the recipient must not be blocked
+func (keeper SendKeeper) SendCoins(ctx sdk.Context, fromAddr sdk.AccAddress, toAddr sdk.AccAddress, amt sdk.Coins) sdk.Error {
+	if err := checkSend(ctx, keeper.sk, keeper.blocked, toAddr, amt); err != nil {
+		return err
+	}
 	return sendCoins(ctx, keeper.am, fromAddr, toAddr, amt)
 }
 
-// InputOutputCoins handles a list of inputs and outputs
-func (keeper SendKeeper) InputOutputCoins(ctx sdk.Context, inputs []Input, outputs []Output) (sdk.Tags, sdk.Error) {
+// InputOutputCoins handles a list of inputs and outputs, the coins must be
+// transferable and the recipients must not be blocked
+func (keeper SendKeeper) InputOutputCoins(ctx sdk.Context, inputs []Input, outputs []Output) sdk.Error {
+	for _, out := range outputs {
+		if err := checkSend(ctx, keeper.sk, keeper.blocked, out.Address, out.Coins); err != nil {
+			return err
+		}
+	}
 	return inputOutputCoins(ctx, keeper.am, inputs, outputs)
 }
 
@@ -147,67 +261,109 @@ func hasCoins(ctx sdk.Context, am auth.AccountMapper, addr sdk.AccAddress, amt s
 }
 
 // SubtractCoins subtracts amt from the coins at the addr.
-func subtractCoins(ctx sdk.Context, am auth.AccountMapper, addr sdk.AccAddress, amt sdk.Coins) (sdk.Coins, sdk.Tags, sdk.Error) {
+func subtractCoins(ctx sdk.Context, am auth.AccountMapper, addr sdk.AccAddress, amt sdk.Coins) (sdk.Coins, sdk.Error) {
 	ctx.GasMeter().ConsumeGas(costSubtractCoins, "subtractCoins")
 	oldCoins := getCoins(ctx, am, addr)
 	newCoins := oldCoins.Minus(amt)
 	if !newCoins.IsNotNegative() {
-		return amt, nil, sdk.ErrInsufficientCoins(fmt.Sprintf("%s < %s", oldCoins, amt))
+		return amt, sdk.ErrInsufficientCoins(fmt.Sprintf("%s < %s", oldCoins, amt))
 	}
 	err := setCoins(ctx, am, addr, newCoins)
-	tags := sdk.NewTags("sender", []byte(addr.String()))
-	return newCoins, tags, err
+	ctx.EventManager().EmitEvent(sdk.NewEvent(EventTypeCoinSpent,
+		sdk.NewAttribute(AttributeKeySpender, addr.String()),
+		sdk.NewAttribute(AttributeKeyAmount, amt.String()),
+	))
+	return newCoins, err
 }
 
 // AddCoins adds amt to the coins at the addr.
-func addCoins(ctx sdk.Context, am auth.AccountMapper, addr sdk.AccAddress, amt sdk.Coins) (sdk.Coins, sdk.Tags, sdk.Error) {
+func addCoins(ctx sdk.Context, am auth.AccountMapper, addr sdk.AccAddress, amt sdk.Coins) (sdk.Coins, sdk.Error) {
 	ctx.GasMeter().ConsumeGas(costAddCoins, "addCoins")
 	oldCoins := getCoins(ctx, am, addr)
 	newCoins := oldCoins.Plus(amt)
 	if !newCoins.IsNotNegative() {
-		return amt, nil, sdk.ErrInsufficientCoins(fmt.Sprintf("%s < %s", oldCoins, amt))
+		return amt, sdk.ErrInsufficientCoins(fmt.Sprintf("%s < %s", oldCoins, amt))
 	}
 	err := setCoins(ctx, am, addr, newCoins)
-	tags := sdk.NewTags("recipient", []byte(addr.String()))
-	return newCoins, tags, err
+	ctx.EventManager().EmitEvent(sdk.NewEvent(EventTypeCoinReceived,
+		sdk.NewAttribute(AttributeKeyReceiver, addr.String()),
+		sdk.NewAttribute(AttributeKeyAmount, amt.String()),
+	))
+	return newCoins, err
 }
 
 // SendCoins moves coins from one account to another
 // NOTE: Make sure to revert state changes from tx on error
-func sendCoins(ctx sdk.Context, am auth.AccountMapper, fromAddr sdk.AccAddress, toAddr sdk.AccAddress, amt sdk.Coins) (sdk.Tags, sdk.Error) {
-	_, subTags, err := subtractCoins(ctx, am, fromAddr, amt)
+func sendCoins(ctx sdk.Context, am auth.AccountMapper, fromAddr sdk.AccAddress, toAddr sdk.AccAddress, amt sdk.Coins) sdk.Error {
+	_, err := subtractCoins(ctx, am, fromAddr, amt)
 	if err != nil {
-		return nil, err
+		return err
 	}
 
-	_, addTags, err := addCoins(ctx, am, toAddr, amt)
+	_, err = addCoins(ctx, am, toAddr, amt)
 	if err != nil {
-		return nil, err
+		return err
 	}
 
-	return subTags.AppendTags(addTags), nil
+	ctx.EventManager().EmitEvent(sdk.NewEvent(EventTypeTransfer,
+		sdk.NewAttribute(AttributeKeySender, fromAddr.String()),
+		sdk.NewAttribute(AttributeKeyRecipient, toAddr.String()),
+		sdk.NewAttribute(AttributeKeyAmount, amt.String()),
+	))
+	return nil
 }
 
 // InputOutputCoins handles a list of inputs and outputs
 // NOTE: Make sure to revert state changes from tx on error
-func inputOutputCoins(ctx sdk.Context, am auth.AccountMapper, inputs []Input, outputs []Output) (sdk.Tags, sdk.Error) {
-	allTags := sdk.EmptyTags()
-
+func inputOutputCoins(ctx sdk.Context, am auth.AccountMapper, inputs []Input, outputs []Output) sdk.Error {
 	for _, in := range inputs {
-		_, tags, err := subtractCoins(ctx, am, in.Address, in.Coins)
+		_, err := subtractCoins(ctx, am, in.Address, in.Coins)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, out := range outputs {
+		_, err := addCoins(ctx, am, out.Address, out.Coins)
 		if err != nil {
-			return nil, err
+			return err
 		}
-		allTags = allTags.AppendTags(tags)
 	}
 
+	// a single input and output is a plain transfer between two accounts
+	if len(inputs) == 1 && len(outputs) == 1 {
+		ctx.EventManager().EmitEvent(sdk.NewEvent(EventTypeTransfer,
+			sdk.NewAttribute(AttributeKeySender, inputs[0].Address.String()),
+			sdk.NewAttribute(AttributeKeyRecipient, outputs[0].Address.String()),
+			sdk.NewAttribute(AttributeKeyAmount, outputs[0].Coins.String()),
+		))
+	}
+
+	return nil
+}
+
+// IssueCoins creates new coins in the outputs and adds them to the supply
+// NOTE: Make sure to revert state changes from tx on error
+func issueCoins(ctx sdk.Context, am auth.AccountMapper, sk SupplyKeeper, issuer sdk.AccAddress, outputs []Output) sdk.Error {
 	for _, out := range outputs {
-		_, tags, err := addCoins(ctx, am, out.Address, out.Coins)
+		for _, coin := range out.Coins {
+			registered := sk.GetIssuer(ctx, coin.Denom)
+			if registered == nil || !bytes.Equal(registered, issuer) {
+				return ErrUnauthorizedIssuer(DefaultCodespace, fmt.Sprintf("%s is not the issuer of %s", issuer, coin.Denom))
+			}
+		}
+
+		_, err := addCoins(ctx, am, out.Address, out.Coins)
 		if err != nil {
-			return nil, err
+			return err
 		}
-		allTags = allTags.AppendTags(tags)
+		sk.InflateSupply(ctx, out.Coins)
+		ctx.EventManager().EmitEvent(sdk.NewEvent(EventTypeIssue,
+			sdk.NewAttribute(AttributeKeyIssuer, issuer.String()),
+			sdk.NewAttribute(AttributeKeyRecipient, out.Address.String()),
+			sdk.NewAttribute(AttributeKeyAmount, out.Coins.String()),
+		))
 	}
 
-	return allTags, nil
+	return nil
 }
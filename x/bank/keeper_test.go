@@ -17,24 +17,26 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/auth"
 )
 
-func setupMultiStore() (sdk.MultiStore, *sdk.KVStoreKey) {
+func setupMultiStore() (sdk.MultiStore, *sdk.KVStoreKey, *sdk.KVStoreKey) {
 	db := dbm.NewMemDB()
 	authKey := sdk.NewKVStoreKey("authkey")
+	supplyKey := sdk.NewKVStoreKey("supplykey")
 	ms := store.NewCommitMultiStore(db)
 	ms.MountStoreWithDB(authKey, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(supplyKey, sdk.StoreTypeIAVL, db)
 	ms.LoadLatestVersion()
-	return ms, authKey
+	return ms, authKey, supplyKey
 }
 
 func TestKeeper(t *testing.T) {
-	ms, authKey := setupMultiStore()
+	ms, authKey, supplyKey := setupMultiStore()
 
 	cdc := wire.NewCodec()
 	auth.RegisterBaseAccount(cdc)
 
 	ctx := sdk.NewContext(ms, abci.Header{}, false, log.NewNopLogger())
 	accountMapper := auth.NewAccountMapper(cdc, authKey, auth.ProtoBaseAccount)
-	coinKeeper := NewKeeper(accountMapper)
+	coinKeeper := NewKeeper(accountMapper, NewSupplyKeeper(cdc, supplyKey))
 
 	addr := sdk.AccAddress([]byte("addr1"))
 	addr2 := sdk.AccAddress([]byte("addr2"))
@@ -78,7 +80,7 @@ func TestKeeper(t *testing.T) {
 	require.True(t, coinKeeper.GetCoins(ctx, addr).IsEqual(sdk.Coins{sdk.NewCoin("foocoin", 10)}))
 	require.True(t, coinKeeper.GetCoins(ctx, addr2).IsEqual(sdk.Coins{sdk.NewCoin("foocoin", 5)}))
 
-	_, err2 := coinKeeper.SendCoins(ctx, addr, addr2, sdk.Coins{sdk.NewCoin("foocoin", 50)})
+	err2 := coinKeeper.SendCoins(ctx, addr, addr2, sdk.Coins{sdk.NewCoin("foocoin", 50)})
 	assert.Implements(t, (*sdk.Error)(nil), err2)
 	require.True(t, coinKeeper.GetCoins(ctx, addr).IsEqual(sdk.Coins{sdk.NewCoin("foocoin", 10)}))
 	require.True(t, coinKeeper.GetCoins(ctx, addr2).IsEqual(sdk.Coins{sdk.NewCoin("foocoin", 5)}))
@@ -112,15 +114,16 @@ func TestKeeper(t *testing.T) {
 }
 
 func TestSendKeeper(t *testing.T) {
-	ms, authKey := setupMultiStore()
+	ms, authKey, supplyKey := setupMultiStore()
 
 	cdc := wire.NewCodec()
 	auth.RegisterBaseAccount(cdc)
 
 	ctx := sdk.NewContext(ms, abci.Header{}, false, log.NewNopLogger())
 	accountMapper := auth.NewAccountMapper(cdc, authKey, auth.ProtoBaseAccount)
-	coinKeeper := NewKeeper(accountMapper)
-	sendKeeper := NewSendKeeper(accountMapper)
+	supplyKeeper := NewSupplyKeeper(cdc, supplyKey)
+	coinKeeper := NewKeeper(accountMapper, supplyKeeper)
+	sendKeeper := NewSendKeeper(accountMapper, supplyKeeper)
 
 	addr := sdk.AccAddress([]byte("addr1"))
 	addr2 := sdk.AccAddress([]byte("addr2"))
@@ -147,7 +150,7 @@ func TestSendKeeper(t *testing.T) {
 	require.True(t, sendKeeper.GetCoins(ctx, addr).IsEqual(sdk.Coins{sdk.NewCoin("foocoin", 10)}))
 	require.True(t, sendKeeper.GetCoins(ctx, addr2).IsEqual(sdk.Coins{sdk.NewCoin("foocoin", 5)}))
 
-	_, err2 := sendKeeper.SendCoins(ctx, addr, addr2, sdk.Coins{sdk.NewCoin("foocoin", 50)})
+	err2 := sendKeeper.SendCoins(ctx, addr, addr2, sdk.Coins{sdk.NewCoin("foocoin", 50)})
 	assert.Implements(t, (*sdk.Error)(nil), err2)
 	require.True(t, sendKeeper.GetCoins(ctx, addr).IsEqual(sdk.Coins{sdk.NewCoin("foocoin", 10)}))
 	require.True(t, sendKeeper.GetCoins(ctx, addr2).IsEqual(sdk.Coins{sdk.NewCoin("foocoin", 5)}))
@@ -181,14 +184,14 @@ func TestSendKeeper(t *testing.T) {
 }
 
 func TestViewKeeper(t *testing.T) {
-	ms, authKey := setupMultiStore()
+	ms, authKey, supplyKey := setupMultiStore()
 
 	cdc := wire.NewCodec()
 	auth.RegisterBaseAccount(cdc)
 
 	ctx := sdk.NewContext(ms, abci.Header{}, false, log.NewNopLogger())
 	accountMapper := auth.NewAccountMapper(cdc, authKey, auth.ProtoBaseAccount)
-	coinKeeper := NewKeeper(accountMapper)
+	coinKeeper := NewKeeper(accountMapper, NewSupplyKeeper(cdc, supplyKey))
 	viewKeeper := NewViewKeeper(accountMapper)
 
 	addr := sdk.AccAddress([]byte("addr1"))
@@ -207,3 +210,230 @@ func TestViewKeeper(t *testing.T) {
 	require.False(t, viewKeeper.HasCoins(ctx, addr, sdk.Coins{sdk.NewCoin("foocoin", 15)}))
 	require.False(t, viewKeeper.HasCoins(ctx, addr, sdk.Coins{sdk.NewCoin("barcoin", 5)}))
 }
+
+func TestSupplyKeeper(t *testing.T) {
+	ms, authKey, supplyKey := setupMultiStore()
+
+	cdc := wire.NewCodec()
+	auth.RegisterBaseAccount(cdc)
+
+	ctx := sdk.NewContext(ms, abci.Header{}, false, log.NewNopLogger())
+	accountMapper := auth.NewAccountMapper(cdc, authKey, auth.ProtoBaseAccount)
+	supplyKeeper := NewSupplyKeeper(cdc, supplyKey)
+	coinKeeper := NewKeeper(accountMapper, supplyKeeper)
+
+	issuer := sdk.AccAddress([]byte("issuer"))
+	addr := sdk.AccAddress([]byte("addr1"))
+	addr2 := sdk.AccAddress([]byte("addr2"))
+
+	// Test genesis
+	InitGenesis(ctx, coinKeeper, NewGenesisState(
+		sdk.Coins{sdk.NewCoin("barcoin", 5), sdk.NewCoin("foocoin", 10)},
+		[]Issuer{NewIssuer("foocoin", issuer)},
+		nil,
+		nil,
+	))
+	require.True(t, coinKeeper.GetSupply(ctx, "foocoin").Equal(sdk.NewInt(10)))
+	require.True(t, coinKeeper.GetSupply(ctx, "bazcoin").IsZero())
+	require.Equal(t, issuer, supplyKeeper.GetIssuer(ctx, "foocoin"))
+	require.Nil(t, supplyKeeper.GetIssuer(ctx, "barcoin"))
+
+	// Test InflateSupply/DeflateSupply
+	coinKeeper.InflateSupply(ctx, sdk.Coins{sdk.NewCoin("bazcoin", 3), sdk.NewCoin("foocoin", 5)})
+	require.True(t, coinKeeper.GetTotalSupply(ctx).IsEqual(
+		sdk.Coins{sdk.NewCoin("barcoin", 5), sdk.NewCoin("bazcoin", 3), sdk.NewCoin("foocoin", 15)}))
+	err := coinKeeper.DeflateSupply(ctx, sdk.Coins{sdk.NewCoin("barcoin", 5)})
+	require.Nil(t, err)
+	require.True(t, coinKeeper.GetTotalSupply(ctx).IsEqual(
+		sdk.Coins{sdk.NewCoin("bazcoin", 3), sdk.NewCoin("foocoin", 15)}))
+	// a supply can't become negative, the other denoms aren't deflated either
+	err = coinKeeper.DeflateSupply(ctx, sdk.Coins{sdk.NewCoin("bazcoin", 4), sdk.NewCoin("foocoin", 1)})
+	require.NotNil(t, err)
+	require.Equal(t, CodeInvalidSupply, err.Code())
+	require.True(t, coinKeeper.GetTotalSupply(ctx).IsEqual(
+		sdk.Coins{sdk.NewCoin("bazcoin", 3), sdk.NewCoin("foocoin", 15)}))
+
+	// Test IssueCoins
+	outputs := []Output{NewOutput(addr, sdk.Coins{sdk.NewCoin("foocoin", 7)}), NewOutput(addr2, sdk.Coins{sdk.NewCoin("foocoin", 3)})}
+	err = coinKeeper.IssueCoins(ctx, addr, outputs)
+	require.NotNil(t, err)
+	require.Equal(t, CodeUnauthorizedIssuer, err.Code())
+	err = coinKeeper.IssueCoins(ctx, issuer, []Output{NewOutput(addr, sdk.Coins{sdk.NewCoin("barcoin", 1)})})
+	require.NotNil(t, err)
+	require.True(t, coinKeeper.GetCoins(ctx, addr).IsEqual(sdk.Coins{}))
+
+	err = coinKeeper.IssueCoins(ctx, issuer, outputs)
+	require.Nil(t, err)
+	require.True(t, coinKeeper.GetCoins(ctx, addr).IsEqual(sdk.Coins{sdk.NewCoin("foocoin", 7)}))
+	require.True(t, coinKeeper.GetCoins(ctx, addr2).IsEqual(sdk.Coins{sdk.NewCoin("foocoin", 3)}))
+	require.True(t, coinKeeper.GetSupply(ctx, "foocoin").Equal(sdk.NewInt(25)))
+
+	// Test WriteGenesis
+	genesis := WriteGenesis(ctx, coinKeeper)
+	require.True(t, genesis.Supply.IsEqual(sdk.Coins{sdk.NewCoin("bazcoin", 3), sdk.NewCoin("foocoin", 25)}))
+	require.Equal(t, []Issuer{NewIssuer("foocoin", issuer)}, genesis.Issuers)
+}
+
+func TestDenomMetadata(t *testing.T) {
+	ms, authKey, supplyKey := setupMultiStore()
+
+	cdc := wire.NewCodec()
+	auth.RegisterBaseAccount(cdc)
+
+	ctx := sdk.NewContext(ms, abci.Header{}, false, log.NewNopLogger())
+	accountMapper := auth.NewAccountMapper(cdc, authKey, auth.ProtoBaseAccount)
+	coinKeeper := NewKeeper(accountMapper, NewSupplyKeeper(cdc, supplyKey))
+
+	atom := NewMetadata("uatom", "atom", 6, "the staking token")
+	InitGenesis(ctx, coinKeeper, NewGenesisState(sdk.Coins{}, nil, []Metadata{atom}, nil))
+
+	metadata, found := coinKeeper.GetDenomMetadata(ctx, "uatom")
+	require.True(t, found)
+	require.Equal(t, atom, metadata)
+	_, found = coinKeeper.GetDenomMetadata(ctx, "atom")
+	require.False(t, found)
+
+	// invalid metadata and display denoms conflicting with another denom are rejected
+	err := coinKeeper.SetDenomMetadata(ctx, NewMetadata("uphoton", "photon", 19, ""))
+	require.NotNil(t, err)
+	require.Equal(t, CodeInvalidMetadata, err.Code())
+	err = coinKeeper.SetDenomMetadata(ctx, NewMetadata("natom", "atom", 9, ""))
+	require.NotNil(t, err)
+	err = coinKeeper.SetDenomMetadata(ctx, NewMetadata("uphoton", "uatom", 6, ""))
+	require.NotNil(t, err)
+
+	// the metadata of a registered denom can be replaced
+	atom.Exponent = 3
+	require.Nil(t, coinKeeper.SetDenomMetadata(ctx, atom))
+	photon := NewMetadata("uphoton", "photon", 6, "")
+	require.Nil(t, coinKeeper.SetDenomMetadata(ctx, photon))
+	require.Equal(t, []Metadata{atom, photon}, coinKeeper.GetAllDenomMetadata(ctx))
+	require.Equal(t, []Metadata{atom, photon}, WriteGenesis(ctx, coinKeeper).DenomMetadata)
+}
+
+func TestSendEnabledAndBlockedAddrs(t *testing.T) {
+	ms, authKey, supplyKey := setupMultiStore()
+
+	cdc := wire.NewCodec()
+	auth.RegisterBaseAccount(cdc)
+
+	ctx := sdk.NewContext(ms, abci.Header{}, false, log.NewNopLogger())
+	accountMapper := auth.NewAccountMapper(cdc, authKey, auth.ProtoBaseAccount)
+	supplyKeeper := NewSupplyKeeper(cdc, supplyKey)
+
+	addr := sdk.AccAddress([]byte("addr1"))
+	addr2 := sdk.AccAddress([]byte("addr2"))
+	blocked := sdk.AccAddress([]byte("feecollector"))
+	coinKeeper := NewKeeper(accountMapper, supplyKeeper, blocked)
+	sendKeeper := NewSendKeeper(accountMapper, supplyKeeper, blocked)
+
+	InitGenesis(ctx, coinKeeper, NewGenesisState(sdk.Coins{}, []Issuer{NewIssuer("barcoin", addr)}, nil,
+		[]SendEnabled{NewSendEnabled("barcoin", false), NewSendEnabled("foocoin", true)}))
+	require.False(t, coinKeeper.GetSendEnabled(ctx, "barcoin"))
+	require.True(t, coinKeeper.GetSendEnabled(ctx, "foocoin"))
+	require.True(t, coinKeeper.GetSendEnabled(ctx, "bazcoin"))
+	require.True(t, coinKeeper.BlockedAddr(blocked))
+	require.False(t, coinKeeper.BlockedAddr(addr))
+
+	coinKeeper.SetCoins(ctx, addr, sdk.Coins{sdk.NewCoin("barcoin", 10), sdk.NewCoin("foocoin", 10)})
+
+	// disabled denoms can't be sent
+	err := coinKeeper.SendCoins(ctx, addr, addr2, sdk.Coins{sdk.NewCoin("barcoin", 5)})
+	require.NotNil(t, err)
+	require.Equal(t, CodeSendDisabled, err.Code())
+	err = sendKeeper.SendCoins(ctx, addr, addr2, sdk.Coins{sdk.NewCoin("barcoin", 5), sdk.NewCoin("foocoin", 5)})
+	require.NotNil(t, err)
+	require.Equal(t, CodeSendDisabled, err.Code())
+	err = coinKeeper.InputOutputCoins(ctx,
+		[]Input{NewInput(addr, sdk.Coins{sdk.NewCoin("barcoin", 5)})},
+		[]Output{NewOutput(addr2, sdk.Coins{sdk.NewCoin("barcoin", 5)})})
+	require.NotNil(t, err)
+	require.Equal(t, CodeSendDisabled, err.Code())
+	require.True(t, coinKeeper.GetCoins(ctx, addr2).IsEqual(sdk.Coins{}))
+
+	// blocked addresses can't receive any transfer
+	err = coinKeeper.SendCoins(ctx, addr, blocked, sdk.Coins{sdk.NewCoin("foocoin", 5)})
+	require.NotNil(t, err)
+	require.Equal(t, CodeBlockedAddr, err.Code())
+	err = sendKeeper.InputOutputCoins(ctx,
+		[]Input{NewInput(addr, sdk.Coins{sdk.NewCoin("foocoin", 5)})},
+		[]Output{NewOutput(blocked, sdk.Coins{sdk.NewCoin("foocoin", 5)})})
+	require.NotNil(t, err)
+	require.Equal(t, CodeBlockedAddr, err.Code())
+	err = coinKeeper.IssueCoins(ctx, addr, []Output{NewOutput(blocked, sdk.Coins{sdk.NewCoin("barcoin", 5)})})
+	require.NotNil(t, err)
+	require.Equal(t, CodeBlockedAddr, err.Code())
+	require.True(t, coinKeeper.GetCoins(ctx, blocked).IsEqual(sdk.Coins{}))
+
+	// modules can still move disabled denoms, eg. to bond them
+	_, err = coinKeeper.SubtractCoins(ctx, addr, sdk.Coins{sdk.NewCoin("barcoin", 5)})
+	require.Nil(t, err)
+
+	// enabling the denom allows transfers again
+	coinKeeper.SetSendEnabled(ctx, NewSendEnabled("barcoin", true))
+	err = coinKeeper.SendCoins(ctx, addr, addr2, sdk.Coins{sdk.NewCoin("barcoin", 5)})
+	require.Nil(t, err)
+	require.True(t, coinKeeper.GetCoins(ctx, addr2).IsEqual(sdk.Coins{sdk.NewCoin("barcoin", 5)}))
+
+	require.Equal(t, []SendEnabled{NewSendEnabled("barcoin", true), NewSendEnabled("foocoin", true)},
+		WriteGenesis(ctx, coinKeeper).SendEnabled)
+}
+
+func TestValidateGenesis(t *testing.T) {
+	cases := []struct {
+		sendEnabled []SendEnabled
+		valid       bool
+	}{
+		{nil, true},
+		{[]SendEnabled{NewSendEnabled("foocoin", true), NewSendEnabled("barcoin", false)}, true},
+		{[]SendEnabled{NewSendEnabled("", true)}, false},
+		{[]SendEnabled{NewSendEnabled("foo coin", true)}, false},
+		{[]SendEnabled{NewSendEnabled("foocoin", true), NewSendEnabled("foocoin", false)}, false},
+	}
+
+	for i, tc := range cases {
+		err := ValidateGenesis(NewGenesisState(sdk.Coins{}, nil, nil, tc.sendEnabled))
+		if tc.valid {
+			require.Nil(t, err, "case #%d", i)
+		} else {
+			require.NotNil(t, err, "case #%d", i)
+		}
+	}
+}
+
+func TestKeeperEvents(t *testing.T) {
+	ms, authKey, supplyKey := setupMultiStore()
+
+	cdc := wire.NewCodec()
+	auth.RegisterBaseAccount(cdc)
+
+	ctx := sdk.NewContext(ms, abci.Header{}, false, log.NewNopLogger())
+	accountMapper := auth.NewAccountMapper(cdc, authKey, auth.ProtoBaseAccount)
+	coinKeeper := NewKeeper(accountMapper, NewSupplyKeeper(cdc, supplyKey))
+
+	addr := sdk.AccAddress([]byte("addr1"))
+	addr2 := sdk.AccAddress([]byte("addr2"))
+	coinKeeper.SetCoins(ctx, addr, sdk.Coins{sdk.NewCoin("foocoin", 10)})
+	amt := sdk.Coins{sdk.NewCoin("foocoin", 5)}
+
+	err := coinKeeper.SendCoins(ctx, addr, addr2, amt)
+	require.Nil(t, err)
+	require.Equal(t, sdk.Events{
+		sdk.NewEvent(EventTypeCoinSpent,
+			sdk.NewAttribute(AttributeKeySpender, addr.String()),
+			sdk.NewAttribute(AttributeKeyAmount, amt.String())),
+		sdk.NewEvent(EventTypeCoinReceived,
+			sdk.NewAttribute(AttributeKeyReceiver, addr2.String()),
+			sdk.NewAttribute(AttributeKeyAmount, amt.String())),
+		sdk.NewEvent(EventTypeTransfer,
+			sdk.NewAttribute(AttributeKeySender, addr.String()),
+			sdk.NewAttribute(AttributeKeyRecipient, addr2.String()),
+			sdk.NewAttribute(AttributeKeyAmount, amt.String())),
+	}, ctx.EventManager().Events())
+
+	// a failed transfer emits nothing
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	err = coinKeeper.SendCoins(ctx, addr, addr2, sdk.Coins{sdk.NewCoin("foocoin", 50)})
+	require.NotNil(t, err)
+	require.Equal(t, sdk.Events{}, ctx.EventManager().Events())
+}
@@ -0,0 +1,250 @@
+package bank
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MaxMetadataExponent is the largest number of decimals a display denom can
+// have relative to its base denom
+const MaxMetadataExponent = 18
+
+var (
+	// same denomination format as sdk.ParseCoin
+	reMetadataDenom = regexp.MustCompile(`^[[:alpha:]][[:alnum:]]{2,15}$`)
+	reDisplayAmount = regexp.MustCompile(`^([[:digit:]]+)(?:\.([[:digit:]]+))?$`)
+	reDisplayCoin   = regexp.MustCompile(`^([[:digit:]]+(?:\.[[:digit:]]+)?)[[:space:]]*([[:alpha:]][[:alnum:]]{2,15})$`)
+)
+
+// Metadata describes how a base denom, the unit every amount is stored in, is
+// presented to users. One display unit is 10^Exponent base units, eg. with
+// Base "uatom", Display "atom" and Exponent 6, 1.5atom is 1500000uatom.
+type Metadata struct {
+	Base        string `json:"base"`
+	Display     string `json:"display"`
+	Exponent    uint   `json:"exponent"`
+	Description string `json:"description"`
+}
+
+// NewMetadata returns a new Metadata
+func NewMetadata(base, display string, exponent uint, description string) Metadata {
+	return Metadata{
+		Base:        base,
+		Display:     display,
+		Exponent:    exponent,
+		Description: description,
+	}
+}
+
+// ValidateBasic performs the stateless validity checks of the metadata
+func (m Metadata) ValidateBasic() sdk.Error {
+	if !reMetadataDenom.MatchString(m.Base) {
+		return ErrInvalidMetadata(DefaultCodespace, fmt.Sprintf("invalid base denom %q", m.Base))
+	}
+	if !reMetadataDenom.MatchString(m.Display) {
+		return ErrInvalidMetadata(DefaultCodespace, fmt.Sprintf("invalid display denom %q", m.Display))
+	}
+	if m.Exponent > MaxMetadataExponent {
+		return ErrInvalidMetadata(DefaultCodespace, fmt.Sprintf("exponent %d is larger than %d", m.Exponent, MaxMetadataExponent))
+	}
+	if m.Base == m.Display && m.Exponent != 0 {
+		return ErrInvalidMetadata(DefaultCodespace, "a display denom equal to the base denom must have a zero exponent")
+	}
+	return nil
+}
+
+// String implements fmt.Stringer
+func (m Metadata) String() string {
+	return fmt.Sprintf("%s: 1%s = 10^%d%s %s", m.Base, m.Display, m.Exponent, m.Base, m.Description)
+}
+
+// ToBaseAmount converts an amount in display units, eg. "1.5", to the exact
+// amount in base units. Amounts which can't be represented exactly in base
+// units are rejected rather than rounded.
+func (m Metadata) ToBaseAmount(amount string) (sdk.Int, error) {
+	matches := reDisplayAmount.FindStringSubmatch(amount)
+	if matches == nil {
+		return sdk.Int{}, fmt.Errorf("invalid %s amount: %q", m.Display, amount)
+	}
+	whole, frac := matches[1], strings.TrimRight(matches[2], "0")
+	if uint(len(frac)) > m.Exponent {
+		return sdk.Int{}, fmt.Errorf("%s%s has more than %d decimals", amount, m.Display, m.Exponent)
+	}
+	digits := whole + frac + strings.Repeat("0", int(m.Exponent)-len(frac))
+	res, ok := sdk.NewIntFromString(digits)
+	if !ok {
+		return sdk.Int{}, fmt.Errorf("%s amount out of range: %q", m.Display, amount)
+	}
+	return res, nil
+}
+
+// ToDisplayAmount formats an amount in base units as an exact decimal amount in
+// display units, without trailing zeros. Unless the display denom is the base
+// denom, the amount keeps a decimal point, so that it parses back as display
+// units.
+func (m Metadata) ToDisplayAmount(amount sdk.Int) string {
+	digits := amount.BigInt().String()
+	neg := strings.HasPrefix(digits, "-")
+	digits = strings.TrimPrefix(digits, "-")
+
+	exp := int(m.Exponent)
+	if len(digits) <= exp {
+		digits = strings.Repeat("0", exp-len(digits)+1) + digits
+	}
+	whole, frac := digits[:len(digits)-exp], strings.TrimRight(digits[len(digits)-exp:], "0")
+
+	res := whole
+	if frac != "" {
+		res += "." + frac
+	} else if m.Display != m.Base {
+		res += ".0"
+	}
+	if neg {
+		res = "-" + res
+	}
+	return res
+}
+
+// ToDisplayCoin formats a coin of the base denom in display units, eg. "1.5atom"
+func (m Metadata) ToDisplayCoin(coin sdk.Coin) string {
+	return m.ToDisplayAmount(coin.Amount) + m.Display
+}
+
+// ParseDisplayCoin parses a coin written either in a display denom of the
+// metadata with a decimal point, eg. "1.5atom" or "5.0atom", or as an integer
+// amount of its denom, eg. "1500000uatom", into a coin of the base denom.
+// Integer amounts are base units, so an integer amount of a display denom
+// which isn't its own base denom, eg. "5atom", is rejected.
+func ParseDisplayCoin(metadata []Metadata, coinStr string) (coin sdk.Coin, err error) {
+	coinStr = strings.TrimSpace(coinStr)
+
+	matches := reDisplayCoin.FindStringSubmatch(coinStr)
+	if matches == nil {
+		return coin, fmt.Errorf("invalid coin expression: %s", coinStr)
+	}
+	amount, denom := matches[1], matches[2]
+	isDecimal := strings.Contains(amount, ".")
+
+	for _, m := range metadata {
+		if m.Display != denom {
+			continue
+		}
+		if !isDecimal {
+			if m.Display == m.Base {
+				break
+			}
+			return coin, fmt.Errorf("%s is a display denom, write the amount with a decimal point or in %s: %s", denom, m.Base, coinStr)
+		}
+		baseAmount, err := m.ToBaseAmount(amount)
+		if err != nil {
+			return coin, err
+		}
+		return sdk.Coin{Denom: m.Base, Amount: baseAmount}, nil
+	}
+
+	if !isDecimal {
+		return sdk.ParseCoin(coinStr)
+	}
+	return coin, fmt.Errorf("%s is not a display denom, only integer amounts are valid: %s", denom, coinStr)
+}
+
+// ParseDisplayCoins parses a comma separated list of coins with ParseDisplayCoin
+func ParseDisplayCoins(metadata []Metadata, coinsStr string) (coins sdk.Coins, err error) {
+	coinsStr = strings.TrimSpace(coinsStr)
+	if len(coinsStr) == 0 {
+		return nil, nil
+	}
+
+	for _, coinStr := range strings.Split(coinsStr, ",") {
+		coin, err := ParseDisplayCoin(metadata, coinStr)
+		if err != nil {
+			return nil, err
+		}
+		coins = append(coins, coin)
+	}
+
+	// sort coins for determinism
+	coins.Sort()
+
+	// validate coins before returning, this rejects the same denom written twice
+	if !coins.IsValid() {
+		return nil, fmt.Errorf("parseCoins invalid: %#v", coins)
+	}
+	return coins, nil
+}
+
+// FormatDisplayCoins formats coins in the display denoms of the metadata,
+// coins without metadata are formatted in their own denom
+func FormatDisplayCoins(metadata []Metadata, coins sdk.Coins) string {
+	if len(coins) == 0 {
+		return ""
+	}
+
+	res := make([]string, len(coins))
+	for i, coin := range coins {
+		res[i] = coin.String()
+		for _, m := range metadata {
+			if m.Base == coin.Denom {
+				res[i] = m.ToDisplayCoin(coin)
+				break
+			}
+		}
+	}
+	return strings.Join(res, ",")
+}
+
+//______________________________________________________________________________________________
+
+// get the key for the metadata of a base denom
+func GetMetadataKey(denom string) []byte {
+	return append(MetadataKeyPrefix, []byte(denom)...)
+}
+
+// GetDenomMetadata returns the metadata of a base denom
+func (keeper SupplyKeeper) GetDenomMetadata(ctx sdk.Context, denom string) (metadata Metadata, found bool) {
+	store := ctx.KVStore(keeper.storeKey)
+	bz := store.Get(GetMetadataKey(denom))
+	if bz == nil {
+		return metadata, false
+	}
+	keeper.cdc.MustUnmarshalBinary(bz, &metadata)
+	return metadata, true
+}
+
+// SetDenomMetadata registers or replaces the metadata of a base denom. A
+// display denom must not be the base or display denom of another registered
+// denom, otherwise amounts typed in it would be ambiguous.
+func (keeper SupplyKeeper) SetDenomMetadata(ctx sdk.Context, metadata Metadata) sdk.Error {
+	if err := metadata.ValidateBasic(); err != nil {
+		return err
+	}
+	for _, other := range keeper.GetAllDenomMetadata(ctx) {
+		if other.Base == metadata.Base {
+			continue
+		}
+		if other.Display == metadata.Display || other.Base == metadata.Display || other.Display == metadata.Base {
+			return ErrInvalidMetadata(DefaultCodespace,
+				fmt.Sprintf("denom %s conflicts with the metadata of %s", metadata.Display, other.Base))
+		}
+	}
+	store := ctx.KVStore(keeper.storeKey)
+	store.Set(GetMetadataKey(metadata.Base), keeper.cdc.MustMarshalBinary(metadata))
+	return nil
+}
+
+// GetAllDenomMetadata returns the metadata of all the registered denoms
+func (keeper SupplyKeeper) GetAllDenomMetadata(ctx sdk.Context) (metadata []Metadata) {
+	store := ctx.KVStore(keeper.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, MetadataKeyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var m Metadata
+		keeper.cdc.MustUnmarshalBinary(iterator.Value(), &m)
+		metadata = append(metadata, m)
+	}
+	return metadata
+}
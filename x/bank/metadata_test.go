@@ -0,0 +1,156 @@
+package bank
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestMetadataValidateBasic(t *testing.T) {
+	cases := []struct {
+		metadata Metadata
+		valid    bool
+	}{
+		{NewMetadata("uatom", "atom", 6, "the staking token"), true},
+		{NewMetadata("steak", "steak", 0, ""), true},
+		{NewMetadata("wei", "ether", 18, ""), true},
+		{NewMetadata("", "atom", 6, ""), false},
+		{NewMetadata("uatom", "1atom", 6, ""), false},
+		{NewMetadata("uatom", "atom", 19, ""), false},
+		{NewMetadata("steak", "steak", 2, ""), false},
+	}
+
+	for i, tc := range cases {
+		err := tc.metadata.ValidateBasic()
+		require.Equal(t, tc.valid, err == nil, "unexpected result for case #%d: %v", i, err)
+	}
+}
+
+func TestMetadataToBaseAmount(t *testing.T) {
+	atom := NewMetadata("uatom", "atom", 6, "")
+	steak := NewMetadata("steak", "steak", 0, "")
+
+	cases := []struct {
+		metadata Metadata
+		amount   string
+		expected int64
+		valid    bool
+	}{
+		{atom, "1", 1000000, true},
+		{atom, "1.5", 1500000, true},
+		{atom, "0.000001", 1, true},
+		{atom, "0.0000010", 1, true},
+		{atom, "12.345678", 12345678, true},
+		{atom, "0", 0, true},
+		{atom, "0.0000001", 0, false},
+		{atom, "1.", 0, false},
+		{atom, ".5", 0, false},
+		{atom, "-1", 0, false},
+		{atom, "1e6", 0, false},
+		{steak, "10", 10, true},
+		{steak, "10.0", 10, true},
+		{steak, "10.5", 0, false},
+	}
+
+	for i, tc := range cases {
+		res, err := tc.metadata.ToBaseAmount(tc.amount)
+		if !tc.valid {
+			require.NotNil(t, err, "expected error for case #%d", i)
+			continue
+		}
+		require.Nil(t, err, "unexpected error for case #%d: %v", i, err)
+		require.True(t, res.Equal(sdk.NewInt(tc.expected)), "case #%d: %v != %v", i, res, tc.expected)
+	}
+
+	// amounts out of the sdk.Int range are rejected
+	_, err := NewMetadata("wei", "ether", 18, "").ToBaseAmount("1" + strings.Repeat("0", 60))
+	require.NotNil(t, err)
+}
+
+func TestMetadataToDisplayAmount(t *testing.T) {
+	atom := NewMetadata("uatom", "atom", 6, "")
+	steak := NewMetadata("steak", "steak", 0, "")
+
+	cases := []struct {
+		metadata Metadata
+		amount   int64
+		expected string
+	}{
+		{atom, 1500000, "1.5"},
+		{atom, 1000000, "1.0"},
+		{atom, 1, "0.000001"},
+		{atom, 12345678, "12.345678"},
+		{atom, 0, "0.0"},
+		{atom, -2500000, "-2.5"},
+		{steak, 10, "10"},
+	}
+
+	for i, tc := range cases {
+		require.Equal(t, tc.expected, tc.metadata.ToDisplayAmount(sdk.NewInt(tc.amount)), "case #%d", i)
+
+		// the conversion must be exact both ways
+		if tc.amount >= 0 {
+			res, err := tc.metadata.ToBaseAmount(tc.expected)
+			require.Nil(t, err)
+			require.True(t, res.Equal(sdk.NewInt(tc.amount)), "case #%d", i)
+		}
+	}
+
+	require.Equal(t, "1.5atom", atom.ToDisplayCoin(sdk.NewCoin("uatom", 1500000)))
+}
+
+func TestParseDisplayCoins(t *testing.T) {
+	metadata := []Metadata{
+		NewMetadata("uatom", "atom", 6, ""),
+		NewMetadata("mphoton", "photon", 3, ""),
+		NewMetadata("steak", "steak", 0, ""),
+	}
+
+	cases := []struct {
+		input    string
+		expected sdk.Coins
+		valid    bool
+	}{
+		{"", nil, true},
+		{"1.5atom", sdk.Coins{sdk.NewCoin("uatom", 1500000)}, true},
+		{"1500000uatom", sdk.Coins{sdk.NewCoin("uatom", 1500000)}, true},
+		{"2.0photon, 0.001atom", sdk.Coins{sdk.NewCoin("mphoton", 2000), sdk.NewCoin("uatom", 1000)}, true},
+		{"10steak,1.0atom", sdk.Coins{sdk.NewCoin("steak", 10), sdk.NewCoin("uatom", 1000000)}, true},
+		{"5steak", sdk.Coins{sdk.NewCoin("steak", 5)}, true}, // the display denom is the base denom
+		{"5atom", nil, false},                // integer amounts are base units, not atom
+		{"1.5steak", nil, false},             // steak has no decimals
+		{"1.5uatom", nil, false},             // base denoms are integer amounts
+		{"0.0001photon", nil, false},         // more decimals than the exponent
+		{"1.0atom,1000000uatom", nil, false}, // same base denom twice
+		{"1.5", nil, false},
+	}
+
+	for i, tc := range cases {
+		res, err := ParseDisplayCoins(metadata, tc.input)
+		if !tc.valid {
+			require.NotNil(t, err, "expected error for case #%d", i)
+			continue
+		}
+		require.Nil(t, err, "unexpected error for case #%d: %v", i, err)
+		require.True(t, tc.expected.IsEqual(res), "case #%d: %v != %v", i, res, tc.expected)
+	}
+}
+
+func TestFormatDisplayCoins(t *testing.T) {
+	metadata := []Metadata{NewMetadata("uatom", "atom", 6, "")}
+
+	require.Equal(t, "", FormatDisplayCoins(metadata, sdk.Coins{}))
+	require.Equal(t, "10steak,1.5atom",
+		FormatDisplayCoins(metadata, sdk.Coins{sdk.NewCoin("steak", 10), sdk.NewCoin("uatom", 1500000)}))
+
+	// formatted display amounts parse back to the same coins
+	coins := sdk.Coins{sdk.NewCoin("steak", 10), sdk.NewCoin("uatom", 2000000)}
+	formatted := FormatDisplayCoins(metadata, coins)
+	require.Equal(t, "10steak,2.0atom", formatted)
+	res, err := ParseDisplayCoins(metadata, formatted)
+	require.Nil(t, err)
+	require.True(t, coins.IsEqual(res))
+}
@@ -245,7 +245,30 @@ func TestMsgIssueType(t *testing.T) {
 }
 
 func TestMsgIssueValidation(t *testing.T) {
-	// TODO
+	banker := sdk.AccAddress([]byte("banker"))
+	addr := sdk.AccAddress([]byte("loan-from-bank"))
+	coins := sdk.Coins{sdk.NewCoin("atom", 10)}
+	negCoins := sdk.Coins{sdk.NewCoin("atom", -10)}
+
+	cases := []struct {
+		valid bool
+		msg   MsgIssue
+	}{
+		{true, NewMsgIssue(banker, []Output{NewOutput(addr, coins)})},
+		{false, NewMsgIssue(nil, []Output{NewOutput(addr, coins)})},       // no banker
+		{false, NewMsgIssue(banker, nil)},                                 // no outputs
+		{false, NewMsgIssue(banker, []Output{NewOutput(addr, negCoins)})}, // negative coins
+		{false, NewMsgIssue(banker, []Output{NewOutput(nil, coins)})},     // no output address
+	}
+
+	for i, tc := range cases {
+		err := tc.msg.ValidateBasic()
+		if tc.valid {
+			require.Nil(t, err, "%d: %+v", i, err)
+		} else {
+			require.NotNil(t, err, "%d", i)
+		}
+	}
 }
 
 func TestMsgIssueGetSignBytes(t *testing.T) {
@@ -0,0 +1,95 @@
+package bank
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const costGetSendEnabled sdk.Gas = 10
+
+// SendEnabled sets whether the coins of a denom can be transferred between
+// accounts. Denoms without a flag are transferable.
+type SendEnabled struct {
+	Denom   string `json:"denom"`
+	Enabled bool   `json:"enabled"`
+}
+
+// NewSendEnabled returns a new SendEnabled
+func NewSendEnabled(denom string, enabled bool) SendEnabled {
+	return SendEnabled{Denom: denom, Enabled: enabled}
+}
+
+// ValidateBasic performs the stateless validity checks of the flag
+func (se SendEnabled) ValidateBasic() sdk.Error {
+	if !reMetadataDenom.MatchString(se.Denom) {
+		return ErrInvalidInput(DefaultCodespace, fmt.Sprintf("invalid denom %q", se.Denom))
+	}
+	return nil
+}
+
+// get the key for the send-enabled flag of a denom
+func GetSendEnabledKey(denom string) []byte {
+	return append(SendEnabledKeyPrefix, []byte(denom)...)
+}
+
+// GetSendEnabled returns whether the coins of a denom can be transferred
+func (keeper SupplyKeeper) GetSendEnabled(ctx sdk.Context, denom string) bool {
+	ctx.GasMeter().ConsumeGas(costGetSendEnabled, "getSendEnabled")
+	store := ctx.KVStore(keeper.storeKey)
+	bz := store.Get(GetSendEnabledKey(denom))
+	if bz == nil {
+		return true
+	}
+	var enabled bool
+	keeper.cdc.MustUnmarshalBinary(bz, &enabled)
+	return enabled
+}
+
+// SetSendEnabled sets whether the coins of a denom can be transferred
+func (keeper SupplyKeeper) SetSendEnabled(ctx sdk.Context, se SendEnabled) {
+	store := ctx.KVStore(keeper.storeKey)
+	store.Set(GetSendEnabledKey(se.Denom), keeper.cdc.MustMarshalBinary(se.Enabled))
+}
+
+// GetAllSendEnabled returns the send-enabled flags of all the denoms which have one
+func (keeper SupplyKeeper) GetAllSendEnabled(ctx sdk.Context) (flags []SendEnabled) {
+	store := ctx.KVStore(keeper.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, SendEnabledKeyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var enabled bool
+		keeper.cdc.MustUnmarshalBinary(iterator.Value(), &enabled)
+		denom := string(iterator.Key()[len(SendEnabledKeyPrefix):])
+		flags = append(flags, NewSendEnabled(denom, enabled))
+	}
+	return flags
+}
+
+//______________________________________________________________________________________________
+
+// set of the addresses that can't receive transfers, eg. accounts controlled by modules
+type blockedAddrs map[string]bool
+
+func newBlockedAddrs(addrs []sdk.AccAddress) blockedAddrs {
+	blocked := make(blockedAddrs, len(addrs))
+	for _, addr := range addrs {
+		blocked[addr.String()] = true
+	}
+	return blocked
+}
+
+// checks that the coins can be transferred and that the recipient accepts
+// transfers
+func checkSend(ctx sdk.Context, sk SupplyKeeper, blocked blockedAddrs, toAddr sdk.AccAddress, amt sdk.Coins) sdk.Error {
+	if blocked[toAddr.String()] {
+		return ErrBlockedAddr(DefaultCodespace, fmt.Sprintf("%s is not allowed to receive transfers", toAddr))
+	}
+	for _, coin := range amt {
+		if !sk.GetSendEnabled(ctx, coin.Denom) {
+			return ErrSendDisabled(DefaultCodespace, fmt.Sprintf("%s transfers are currently disabled", coin.Denom))
+		}
+	}
+	return nil
+}
@@ -0,0 +1,173 @@
+package bank
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/wire"
+)
+
+const (
+	costGetSupply    sdk.Gas = 10
+	costSetSupply    sdk.Gas = 100
+	costGetIssuer    sdk.Gas = 10
+	costChangeSupply sdk.Gas = 10
+)
+
+// nolint
+var (
+	SupplyKeyPrefix      = []byte{0x00} // prefix for the total supply of each denom
+	IssuerKeyPrefix      = []byte{0x01} // prefix for the issuer of each denom
+	MetadataKeyPrefix    = []byte{0x02} // prefix for the metadata of each base denom
+	SendEnabledKeyPrefix = []byte{0x03} // prefix for the send-enabled flag of each denom
+)
+
+// get the key for the total supply of a denom
+func GetSupplyKey(denom string) []byte {
+	return append(SupplyKeyPrefix, []byte(denom)...)
+}
+
+// get the key for the issuer of a denom
+func GetIssuerKey(denom string) []byte {
+	return append(IssuerKeyPrefix, []byte(denom)...)
+}
+
+// Issuer is the account allowed to issue new coins of a denom
+type Issuer struct {
+	Denom   string         `json:"denom"`
+	Address sdk.AccAddress `json:"address"`
+}
+
+// NewIssuer returns a new Issuer
+func NewIssuer(denom string, addr sdk.AccAddress) Issuer {
+	return Issuer{Denom: denom, Address: addr}
+}
+
+//______________________________________________________________________________________________
+
+// SupplyKeeper tracks the total supply of every denom in existence and the
+// registry of the accounts allowed to issue each denom. Coins are only created
+// or destroyed through the SupplyKeeper, moving them around doesn't touch it.
+type SupplyKeeper struct {
+	storeKey sdk.StoreKey
+	cdc      *wire.Codec
+}
+
+// NewSupplyKeeper returns a new SupplyKeeper
+func NewSupplyKeeper(cdc *wire.Codec, key sdk.StoreKey) SupplyKeeper {
+	return SupplyKeeper{
+		storeKey: key,
+		cdc:      cdc,
+	}
+}
+
+// GetSupply returns the total supply of a denom
+func (keeper SupplyKeeper) GetSupply(ctx sdk.Context, denom string) sdk.Int {
+	ctx.GasMeter().ConsumeGas(costGetSupply, "getSupply")
+	store := ctx.KVStore(keeper.storeKey)
+	bz := store.Get(GetSupplyKey(denom))
+	if bz == nil {
+		return sdk.ZeroInt()
+	}
+	var supply sdk.Int
+	keeper.cdc.MustUnmarshalBinary(bz, &supply)
+	return supply
+}
+
+func (keeper SupplyKeeper) setSupply(ctx sdk.Context, denom string, supply sdk.Int) {
+	ctx.GasMeter().ConsumeGas(costSetSupply, "setSupply")
+	store := ctx.KVStore(keeper.storeKey)
+	if supply.IsZero() {
+		store.Delete(GetSupplyKey(denom))
+		return
+	}
+	store.Set(GetSupplyKey(denom), keeper.cdc.MustMarshalBinary(supply))
+}
+
+// GetTotalSupply returns the total supply of all the denoms
+func (keeper SupplyKeeper) GetTotalSupply(ctx sdk.Context) sdk.Coins {
+	store := ctx.KVStore(keeper.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, SupplyKeyPrefix)
+	defer iterator.Close()
+
+	supply := sdk.Coins{}
+	for ; iterator.Valid(); iterator.Next() {
+		var amount sdk.Int
+		keeper.cdc.MustUnmarshalBinary(iterator.Value(), &amount)
+		denom := string(iterator.Key()[len(SupplyKeyPrefix):])
+		supply = append(supply, sdk.Coin{Denom: denom, Amount: amount})
+	}
+	// the store iterates by denom, so the coins are already sorted
+	return supply
+}
+
+// SetTotalSupply overwrites the supply of the denoms in amt, used at genesis
+func (keeper SupplyKeeper) SetTotalSupply(ctx sdk.Context, amt sdk.Coins) {
+	for _, coin := range amt {
+		keeper.setSupply(ctx, coin.Denom, coin.Amount)
+	}
+}
+
+// InflateSupply adds newly created coins to the supply
+func (keeper SupplyKeeper) InflateSupply(ctx sdk.Context, amt sdk.Coins) {
+	ctx.GasMeter().ConsumeGas(costChangeSupply, "inflateSupply")
+	for _, coin := range amt {
+		keeper.setSupply(ctx, coin.Denom, keeper.GetSupply(ctx, coin.Denom).Add(coin.Amount))
+	}
+}
+
+// DeflateSupply removes destroyed coins from the supply. Destroying more coins
+// than exist means the supply bookkeeping is broken, so it fails and leaves
+// the supply unchanged.
+func (keeper SupplyKeeper) DeflateSupply(ctx sdk.Context, amt sdk.Coins) sdk.Error {
+	ctx.GasMeter().ConsumeGas(costChangeSupply, "deflateSupply")
+	supplies := make([]sdk.Int, len(amt))
+	for i, coin := range amt {
+		supply := keeper.GetSupply(ctx, coin.Denom)
+		supplies[i] = supply.Sub(coin.Amount)
+		if supplies[i].LT(sdk.ZeroInt()) {
+			return ErrInvalidSupply(DefaultCodespace, fmt.Sprintf(
+				"supply of %s is %s, cannot destroy %s", coin.Denom, supply, coin.Amount))
+		}
+	}
+	for i, coin := range amt {
+		keeper.setSupply(ctx, coin.Denom, supplies[i])
+	}
+	return nil
+}
+
+// GetIssuer returns the account allowed to issue a denom, or nil if the denom
+// can't be issued
+func (keeper SupplyKeeper) GetIssuer(ctx sdk.Context, denom string) sdk.AccAddress {
+	ctx.GasMeter().ConsumeGas(costGetIssuer, "getIssuer")
+	store := ctx.KVStore(keeper.storeKey)
+	bz := store.Get(GetIssuerKey(denom))
+	if bz == nil {
+		return nil
+	}
+	return sdk.AccAddress(bz)
+}
+
+// SetIssuer registers the account allowed to issue a denom, an empty address
+// removes the issuer
+func (keeper SupplyKeeper) SetIssuer(ctx sdk.Context, issuer Issuer) {
+	store := ctx.KVStore(keeper.storeKey)
+	if len(issuer.Address) == 0 {
+		store.Delete(GetIssuerKey(issuer.Denom))
+		return
+	}
+	store.Set(GetIssuerKey(issuer.Denom), issuer.Address)
+}
+
+// GetIssuers returns all the registered issuers
+func (keeper SupplyKeeper) GetIssuers(ctx sdk.Context) (issuers []Issuer) {
+	store := ctx.KVStore(keeper.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, IssuerKeyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		denom := string(iterator.Key()[len(IssuerKeyPrefix):])
+		issuers = append(issuers, NewIssuer(denom, iterator.Value()))
+	}
+	return issuers
+}
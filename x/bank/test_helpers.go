@@ -16,11 +16,14 @@ import (
 	"github.com/tendermint/tendermint/crypto"
 )
 
-// ModuleInvariants runs all invariants of the bank module.
-// Currently runs non-negative balance invariant and TotalCoinsInvariant
-func ModuleInvariants(t *testing.T, app *mock.App, log string) {
-	NonnegativeBalanceInvariant(t, app, log)
-	TotalCoinsInvariant(t, app, log)
+// ModuleInvariants returns an invariant running all invariants of the bank
+// module: the non-negative balance invariant and the SupplyInvariant
+func ModuleInvariants(keeper Keeper) mock.Invariant {
+	supplyInvariant := SupplyInvariant(keeper)
+	return func(t *testing.T, app *mock.App, log string) {
+		NonnegativeBalanceInvariant(t, app, log)
+		supplyInvariant(t, app, log)
+	}
 }
 
 // NonnegativeBalanceInvariant checks that all accounts in the application have non-negative balances
@@ -38,20 +41,25 @@ func NonnegativeBalanceInvariant(t *testing.T, app *mock.App, log string) {
 	}
 }
 
-// TotalCoinsInvariant checks that the sum of the coins across all accounts
-// is what is expected
-func TotalCoinsInvariant(t *testing.T, app *mock.App, log string) {
-	ctx := app.BaseApp.NewContext(false, abci.Header{})
-	totalCoins := sdk.Coins{}
+// SupplyInvariant checks that the sum of the coins across all accounts is the
+// total supply tracked by the keeper. It only holds for apps which don't keep
+// coins outside of accounts.
+func SupplyInvariant(keeper Keeper) mock.Invariant {
+	return func(t *testing.T, app *mock.App, log string) {
+		ctx := app.BaseApp.NewContext(false, abci.Header{})
+		totalCoins := sdk.Coins{}
+
+		chkAccount := func(acc auth.Account) bool {
+			coins := acc.GetCoins()
+			totalCoins = totalCoins.Plus(coins)
+			return false
+		}
 
-	chkAccount := func(acc auth.Account) bool {
-		coins := acc.GetCoins()
-		totalCoins = totalCoins.Plus(coins)
-		return false
+		app.AccountMapper.IterateAccounts(ctx, chkAccount)
+		supply := keeper.GetTotalSupply(ctx)
+		require.True(t, supply.IsEqual(totalCoins),
+			fmt.Sprintf("supply %v doesn't match the sum of accounts %v\n%s", supply, totalCoins, log))
 	}
-
-	app.AccountMapper.IterateAccounts(ctx, chkAccount)
-	require.Equal(t, app.TotalCoinsSupply, totalCoins, log)
 }
 
 // TestAndRunSingleInputMsgSend tests and runs a single msg send, with one input and one output, where both
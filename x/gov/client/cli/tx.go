@@ -2,14 +2,19 @@ package cli
 
 import (
 	"fmt"
+	"strconv"
+	"strings"
 
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 
+	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/context"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/wire"
 	authcmd "github.com/cosmos/cosmos-sdk/x/auth/client/cli"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	bankclient "github.com/cosmos/cosmos-sdk/x/bank/client"
 	"github.com/cosmos/cosmos-sdk/x/gov"
 	"github.com/pkg/errors"
 )
@@ -24,10 +29,18 @@ const (
 	flagDepositer    = "depositer"
 	flagVoter        = "voter"
 	flagOption       = "option"
+	flagStatus       = "status"
+
+	flagBaseDenom        = "base-denom"
+	flagDisplayDenom     = "display-denom"
+	flagExponent         = "exponent"
+	flagDenomDescription = "denom-description"
+	flagSendEnabled      = "send-enabled"
 )
 
-// submit a proposal tx
-func GetCmdSubmitProposal(cdc *wire.Codec) *cobra.Command {
+// submit a proposal tx, a deposit in display units is converted with the denom
+// metadata of the supply store
+func GetCmdSubmitProposal(supplyStoreName string, cdc *wire.Codec) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "submit-proposal",
 		Short: "Submit a proposal along with an initial deposit",
@@ -43,7 +56,9 @@ func GetCmdSubmitProposal(cdc *wire.Codec) *cobra.Command {
 				return err
 			}
 
-			amount, err := sdk.ParseCoins(initialDeposit)
+			ctx := context.NewCoreContextFromViper().WithDecoder(authcmd.GetAccountDecoder(cdc))
+
+			amount, err := bankclient.ParseCoins(ctx, cdc, supplyStoreName, initialDeposit)
 			if err != nil {
 				return err
 			}
@@ -54,7 +69,20 @@ func GetCmdSubmitProposal(cdc *wire.Codec) *cobra.Command {
 			}
 
 			// create the message
-			msg := gov.NewMsgSubmitProposal(title, description, proposalType, from, amount)
+			var msg sdk.Msg
+			if proposalType == gov.ProposalTypeDenomMetadata {
+				metadata := bank.NewMetadata(viper.GetString(flagBaseDenom), viper.GetString(flagDisplayDenom),
+					uint(viper.GetInt(flagExponent)), viper.GetString(flagDenomDescription))
+				msg = gov.NewMsgSubmitDenomMetadataProposal(title, description, metadata, from, amount)
+			} else if proposalType == gov.ProposalTypeParameterChange && viper.GetString(flagSendEnabled) != "" {
+				sendEnabled, err := parseSendEnabled(viper.GetString(flagSendEnabled))
+				if err != nil {
+					return err
+				}
+				msg = gov.NewMsgSubmitParameterChangeProposal(title, description, sendEnabled, from, amount)
+			} else {
+				msg = gov.NewMsgSubmitProposal(title, description, proposalType, from, amount)
+			}
 
 			err = msg.ValidateBasic()
 			if err != nil {
@@ -62,7 +90,6 @@ func GetCmdSubmitProposal(cdc *wire.Codec) *cobra.Command {
 			}
 
 			// build and sign the transaction, then broadcast to Tendermint
-			ctx := context.NewCoreContextFromViper().WithDecoder(authcmd.GetAccountDecoder(cdc))
 			// proposalID must be returned, and it is a part of response
 			ctx.PrintResponse = true
 
@@ -77,14 +104,36 @@ func GetCmdSubmitProposal(cdc *wire.Codec) *cobra.Command {
 	cmd.Flags().String(flagTitle, "", "title of proposal")
 	cmd.Flags().String(flagDescription, "", "description of proposal")
 	cmd.Flags().String(flagProposalType, "", "proposalType of proposal")
+	cmd.Flags().String(flagBaseDenom, "", "base denom of a DenomMetadata proposal")
+	cmd.Flags().String(flagDisplayDenom, "", "display denom of a DenomMetadata proposal")
+	cmd.Flags().Uint(flagExponent, 0, "decimals of the display denom of a DenomMetadata proposal")
+	cmd.Flags().String(flagDenomDescription, "", "denom description of a DenomMetadata proposal")
+	cmd.Flags().String(flagSendEnabled, "", "send-enabled flags set by a ParameterChange proposal, eg. steak=true,photon=false")
 	cmd.Flags().String(flagDeposit, "", "deposit of proposal")
 	cmd.Flags().String(flagProposer, "", "proposer of proposal")
 
 	return cmd
 }
 
-// set a new Deposit transaction
-func GetCmdDeposit(cdc *wire.Codec) *cobra.Command {
+// parse a comma separated list of denom=bool send-enabled flags
+func parseSendEnabled(str string) (flags []bank.SendEnabled, err error) {
+	for _, flag := range strings.Split(str, ",") {
+		kv := strings.SplitN(strings.TrimSpace(flag), "=", 2)
+		if len(kv) != 2 {
+			return nil, errors.Errorf("invalid send-enabled flag %q, expected denom=bool", flag)
+		}
+		enabled, err := strconv.ParseBool(kv[1])
+		if err != nil {
+			return nil, errors.Errorf("invalid send-enabled flag %q, expected denom=bool", flag)
+		}
+		flags = append(flags, bank.NewSendEnabled(kv[0], enabled))
+	}
+	return flags, nil
+}
+
+// set a new Deposit transaction, an amount in display units is converted with
+// the denom metadata of the supply store
+func GetCmdDeposit(supplyStoreName string, cdc *wire.Codec) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "deposit",
 		Short: "deposit tokens for activing proposal",
@@ -97,7 +146,9 @@ func GetCmdDeposit(cdc *wire.Codec) *cobra.Command {
 
 			proposalID := viper.GetInt64(flagProposalID)
 
-			amount, err := sdk.ParseCoins(viper.GetString(flagDeposit))
+			ctx := context.NewCoreContextFromViper().WithDecoder(authcmd.GetAccountDecoder(cdc))
+
+			amount, err := bankclient.ParseCoins(ctx, cdc, supplyStoreName, viper.GetString(flagDeposit))
 			if err != nil {
 				return err
 			}
@@ -111,8 +162,6 @@ func GetCmdDeposit(cdc *wire.Codec) *cobra.Command {
 			}
 
 			// build and sign the transaction, then broadcast to Tendermint
-			ctx := context.NewCoreContextFromViper().WithDecoder(authcmd.GetAccountDecoder(cdc))
-
 			err = ctx.EnsureSignBuildBroadcast(ctx.FromAddressName, []sdk.Msg{msg}, cdc)
 			if err != nil {
 				return err
@@ -245,3 +294,241 @@ func GetCmdQueryVote(storeName string, cdc *wire.Codec) *cobra.Command {
 
 	return cmd
 }
+
+// Command to query the tally of a proposal, computed on the current state
+// while the proposal is in its voting period
+func GetCmdQueryTally(queryRoute string, cdc *wire.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "query-tally",
+		Short: "query the tally of a proposal",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			proposalID := viper.GetInt64(flagProposalID)
+
+			ctx := context.NewCoreContextFromViper()
+
+			params := gov.QueryTallyParams{
+				ProposalID: proposalID,
+			}
+			bz, err := cdc.MarshalJSON(params)
+			if err != nil {
+				return err
+			}
+
+			res, _, err := ctx.QueryWithData(fmt.Sprintf("/custom/%s/%s", queryRoute, gov.QueryTally), bz)
+			if err != nil {
+				return err
+			}
+
+			var tallyResult gov.TallyResult
+			cdc.MustUnmarshalJSON(res, &tallyResult)
+			output, err := wire.MarshalJSONIndent(cdc, tallyResult)
+			if err != nil {
+				return err
+			}
+			fmt.Println(string(output))
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flagProposalID, "", "proposalID of the proposal being tallied")
+
+	return cmd
+}
+
+// Command to query a page of the proposals, filtered by voter, depositer and
+// status
+func GetCmdQueryProposals(queryRoute string, cdc *wire.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "query-proposals",
+		Short: "query proposals with optional filters",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			params := gov.QueryProposalsParams{
+				Pagination: sdk.NewPaginationParams(viper.GetInt(client.FlagPage), viper.GetInt(client.FlagLimit)),
+			}
+
+			if bechVoterAddr := viper.GetString(flagVoter); len(bechVoterAddr) != 0 {
+				voterAddr, err := sdk.AccAddressFromBech32(bechVoterAddr)
+				if err != nil {
+					return err
+				}
+				params.Voter = voterAddr
+			}
+
+			if bechDepositerAddr := viper.GetString(flagDepositer); len(bechDepositerAddr) != 0 {
+				depositerAddr, err := sdk.AccAddressFromBech32(bechDepositerAddr)
+				if err != nil {
+					return err
+				}
+				params.Depositer = depositerAddr
+			}
+
+			proposalStatus, err := gov.ProposalStatusFromString(viper.GetString(flagStatus))
+			if err != nil {
+				return err
+			}
+			params.ProposalStatus = proposalStatus
+
+			bz, err := cdc.MarshalJSON(params)
+			if err != nil {
+				return err
+			}
+
+			ctx := context.NewCoreContextFromViper()
+			res, _, err := ctx.QueryWithData(fmt.Sprintf("/custom/%s/%s", queryRoute, gov.QueryProposals), bz)
+			if err != nil {
+				return err
+			}
+
+			var proposals []gov.Proposal
+			cdc.MustUnmarshalJSON(res, &proposals)
+			output, err := wire.MarshalJSONIndent(cdc, proposals)
+			if err != nil {
+				return err
+			}
+			fmt.Println(string(output))
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flagVoter, "", "(optional) filter by proposals voted on by voter")
+	cmd.Flags().String(flagDepositer, "", "(optional) filter by proposals deposited on by depositer")
+	cmd.Flags().String(flagStatus, "", "(optional) filter proposals by proposal status (DepositPeriod|VotingPeriod|Passed|Rejected)")
+	client.AddPaginationFlags(cmd)
+
+	return cmd
+}
+
+// Command to query a deposit on a proposal
+func GetCmdQueryDeposit(storeName string, cdc *wire.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "query-deposit",
+		Short: "query deposit",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			proposalID := viper.GetInt64(flagProposalID)
+
+			depositerAddr, err := sdk.AccAddressFromBech32(viper.GetString(flagDepositer))
+			if err != nil {
+				return err
+			}
+
+			ctx := context.NewCoreContextFromViper()
+
+			res, err := ctx.QueryStore(gov.KeyDeposit(proposalID, depositerAddr), storeName)
+			if len(res) == 0 || err != nil {
+				return errors.Errorf("depositer [%s] did not deposit on proposalID [%d]", depositerAddr, proposalID)
+			}
+
+			var deposit gov.Deposit
+			cdc.MustUnmarshalBinary(res, &deposit)
+			output, err := wire.MarshalJSONIndent(cdc, deposit)
+			if err != nil {
+				return err
+			}
+			fmt.Println(string(output))
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flagProposalID, "", "proposalID of proposal deposited on")
+	cmd.Flags().String(flagDepositer, "", "bech32 depositer address")
+
+	return cmd
+}
+
+// Command to query a page of the votes on a proposal
+func GetCmdQueryVotes(queryRoute string, cdc *wire.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "query-votes",
+		Short: "query votes on a proposal",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			res, err := queryProposalRecords(queryRoute, gov.QueryVotes, cdc)
+			if err != nil {
+				return err
+			}
+
+			var votes []gov.Vote
+			cdc.MustUnmarshalJSON(res, &votes)
+			output, err := wire.MarshalJSONIndent(cdc, votes)
+			if err != nil {
+				return err
+			}
+			fmt.Println(string(output))
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flagProposalID, "", "proposalID of which proposal's votes are being queried")
+	client.AddPaginationFlags(cmd)
+
+	return cmd
+}
+
+// Command to query a page of the deposits on a proposal
+func GetCmdQueryDeposits(queryRoute string, cdc *wire.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "query-deposits",
+		Short: "query deposits on a proposal",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			res, err := queryProposalRecords(queryRoute, gov.QueryDeposits, cdc)
+			if err != nil {
+				return err
+			}
+
+			var deposits []gov.Deposit
+			cdc.MustUnmarshalJSON(res, &deposits)
+			output, err := wire.MarshalJSONIndent(cdc, deposits)
+			if err != nil {
+				return err
+			}
+			fmt.Println(string(output))
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flagProposalID, "", "proposalID of which proposal's deposits are being queried")
+	client.AddPaginationFlags(cmd)
+
+	return cmd
+}
+
+// query a page of the votes or deposits on the proposal given by the flags
+func queryProposalRecords(queryRoute, endpoint string, cdc *wire.Codec) ([]byte, error) {
+	params := gov.QueryProposalRecordsParams{
+		ProposalID: viper.GetInt64(flagProposalID),
+		Pagination: sdk.NewPaginationParams(viper.GetInt(client.FlagPage), viper.GetInt(client.FlagLimit)),
+	}
+	bz, err := cdc.MarshalJSON(params)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := context.NewCoreContextFromViper()
+	res, _, err := ctx.QueryWithData(fmt.Sprintf("/custom/%s/%s", queryRoute, endpoint), bz)
+	return res, err
+}
+
+// Command to query the deposit, voting and tallying procedures of governance
+func GetCmdQueryParams(queryRoute string, cdc *wire.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "query-params",
+		Short: "query the parameters of the governance process",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.NewCoreContextFromViper()
+			res, _, err := ctx.QueryWithData(fmt.Sprintf("/custom/%s/%s", queryRoute, gov.QueryParams), nil)
+			if err != nil {
+				return err
+			}
+
+			var params gov.Params
+			cdc.MustUnmarshalJSON(res, &params)
+			output, err := wire.MarshalJSONIndent(cdc, params)
+			if err != nil {
+				return err
+			}
+			fmt.Println(string(output))
+			return nil
+		},
+	}
+
+	return cmd
+}
@@ -6,6 +6,8 @@ import (
 	"strconv"
 
 	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/utils"
+	"github.com/cosmos/cosmos-sdk/crypto/keys"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/wire"
 	"github.com/cosmos/cosmos-sdk/x/gov"
@@ -16,21 +18,36 @@ import (
 // REST Variable names
 // nolint
 const (
-	RestProposalID = "proposalID"
-	RestDepositer  = "depositer"
-	RestVoter      = "voter"
-	storeName      = "gov"
+	RestProposalID     = "proposalID"
+	RestDepositer      = "depositer"
+	RestVoter          = "voter"
+	RestProposalStatus = "status"
+	storeName          = "gov"
 )
 
 // RegisterRoutes - Central function to define routes that get registered by the main application
-func RegisterRoutes(ctx context.CoreContext, r *mux.Router, cdc *wire.Codec) {
-	r.HandleFunc("/gov/proposals", postProposalHandlerFn(cdc, ctx)).Methods("POST")
-	r.HandleFunc(fmt.Sprintf("/gov/proposals/{%s}/deposits", RestProposalID), depositHandlerFn(cdc, ctx)).Methods("POST")
-	r.HandleFunc(fmt.Sprintf("/gov/proposals/{%s}/votes", RestProposalID), voteHandlerFn(cdc, ctx)).Methods("POST")
+func RegisterRoutes(ctx context.CoreContext, r *mux.Router, cdc *wire.Codec, kb keys.Keybase) {
+	RegisterTxRoutes(ctx, r, cdc, kb)
+	RegisterQueryRoutes(ctx, r, cdc)
+}
+
+// RegisterTxRoutes registers the REST routes signing gov txs with the keybase.
+// Without a keybase they only generate unsigned txs.
+func RegisterTxRoutes(ctx context.CoreContext, r *mux.Router, cdc *wire.Codec, kb keys.Keybase) {
+	r.HandleFunc("/gov/proposals", postProposalHandlerFn(cdc, kb, ctx)).Methods("POST")
+	r.HandleFunc(fmt.Sprintf("/gov/proposals/{%s}/deposits", RestProposalID), depositHandlerFn(cdc, kb, ctx)).Methods("POST")
+	r.HandleFunc(fmt.Sprintf("/gov/proposals/{%s}/votes", RestProposalID), voteHandlerFn(cdc, kb, ctx)).Methods("POST")
+}
 
+// RegisterQueryRoutes registers the REST routes querying the gov state
+func RegisterQueryRoutes(ctx context.CoreContext, r *mux.Router, cdc *wire.Codec) {
 	r.HandleFunc(fmt.Sprintf("/gov/proposals/{%s}", RestProposalID), queryProposalHandlerFn(cdc)).Methods("GET")
 	r.HandleFunc(fmt.Sprintf("/gov/proposals/{%s}/deposits/{%s}", RestProposalID, RestDepositer), queryDepositHandlerFn(cdc)).Methods("GET")
 	r.HandleFunc(fmt.Sprintf("/gov/proposals/{%s}/votes/{%s}", RestProposalID, RestVoter), queryVoteHandlerFn(cdc)).Methods("GET")
+	r.HandleFunc(fmt.Sprintf("/gov/proposals/{%s}/tally", RestProposalID), queryTallyHandlerFn(cdc)).Methods("GET")
+	r.HandleFunc(fmt.Sprintf("/gov/proposals/{%s}/deposits", RestProposalID), queryProposalRecordsHandlerFn(cdc, gov.QueryDeposits)).Methods("GET")
+	r.HandleFunc(fmt.Sprintf("/gov/proposals/{%s}/votes", RestProposalID), queryProposalRecordsHandlerFn(cdc, gov.QueryVotes)).Methods("GET")
+	r.HandleFunc("/gov/params", queryParamsHandlerFn(cdc)).Methods("GET")
 
 	r.HandleFunc("/gov/proposals", queryProposalsWithParameterFn(cdc)).Methods("GET")
 }
@@ -56,7 +73,7 @@ type voteReq struct {
 	Option  gov.VoteOption `json:"option"` //  option from OptionSet chosen by the voter
 }
 
-func postProposalHandlerFn(cdc *wire.Codec, ctx context.CoreContext) http.HandlerFunc {
+func postProposalHandlerFn(cdc *wire.Codec, kb keys.Keybase, ctx context.CoreContext) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		var req postProposalReq
 		err := buildReq(w, r, cdc, &req)
@@ -64,7 +81,7 @@ func postProposalHandlerFn(cdc *wire.Codec, ctx context.CoreContext) http.Handle
 			return
 		}
 
-		if !req.BaseReq.baseReqValidate(w) {
+		if !req.BaseReq.baseReqValidate(w, r, kb) {
 			return
 		}
 
@@ -77,11 +94,11 @@ func postProposalHandlerFn(cdc *wire.Codec, ctx context.CoreContext) http.Handle
 		}
 
 		// sign
-		signAndBuild(w, ctx, req.BaseReq, msg, cdc)
+		signAndBuild(w, r, ctx, req.BaseReq, msg, cdc)
 	}
 }
 
-func depositHandlerFn(cdc *wire.Codec, ctx context.CoreContext) http.HandlerFunc {
+func depositHandlerFn(cdc *wire.Codec, kb keys.Keybase, ctx context.CoreContext) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		vars := mux.Vars(r)
 		strProposalID := vars[RestProposalID]
@@ -105,7 +122,7 @@ func depositHandlerFn(cdc *wire.Codec, ctx context.CoreContext) http.HandlerFunc
 		if err != nil {
 			return
 		}
-		if !req.BaseReq.baseReqValidate(w) {
+		if !req.BaseReq.baseReqValidate(w, r, kb) {
 			return
 		}
 
@@ -118,11 +135,11 @@ func depositHandlerFn(cdc *wire.Codec, ctx context.CoreContext) http.HandlerFunc
 		}
 
 		// sign
-		signAndBuild(w, ctx, req.BaseReq, msg, cdc)
+		signAndBuild(w, r, ctx, req.BaseReq, msg, cdc)
 	}
 }
 
-func voteHandlerFn(cdc *wire.Codec, ctx context.CoreContext) http.HandlerFunc {
+func voteHandlerFn(cdc *wire.Codec, kb keys.Keybase, ctx context.CoreContext) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		vars := mux.Vars(r)
 		strProposalID := vars[RestProposalID]
@@ -146,7 +163,7 @@ func voteHandlerFn(cdc *wire.Codec, ctx context.CoreContext) http.HandlerFunc {
 		if err != nil {
 			return
 		}
-		if !req.BaseReq.baseReqValidate(w) {
+		if !req.BaseReq.baseReqValidate(w, r, kb) {
 			return
 		}
 
@@ -159,7 +176,7 @@ func voteHandlerFn(cdc *wire.Codec, ctx context.CoreContext) http.HandlerFunc {
 		}
 
 		// sign
-		signAndBuild(w, ctx, req.BaseReq, msg, cdc)
+		signAndBuild(w, r, ctx, req.BaseReq, msg, cdc)
 	}
 }
 
@@ -182,10 +199,18 @@ func queryProposalHandlerFn(cdc *wire.Codec) http.HandlerFunc {
 			return
 		}
 
-		ctx := context.NewCoreContextFromViper()
+		ctx, ok := utils.ParseQueryHeight(w, r, context.NewCoreContextFromViper())
+		if !ok {
+			return
+		}
 
-		res, err := ctx.QueryStore(gov.KeyProposal(proposalID), storeName)
-		if err != nil || len(res) == 0 {
+		res, height, err := ctx.QueryStoreWithHeight(gov.KeyProposal(proposalID), storeName)
+		if err != nil {
+			utils.WriteQueryError(w, "couldn't query proposal", err)
+			return
+		}
+		utils.WriteQueryHeight(w, height)
+		if len(res) == 0 {
 			err := errors.Errorf("proposalID [%d] does not exist", proposalID)
 			w.Write([]byte(err.Error()))
 			return
@@ -203,6 +228,57 @@ func queryProposalHandlerFn(cdc *wire.Codec) http.HandlerFunc {
 	}
 }
 
+func queryTallyHandlerFn(cdc *wire.Codec) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+		strProposalID := vars[RestProposalID]
+
+		if len(strProposalID) == 0 {
+			w.WriteHeader(http.StatusBadRequest)
+			err := errors.New("proposalId required but not specified")
+			w.Write([]byte(err.Error()))
+			return
+		}
+
+		proposalID, err := strconv.ParseInt(strProposalID, 10, 64)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			err := errors.Errorf("proposalID [%s] is not a valid integer", strProposalID)
+			w.Write([]byte(err.Error()))
+			return
+		}
+
+		ctx := context.NewCoreContextFromViper()
+
+		params := gov.QueryTallyParams{
+			ProposalID: proposalID,
+		}
+		bz, err := cdc.MarshalJSON(params)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(err.Error()))
+			return
+		}
+
+		res, height, err := ctx.QueryWithData(fmt.Sprintf("/custom/%s/%s", storeName, gov.QueryTally), bz)
+		if err != nil {
+			utils.WriteQueryError(w, "couldn't query tally", err)
+			return
+		}
+		utils.WriteQueryHeight(w, height)
+
+		var tallyResult gov.TallyResult
+		cdc.MustUnmarshalJSON(res, &tallyResult)
+		output, err := wire.MarshalJSONIndent(cdc, tallyResult)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(err.Error()))
+			return
+		}
+		w.Write(output)
+	}
+}
+
 func queryDepositHandlerFn(cdc *wire.Codec) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		vars := mux.Vars(r)
@@ -239,11 +315,19 @@ func queryDepositHandlerFn(cdc *wire.Codec) http.HandlerFunc {
 			return
 		}
 
-		ctx := context.NewCoreContextFromViper()
+		ctx, ok := utils.ParseQueryHeight(w, r, context.NewCoreContextFromViper())
+		if !ok {
+			return
+		}
 
-		res, err := ctx.QueryStore(gov.KeyDeposit(proposalID, depositerAddr), storeName)
-		if err != nil || len(res) == 0 {
-			res, err := ctx.QueryStore(gov.KeyProposal(proposalID), storeName)
+		res, height, err := ctx.QueryStoreWithHeight(gov.KeyDeposit(proposalID, depositerAddr), storeName)
+		if err != nil {
+			utils.WriteQueryError(w, "couldn't query deposit", err)
+			return
+		}
+		utils.WriteQueryHeight(w, height)
+		if len(res) == 0 {
+			res, err := ctx.WithHeight(height).QueryStore(gov.KeyProposal(proposalID), storeName)
 			if err != nil || len(res) == 0 {
 				w.WriteHeader(http.StatusNotFound)
 				err := errors.Errorf("proposalID [%d] does not exist", proposalID)
@@ -304,12 +388,20 @@ func queryVoteHandlerFn(cdc *wire.Codec) http.HandlerFunc {
 			return
 		}
 
-		ctx := context.NewCoreContextFromViper()
+		ctx, ok := utils.ParseQueryHeight(w, r, context.NewCoreContextFromViper())
+		if !ok {
+			return
+		}
 
-		res, err := ctx.QueryStore(gov.KeyVote(proposalID, voterAddr), storeName)
-		if err != nil || len(res) == 0 {
+		res, height, err := ctx.QueryStoreWithHeight(gov.KeyVote(proposalID, voterAddr), storeName)
+		if err != nil {
+			utils.WriteQueryError(w, "couldn't query vote", err)
+			return
+		}
+		utils.WriteQueryHeight(w, height)
+		if len(res) == 0 {
 
-			res, err := ctx.QueryStore(gov.KeyProposal(proposalID), storeName)
+			res, err := ctx.WithHeight(height).QueryStore(gov.KeyProposal(proposalID), storeName)
 			if err != nil || len(res) == 0 {
 				w.WriteHeader(http.StatusNotFound)
 				err := errors.Errorf("proposalID [%d] does not exist", proposalID)
@@ -340,70 +432,161 @@ func queryProposalsWithParameterFn(cdc *wire.Codec) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		bechVoterAddr := r.URL.Query().Get(RestVoter)
 		bechDepositerAddr := r.URL.Query().Get(RestDepositer)
+		strProposalStatus := r.URL.Query().Get(RestProposalStatus)
 
-		var err error
-		var voterAddr sdk.AccAddress
-		var depositerAddr sdk.AccAddress
+		pagination, ok := utils.ParsePaginationParams(w, r)
+		if !ok {
+			return
+		}
+		params := gov.QueryProposalsParams{
+			Pagination: pagination,
+		}
 
 		if len(bechVoterAddr) != 0 {
-			voterAddr, err = sdk.AccAddressFromBech32(bechVoterAddr)
+			voterAddr, err := sdk.AccAddressFromBech32(bechVoterAddr)
 			if err != nil {
 				w.WriteHeader(http.StatusBadRequest)
 				err := errors.Errorf("'%s' needs to be bech32 encoded", RestVoter)
 				w.Write([]byte(err.Error()))
 				return
 			}
+			params.Voter = voterAddr
 		}
 
 		if len(bechDepositerAddr) != 0 {
-			depositerAddr, err = sdk.AccAddressFromBech32(bechDepositerAddr)
+			depositerAddr, err := sdk.AccAddressFromBech32(bechDepositerAddr)
 			if err != nil {
 				w.WriteHeader(http.StatusBadRequest)
 				err := errors.Errorf("'%s' needs to be bech32 encoded", RestDepositer)
 				w.Write([]byte(err.Error()))
 				return
 			}
+			params.Depositer = depositerAddr
 		}
 
-		ctx := context.NewCoreContextFromViper()
+		proposalStatus, err := gov.ProposalStatusFromString(strProposalStatus)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(err.Error()))
+			return
+		}
+		params.ProposalStatus = proposalStatus
 
-		res, err := ctx.QueryStore(gov.KeyNextProposalID, storeName)
+		ctx, ok := utils.ParseQueryHeight(w, r, context.NewCoreContextFromViper())
+		if !ok {
+			return
+		}
+
+		bz, err := cdc.MarshalJSON(params)
 		if err != nil {
-			err = errors.New("no proposals exist yet and proposalID has not been set")
+			w.WriteHeader(http.StatusBadRequest)
 			w.Write([]byte(err.Error()))
 			return
 		}
-		var maxProposalID int64
-		cdc.MustUnmarshalBinary(res, &maxProposalID)
 
-		matchingProposals := []gov.Proposal{}
+		res, height, err := ctx.QueryWithData(fmt.Sprintf("/custom/%s/%s", storeName, gov.QueryProposals), bz)
+		if err != nil {
+			utils.WriteQueryError(w, "couldn't query proposals", err)
+			return
+		}
+		utils.WriteQueryHeight(w, height)
 
-		for proposalID := int64(0); proposalID < maxProposalID; proposalID++ {
-			if voterAddr != nil {
-				res, err = ctx.QueryStore(gov.KeyVote(proposalID, voterAddr), storeName)
-				if err != nil || len(res) == 0 {
-					continue
-				}
-			}
+		var matchingProposals []gov.Proposal
+		cdc.MustUnmarshalJSON(res, &matchingProposals)
+		output, err := wire.MarshalJSONIndent(cdc, matchingProposals)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(err.Error()))
+			return
+		}
+		w.Write(output)
+	}
+}
 
-			if depositerAddr != nil {
-				res, err = ctx.QueryStore(gov.KeyDeposit(proposalID, depositerAddr), storeName)
-				if err != nil || len(res) == 0 {
-					continue
-				}
-			}
+// queryProposalRecordsHandlerFn lists a page of the deposits or the votes on a
+// proposal, as selected by the endpoint
+func queryProposalRecordsHandlerFn(cdc *wire.Codec, endpoint string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+		strProposalID := vars[RestProposalID]
 
-			res, err = ctx.QueryStore(gov.KeyProposal(proposalID), storeName)
-			if err != nil || len(res) == 0 {
-				continue
-			}
-			var proposal gov.Proposal
-			cdc.MustUnmarshalBinary(res, &proposal)
+		if len(strProposalID) == 0 {
+			w.WriteHeader(http.StatusBadRequest)
+			err := errors.New("proposalId required but not specified")
+			w.Write([]byte(err.Error()))
+			return
+		}
 
-			matchingProposals = append(matchingProposals, proposal)
+		proposalID, err := strconv.ParseInt(strProposalID, 10, 64)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			err := errors.Errorf("proposalID [%s] is not a valid integer", strProposalID)
+			w.Write([]byte(err.Error()))
+			return
 		}
 
-		output, err := wire.MarshalJSONIndent(cdc, matchingProposals)
+		pagination, ok := utils.ParsePaginationParams(w, r)
+		if !ok {
+			return
+		}
+
+		ctx, ok := utils.ParseQueryHeight(w, r, context.NewCoreContextFromViper())
+		if !ok {
+			return
+		}
+
+		params := gov.QueryProposalRecordsParams{
+			ProposalID: proposalID,
+			Pagination: pagination,
+		}
+		bz, err := cdc.MarshalJSON(params)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(err.Error()))
+			return
+		}
+
+		res, height, err := ctx.QueryWithData(fmt.Sprintf("/custom/%s/%s", storeName, endpoint), bz)
+		if err != nil {
+			utils.WriteQueryError(w, fmt.Sprintf("couldn't query %s", endpoint), err)
+			return
+		}
+		utils.WriteQueryHeight(w, height)
+
+		var records interface{}
+		if endpoint == gov.QueryVotes {
+			records = &[]gov.Vote{}
+		} else {
+			records = &[]gov.Deposit{}
+		}
+		cdc.MustUnmarshalJSON(res, records)
+		output, err := wire.MarshalJSONIndent(cdc, records)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(err.Error()))
+			return
+		}
+		w.Write(output)
+	}
+}
+
+func queryParamsHandlerFn(cdc *wire.Codec) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx, ok := utils.ParseQueryHeight(w, r, context.NewCoreContextFromViper())
+		if !ok {
+			return
+		}
+
+		res, height, err := ctx.QueryWithData(fmt.Sprintf("/custom/%s/%s", storeName, gov.QueryParams), nil)
+		if err != nil {
+			utils.WriteQueryError(w, "couldn't query params", err)
+			return
+		}
+		utils.WriteQueryHeight(w, height)
+
+		var params gov.Params
+		cdc.MustUnmarshalJSON(res, &params)
+		output, err := wire.MarshalJSONIndent(cdc, params)
 		if err != nil {
 			w.WriteHeader(http.StatusBadRequest)
 			w.Write([]byte(err.Error()))
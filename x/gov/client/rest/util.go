@@ -5,6 +5,8 @@ import (
 	"net/http"
 
 	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/utils"
+	"github.com/cosmos/cosmos-sdk/crypto/keys"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/wire"
 	"github.com/pkg/errors"
@@ -33,15 +35,23 @@ func buildReq(w http.ResponseWriter, r *http.Request, cdc *wire.Codec, req inter
 	return nil
 }
 
-func (req baseReq) baseReqValidate(w http.ResponseWriter) bool {
-	if len(req.Name) == 0 {
-		writeErr(&w, http.StatusUnauthorized, "Name required but not specified")
-		return false
-	}
-
-	if len(req.Password) == 0 {
-		writeErr(&w, http.StatusUnauthorized, "Password required but not specified")
-		return false
+func (req baseReq) baseReqValidate(w http.ResponseWriter, r *http.Request, kb keys.Keybase) bool {
+	// the key isn't needed to only generate the tx
+	if !utils.HasGenerateOnlyArg(r) {
+		if kb == nil {
+			writeErr(&w, http.StatusBadRequest, utils.ErrKeybaseDisabled.Error())
+			return false
+		}
+
+		if len(req.Name) == 0 {
+			writeErr(&w, http.StatusUnauthorized, "Name required but not specified")
+			return false
+		}
+
+		if len(req.Password) == 0 {
+			writeErr(&w, http.StatusUnauthorized, "Password required but not specified")
+			return false
+		}
 	}
 
 	if len(req.ChainID) == 0 {
@@ -68,7 +78,7 @@ func writeErr(w *http.ResponseWriter, status int, msg string) {
 }
 
 // TODO: Build this function out into a more generic base-request (probably should live in client/lcd)
-func signAndBuild(w http.ResponseWriter, ctx context.CoreContext, baseReq baseReq, msg sdk.Msg, cdc *wire.Codec) {
+func signAndBuild(w http.ResponseWriter, r *http.Request, ctx context.CoreContext, baseReq baseReq, msg sdk.Msg, cdc *wire.Codec) {
 	ctx = ctx.WithAccountNumber(baseReq.AccountNumber)
 	ctx = ctx.WithSequence(baseReq.Sequence)
 	ctx = ctx.WithChainID(baseReq.ChainID)
@@ -76,6 +86,11 @@ func signAndBuild(w http.ResponseWriter, ctx context.CoreContext, baseReq baseRe
 	// add gas to context
 	ctx = ctx.WithGas(baseReq.Gas)
 
+	if utils.HasGenerateOnlyArg(r) {
+		utils.WriteGenerateOnlyResponse(w, ctx, cdc, []sdk.Msg{msg})
+		return
+	}
+
 	txBytes, err := ctx.SignAndBuild(baseReq.Name, baseReq.Password, []sdk.Msg{msg}, cdc)
 	if err != nil {
 		writeErr(&w, http.StatusUnauthorized, err.Error())
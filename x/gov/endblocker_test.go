@@ -7,6 +7,10 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto"
+
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	"github.com/cosmos/cosmos-sdk/x/stake"
 )
 
 func TestTickExpiredDepositPeriod(t *testing.T) {
@@ -166,3 +170,143 @@ func TestTickPassedVotingPeriod(t *testing.T) {
 	depositsIterator.Close()
 	require.Equal(t, StatusRejected, keeper.GetProposal(ctx, proposalID).GetStatus())
 }
+
+func TestTickPassedDenomMetadataProposal(t *testing.T) {
+	mapp, keeper, sk, addrs, _, _ := getMockApp(t, 10)
+	SortAddresses(addrs)
+	mapp.BeginBlock(abci.RequestBeginBlock{})
+	ctx := mapp.BaseApp.NewContext(false, abci.Header{})
+	govHandler := NewHandler(keeper)
+	stakeHandler := stake.NewHandler(sk)
+
+	dummyDescription := stake.NewDescription("T", "E", "S", "T")
+	res := stakeHandler(ctx, stake.NewMsgCreateValidator(addrs[0], crypto.GenPrivKeyEd25519().PubKey(), sdk.NewCoin("steak", 5), dummyDescription))
+	require.True(t, res.IsOK())
+	res = stakeHandler(ctx, stake.NewMsgCreateValidator(addrs[1], crypto.GenPrivKeyEd25519().PubKey(), sdk.NewCoin("steak", 5), dummyDescription))
+	require.True(t, res.IsOK())
+
+	metadata := bank.NewMetadata("usteak", "steak", 6, "")
+	newProposalMsg := NewMsgSubmitDenomMetadataProposal("Test", "test", metadata, addrs[0], sdk.Coins{sdk.NewCoin("steak", 10)})
+	res = govHandler(ctx, newProposalMsg)
+	require.True(t, res.IsOK())
+	var proposalID int64
+	keeper.cdc.UnmarshalBinaryBare(res.Data, &proposalID)
+
+	proposal, ok := keeper.GetProposal(ctx, proposalID).(*DenomMetadataProposal)
+	require.True(t, ok)
+	require.Equal(t, ProposalTypeDenomMetadata, proposal.GetProposalType())
+	require.Equal(t, metadata, proposal.Metadata)
+	require.Equal(t, StatusVotingPeriod, proposal.GetStatus())
+
+	require.Nil(t, keeper.AddVote(ctx, proposalID, addrs[0], OptionYes))
+	require.Nil(t, keeper.AddVote(ctx, proposalID, addrs[1], OptionYes))
+
+	// the metadata is only registered once the proposal passes
+	EndBlocker(ctx, keeper)
+	_, found := keeper.ck.GetDenomMetadata(ctx, "usteak")
+	require.False(t, found)
+
+	ctx = ctx.WithBlockHeight(215)
+	require.True(t, shouldPopActiveProposalQueue(ctx, keeper))
+	EndBlocker(ctx, keeper)
+
+	require.Equal(t, StatusPassed, keeper.GetProposal(ctx, proposalID).GetStatus())
+	registered, found := keeper.ck.GetDenomMetadata(ctx, "usteak")
+	require.True(t, found)
+	require.Equal(t, metadata, registered)
+}
+
+func TestTickPassedParameterChangeProposal(t *testing.T) {
+	mapp, keeper, sk, addrs, _, _ := getMockApp(t, 10)
+	SortAddresses(addrs)
+	mapp.BeginBlock(abci.RequestBeginBlock{})
+	ctx := mapp.BaseApp.NewContext(false, abci.Header{})
+	govHandler := NewHandler(keeper)
+	stakeHandler := stake.NewHandler(sk)
+
+	dummyDescription := stake.NewDescription("T", "E", "S", "T")
+	res := stakeHandler(ctx, stake.NewMsgCreateValidator(addrs[0], crypto.GenPrivKeyEd25519().PubKey(), sdk.NewCoin("steak", 5), dummyDescription))
+	require.True(t, res.IsOK())
+
+	keeper.ck.SetSendEnabled(ctx, bank.NewSendEnabled("steak", false))
+
+	sendEnabled := []bank.SendEnabled{bank.NewSendEnabled("steak", true)}
+	newProposalMsg := NewMsgSubmitParameterChangeProposal("Test", "test", sendEnabled, addrs[0], sdk.Coins{sdk.NewCoin("steak", 10)})
+	res = govHandler(ctx, newProposalMsg)
+	require.True(t, res.IsOK())
+	var proposalID int64
+	keeper.cdc.UnmarshalBinaryBare(res.Data, &proposalID)
+
+	proposal, ok := keeper.GetProposal(ctx, proposalID).(*ParameterChangeProposal)
+	require.True(t, ok)
+	require.Equal(t, ProposalTypeParameterChange, proposal.GetProposalType())
+	require.Equal(t, sendEnabled, proposal.SendEnabled)
+
+	require.Nil(t, keeper.AddVote(ctx, proposalID, addrs[0], OptionYes))
+
+	EndBlocker(ctx, keeper)
+	require.False(t, keeper.ck.GetSendEnabled(ctx, "steak"))
+
+	ctx = ctx.WithBlockHeight(215)
+	EndBlocker(ctx, keeper)
+
+	require.Equal(t, StatusPassed, keeper.GetProposal(ctx, proposalID).GetStatus())
+	require.True(t, keeper.ck.GetSendEnabled(ctx, "steak"))
+}
+
+func TestTickTallyResultAndVotePruning(t *testing.T) {
+	mapp, keeper, sk, addrs, _, _ := getMockApp(t, 10)
+	SortAddresses(addrs)
+	mapp.BeginBlock(abci.RequestBeginBlock{})
+	ctx := mapp.BaseApp.NewContext(false, abci.Header{})
+	govHandler := NewHandler(keeper)
+	stakeHandler := stake.NewHandler(sk)
+
+	dummyDescription := stake.NewDescription("T", "E", "S", "T")
+	val1CreateMsg := stake.NewMsgCreateValidator(addrs[0], crypto.GenPrivKeyEd25519().PubKey(), sdk.NewCoin("steak", 5), dummyDescription)
+	res := stakeHandler(ctx, val1CreateMsg)
+	require.True(t, res.IsOK())
+	val2CreateMsg := stake.NewMsgCreateValidator(addrs[1], crypto.GenPrivKeyEd25519().PubKey(), sdk.NewCoin("steak", 5), dummyDescription)
+	res = stakeHandler(ctx, val2CreateMsg)
+	require.True(t, res.IsOK())
+
+	newProposalMsg := NewMsgSubmitProposal("Test", "test", ProposalTypeText, addrs[0], sdk.Coins{sdk.NewCoin("steak", 10)})
+	res = govHandler(ctx, newProposalMsg)
+	require.True(t, res.IsOK())
+	var proposalID int64
+	keeper.cdc.UnmarshalBinaryBare(res.Data, &proposalID)
+	require.Equal(t, StatusVotingPeriod, keeper.GetProposal(ctx, proposalID).GetStatus())
+
+	res = govHandler(ctx, NewMsgVote(addrs[0], proposalID, OptionYes))
+	require.True(t, res.IsOK())
+	res = govHandler(ctx, NewMsgVote(addrs[1], proposalID, OptionYes))
+	require.True(t, res.IsOK())
+
+	// the tally result is stored when the voting period ends
+	ctx = ctx.WithBlockHeight(200)
+	EndBlocker(ctx, keeper)
+
+	proposal := keeper.GetProposal(ctx, proposalID)
+	require.Equal(t, StatusPassed, proposal.GetStatus())
+	require.True(t, proposal.GetTallyResult().Yes.Equal(sdk.NewDec(10)))
+	require.True(t, proposal.GetTallyResult().No.IsZero())
+	require.Equal(t, proposalID, keeper.VotePruningQueuePeek(ctx).GetProposalID())
+
+	// the votes are kept during the vote history period
+	ctx = ctx.WithBlockHeight(299)
+	require.False(t, shouldPopVotePruningQueue(ctx, keeper))
+	EndBlocker(ctx, keeper)
+	votesIterator := keeper.GetVotes(ctx, proposalID)
+	require.True(t, votesIterator.Valid())
+	votesIterator.Close()
+
+	// and deleted once it is over, the tally result stays
+	ctx = ctx.WithBlockHeight(300)
+	require.True(t, shouldPopVotePruningQueue(ctx, keeper))
+	EndBlocker(ctx, keeper)
+	require.Nil(t, keeper.VotePruningQueuePeek(ctx))
+	votesIterator = keeper.GetVotes(ctx, proposalID)
+	require.False(t, votesIterator.Valid())
+	votesIterator.Close()
+	require.True(t, keeper.GetProposal(ctx, proposalID).GetTallyResult().Yes.Equal(sdk.NewDec(10)))
+}
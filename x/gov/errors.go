@@ -21,8 +21,24 @@ const (
 	CodeInvalidVote             sdk.CodeType = 9
 	CodeInvalidGenesis          sdk.CodeType = 10
 	CodeInvalidProposalStatus   sdk.CodeType = 11
+	CodeNoParamChanges          sdk.CodeType = 12
 )
 
+func init() {
+	sdk.RegisterError(DefaultCodespace, CodeUnknownProposal, "unknown proposal")
+	sdk.RegisterError(DefaultCodespace, CodeInactiveProposal, "inactive proposal")
+	sdk.RegisterError(DefaultCodespace, CodeAlreadyActiveProposal, "proposal already in its voting period")
+	sdk.RegisterError(DefaultCodespace, CodeAlreadyFinishedProposal, "proposal already finished its voting period")
+	sdk.RegisterError(DefaultCodespace, CodeAddressNotStaked, "address is not staked")
+	sdk.RegisterError(DefaultCodespace, CodeInvalidTitle, "invalid proposal title")
+	sdk.RegisterError(DefaultCodespace, CodeInvalidDescription, "invalid proposal description")
+	sdk.RegisterError(DefaultCodespace, CodeInvalidProposalType, "invalid proposal type")
+	sdk.RegisterError(DefaultCodespace, CodeInvalidVote, "invalid vote option")
+	sdk.RegisterError(DefaultCodespace, CodeInvalidGenesis, "invalid genesis state")
+	sdk.RegisterError(DefaultCodespace, CodeInvalidProposalStatus, "invalid proposal status")
+	sdk.RegisterError(DefaultCodespace, CodeNoParamChanges, "parameter change proposal doesn't change any parameter")
+}
+
 //----------------------------------------
 // Error constructors
 
@@ -65,3 +81,7 @@ func ErrInvalidVote(codespace sdk.CodespaceType, voteOption VoteOption) sdk.Erro
 func ErrInvalidGenesis(codespace sdk.CodespaceType, msg string) sdk.Error {
 	return sdk.NewError(codespace, CodeInvalidVote, msg)
 }
+
+func ErrNoParamChanges(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeNoParamChanges, "Parameter change proposal doesn't change any parameter")
+}
@@ -0,0 +1,22 @@
+// nolint
+package gov
+
+// Events emitted by the gov module. Proposal ids are formatted as decimal
+// strings.
+const (
+	EventTypeSubmitProposal    = "submit_proposal"
+	EventTypeProposalDeposit   = "proposal_deposit"
+	EventTypeProposalVote      = "proposal_vote"
+	EventTypeVotingPeriodStart = "voting_period_start"
+	EventTypeProposalDropped   = "proposal_dropped"
+	EventTypeProposalPassed    = "proposal_passed"
+	EventTypeProposalRejected  = "proposal_rejected"
+
+	AttributeKeyProposalID   = "proposal_id"
+	AttributeKeyProposalType = "proposal_type"
+	AttributeKeyProposer     = "proposer"
+	AttributeKeyDepositer    = "depositer"
+	AttributeKeyVoter        = "voter"
+	AttributeKeyOption       = "option"
+	AttributeKeyAmount       = "amount"
+)
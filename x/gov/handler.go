@@ -1,6 +1,9 @@
 package gov
 
 import (
+	"fmt"
+	"strconv"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -12,6 +15,10 @@ func NewHandler(keeper Keeper) sdk.Handler {
 			return handleMsgDeposit(ctx, keeper, msg)
 		case MsgSubmitProposal:
 			return handleMsgSubmitProposal(ctx, keeper, msg)
+		case MsgSubmitDenomMetadataProposal:
+			return handleMsgSubmitDenomMetadataProposal(ctx, keeper, msg)
+		case MsgSubmitParameterChangeProposal:
+			return handleMsgSubmitParameterChangeProposal(ctx, keeper, msg)
 		case MsgVote:
 			return handleMsgVote(ctx, keeper, msg)
 		default:
@@ -25,26 +32,45 @@ func handleMsgSubmitProposal(ctx sdk.Context, keeper Keeper, msg MsgSubmitPropos
 
 	proposal := keeper.NewTextProposal(ctx, msg.Title, msg.Description, msg.ProposalType)
 
-	err, votingStarted := keeper.AddDeposit(ctx, proposal.GetProposalID(), msg.Proposer, msg.InitialDeposit)
+	return submitProposal(ctx, keeper, proposal, msg.Proposer, msg.InitialDeposit)
+}
+
+func handleMsgSubmitDenomMetadataProposal(ctx sdk.Context, keeper Keeper, msg MsgSubmitDenomMetadataProposal) sdk.Result {
+
+	proposal := keeper.NewDenomMetadataProposal(ctx, msg.Title, msg.Description, msg.Metadata)
+
+	return submitProposal(ctx, keeper, proposal, msg.Proposer, msg.InitialDeposit)
+}
+
+func handleMsgSubmitParameterChangeProposal(ctx sdk.Context, keeper Keeper, msg MsgSubmitParameterChangeProposal) sdk.Result {
+
+	proposal := keeper.NewParameterChangeProposal(ctx, msg.Title, msg.Description, msg.SendEnabled)
+
+	return submitProposal(ctx, keeper, proposal, msg.Proposer, msg.InitialDeposit)
+}
+
+// adds the initial deposit to a newly created proposal
+func submitProposal(ctx sdk.Context, keeper Keeper, proposal Proposal, proposer sdk.AccAddress, initialDeposit sdk.Coins) sdk.Result {
+
+	err, votingStarted := keeper.AddDeposit(ctx, proposal.GetProposalID(), proposer, initialDeposit)
 	if err != nil {
 		return err.Result()
 	}
 
 	proposalIDBytes := keeper.cdc.MustMarshalBinaryBare(proposal.GetProposalID())
 
-	tags := sdk.NewTags(
-		"action", []byte("submitProposal"),
-		"proposer", []byte(msg.Proposer.String()),
-		"proposalId", proposalIDBytes,
-	)
-
+	ctx.EventManager().EmitEvent(sdk.NewEvent(EventTypeSubmitProposal,
+		sdk.NewAttribute(AttributeKeyProposalID, formatProposalID(proposal.GetProposalID())),
+		sdk.NewAttribute(AttributeKeyProposalType, proposal.GetProposalType().String()),
+		sdk.NewAttribute(AttributeKeyProposer, proposer.String()),
+		sdk.NewAttribute(AttributeKeyAmount, initialDeposit.String()),
+	))
 	if votingStarted {
-		tags.AppendTag("votingPeriodStart", proposalIDBytes)
+		emitVotingPeriodStart(ctx, proposal.GetProposalID())
 	}
 
 	return sdk.Result{
 		Data: proposalIDBytes,
-		Tags: tags,
 	}
 }
 
@@ -55,22 +81,16 @@ func handleMsgDeposit(ctx sdk.Context, keeper Keeper, msg MsgDeposit) sdk.Result
 		return err.Result()
 	}
 
-	proposalIDBytes := keeper.cdc.MustMarshalBinaryBare(msg.ProposalID)
-
-	// TODO: Add tag for if voting period started
-	tags := sdk.NewTags(
-		"action", []byte("deposit"),
-		"depositer", []byte(msg.Depositer.String()),
-		"proposalId", proposalIDBytes,
-	)
-
+	ctx.EventManager().EmitEvent(sdk.NewEvent(EventTypeProposalDeposit,
+		sdk.NewAttribute(AttributeKeyProposalID, formatProposalID(msg.ProposalID)),
+		sdk.NewAttribute(AttributeKeyDepositer, msg.Depositer.String()),
+		sdk.NewAttribute(AttributeKeyAmount, msg.Amount.String()),
+	))
 	if votingStarted {
-		tags.AppendTag("votingPeriodStart", proposalIDBytes)
+		emitVotingPeriodStart(ctx, msg.ProposalID)
 	}
 
-	return sdk.Result{
-		Tags: tags,
-	}
+	return sdk.Result{}
 }
 
 func handleMsgVote(ctx sdk.Context, keeper Keeper, msg MsgVote) sdk.Result {
@@ -80,60 +100,83 @@ func handleMsgVote(ctx sdk.Context, keeper Keeper, msg MsgVote) sdk.Result {
 		return err.Result()
 	}
 
-	proposalIDBytes := keeper.cdc.MustMarshalBinaryBare(msg.ProposalID)
+	ctx.EventManager().EmitEvent(sdk.NewEvent(EventTypeProposalVote,
+		sdk.NewAttribute(AttributeKeyProposalID, formatProposalID(msg.ProposalID)),
+		sdk.NewAttribute(AttributeKeyVoter, msg.Voter.String()),
+		sdk.NewAttribute(AttributeKeyOption, msg.Option.String()),
+	))
 
-	tags := sdk.NewTags(
-		"action", []byte("vote"),
-		"voter", []byte(msg.Voter.String()),
-		"proposalId", proposalIDBytes,
-	)
-	return sdk.Result{
-		Tags: tags,
-	}
+	return sdk.Result{}
 }
 
-// Called every block, process inflation, update validator set
-func EndBlocker(ctx sdk.Context, keeper Keeper) (tags sdk.Tags, nonVotingVals []sdk.AccAddress) {
+func emitVotingPeriodStart(ctx sdk.Context, proposalID int64) {
+	ctx.EventManager().EmitEvent(sdk.NewEvent(EventTypeVotingPeriodStart,
+		sdk.NewAttribute(AttributeKeyProposalID, formatProposalID(proposalID)),
+	))
+}
 
-	tags = sdk.NewTags()
+func formatProposalID(proposalID int64) string {
+	return strconv.FormatInt(proposalID, 10)
+}
+
+// Called every block, process inflation, update validator set
+func EndBlocker(ctx sdk.Context, keeper Keeper) (nonVotingVals []sdk.AccAddress) {
 
 	// Delete proposals that haven't met minDeposit
 	for shouldPopInactiveProposalQueue(ctx, keeper) {
 		inactiveProposal := keeper.InactiveProposalQueuePop(ctx)
 		if inactiveProposal.GetStatus() == StatusDepositPeriod {
-			proposalIDBytes := keeper.cdc.MustMarshalBinaryBare(inactiveProposal.GetProposalID())
 			keeper.DeleteProposal(ctx, inactiveProposal)
-			tags.AppendTag("action", []byte("proposalDropped"))
-			tags.AppendTag("proposalId", proposalIDBytes)
+			ctx.EventManager().EmitEvent(sdk.NewEvent(EventTypeProposalDropped,
+				sdk.NewAttribute(AttributeKeyProposalID, formatProposalID(inactiveProposal.GetProposalID())),
+			))
 		}
 	}
 
+	// Delete the votes of ended proposals once their history period is over
+	for shouldPopVotePruningQueue(ctx, keeper) {
+		endedProposal := keeper.VotePruningQueuePop(ctx)
+		keeper.deleteVotes(ctx, endedProposal.GetProposalID())
+	}
+
 	var passes bool
+	var tallyResults TallyResult
 
 	// Check if earliest Active Proposal ended voting period yet
 	for shouldPopActiveProposalQueue(ctx, keeper) {
 		activeProposal := keeper.ActiveProposalQueuePop(ctx)
 
 		if ctx.BlockHeight() >= activeProposal.GetVotingStartBlock()+keeper.GetVotingProcedure().VotingPeriod {
-			passes, nonVotingVals = tally(ctx, keeper, activeProposal)
-			proposalIDBytes := keeper.cdc.MustMarshalBinaryBare(activeProposal.GetProposalID())
+			passes, tallyResults, nonVotingVals = tally(ctx, keeper, activeProposal)
+			activeProposal.SetTallyResult(tallyResults)
+			proposalID := formatProposalID(activeProposal.GetProposalID())
 			if passes {
 				keeper.RefundDeposits(ctx, activeProposal.GetProposalID())
 				activeProposal.SetStatus(StatusPassed)
-				tags.AppendTag("action", []byte("proposalPassed"))
-				tags.AppendTag("proposalId", proposalIDBytes)
+				ctx.EventManager().EmitEvent(sdk.NewEvent(EventTypeProposalPassed,
+					sdk.NewAttribute(AttributeKeyProposalID, proposalID),
+				))
+
+				// the proposal passed regardless, a failure only means its change couldn't be applied
+				err := keeper.executeProposal(ctx, activeProposal)
+				if err != nil {
+					ctx.Logger().With("module", "x/gov").Error(
+						fmt.Sprintf("proposal %d passed but couldn't be executed: %s", activeProposal.GetProposalID(), err.Error()))
+				}
 			} else {
 				keeper.DeleteDeposits(ctx, activeProposal.GetProposalID())
 				activeProposal.SetStatus(StatusRejected)
-				tags.AppendTag("action", []byte("proposalRejected"))
-				tags.AppendTag("proposalId", proposalIDBytes)
+				ctx.EventManager().EmitEvent(sdk.NewEvent(EventTypeProposalRejected,
+					sdk.NewAttribute(AttributeKeyProposalID, proposalID),
+				))
 			}
 
 			keeper.SetProposal(ctx, activeProposal)
+			keeper.VotePruningQueuePush(ctx, activeProposal)
 		}
 	}
 
-	return tags, nonVotingVals
+	return nonVotingVals
 }
 func shouldPopInactiveProposalQueue(ctx sdk.Context, keeper Keeper) bool {
 	depositProcedure := keeper.GetDepositProcedure()
@@ -149,6 +192,18 @@ func shouldPopInactiveProposalQueue(ctx sdk.Context, keeper Keeper) bool {
 	return false
 }
 
+func shouldPopVotePruningQueue(ctx sdk.Context, keeper Keeper) bool {
+	votingProcedure := keeper.GetVotingProcedure()
+	peekProposal := keeper.VotePruningQueuePeek(ctx)
+
+	if peekProposal == nil {
+		return false
+	} else if ctx.BlockHeight() >= peekProposal.GetVotingStartBlock()+votingProcedure.VotingPeriod+votingProcedure.VoteHistoryPeriod {
+		return true
+	}
+	return false
+}
+
 func shouldPopActiveProposalQueue(ctx sdk.Context, keeper Keeper) bool {
 	votingProcedure := keeper.GetVotingProcedure()
 	peekProposal := keeper.ActiveProposalQueuePeek(ctx)
@@ -62,12 +62,78 @@ func (keeper Keeper) NewTextProposal(ctx sdk.Context, title string, description
 		TotalDeposit:     sdk.Coins{},
 		SubmitBlock:      ctx.BlockHeight(),
 		VotingStartBlock: -1, // TODO: Make Time
+		TallyResult:      EmptyTallyResult(),
 	}
 	keeper.SetProposal(ctx, proposal)
 	keeper.InactiveProposalQueuePush(ctx, proposal)
 	return proposal
 }
 
+// Creates a new DenomMetadataProposal
+func (keeper Keeper) NewDenomMetadataProposal(ctx sdk.Context, title string, description string, metadata bank.Metadata) Proposal {
+	proposalID, err := keeper.getNewProposalID(ctx)
+	if err != nil {
+		return nil
+	}
+	var proposal Proposal = &DenomMetadataProposal{
+		TextProposal: TextProposal{
+			ProposalID:       proposalID,
+			Title:            title,
+			Description:      description,
+			ProposalType:     ProposalTypeDenomMetadata,
+			Status:           StatusDepositPeriod,
+			TotalDeposit:     sdk.Coins{},
+			SubmitBlock:      ctx.BlockHeight(),
+			VotingStartBlock: -1, // TODO: Make Time
+			TallyResult:      EmptyTallyResult(),
+		},
+		Metadata: metadata,
+	}
+	keeper.SetProposal(ctx, proposal)
+	keeper.InactiveProposalQueuePush(ctx, proposal)
+	return proposal
+}
+
+// Creates a new ParameterChangeProposal
+func (keeper Keeper) NewParameterChangeProposal(ctx sdk.Context, title string, description string, sendEnabled []bank.SendEnabled) Proposal {
+	proposalID, err := keeper.getNewProposalID(ctx)
+	if err != nil {
+		return nil
+	}
+	var proposal Proposal = &ParameterChangeProposal{
+		TextProposal: TextProposal{
+			ProposalID:       proposalID,
+			Title:            title,
+			Description:      description,
+			ProposalType:     ProposalTypeParameterChange,
+			Status:           StatusDepositPeriod,
+			TotalDeposit:     sdk.Coins{},
+			SubmitBlock:      ctx.BlockHeight(),
+			VotingStartBlock: -1, // TODO: Make Time
+			TallyResult:      EmptyTallyResult(),
+		},
+		SendEnabled: sendEnabled,
+	}
+	keeper.SetProposal(ctx, proposal)
+	keeper.InactiveProposalQueuePush(ctx, proposal)
+	return proposal
+}
+
+// Executes the changes of a passed proposal, text proposals have none
+func (keeper Keeper) executeProposal(ctx sdk.Context, proposal Proposal) sdk.Error {
+	switch proposal := proposal.(type) {
+	case *DenomMetadataProposal:
+		return keeper.ck.SetDenomMetadata(ctx, proposal.Metadata)
+	case *ParameterChangeProposal:
+		for _, se := range proposal.SendEnabled {
+			keeper.ck.SetSendEnabled(ctx, se)
+		}
+		return nil
+	default:
+		return nil
+	}
+}
+
 // Get Proposal from store by ProposalID
 func (keeper Keeper) GetProposal(ctx sdk.Context, proposalID int64) Proposal {
 	store := ctx.KVStore(keeper.storeKey)
@@ -129,9 +195,10 @@ func (keeper Keeper) activateVotingPeriod(ctx sdk.Context, proposal Proposal) {
 // Procedures
 
 var (
-	defaultMinDeposit       int64 = 10
-	defaultMaxDepositPeriod int64 = 10000
-	defaultVotingPeriod     int64 = 10000
+	defaultMinDeposit        int64 = 10
+	defaultMaxDepositPeriod  int64 = 10000
+	defaultVotingPeriod      int64 = 10000
+	defaultVoteHistoryPeriod int64 = 10000
 )
 
 // Gets procedure from store. TODO: move to global param store and allow for updating of this
@@ -145,16 +212,17 @@ func (keeper Keeper) GetDepositProcedure() DepositProcedure {
 // Gets procedure from store. TODO: move to global param store and allow for updating of this
 func (keeper Keeper) GetVotingProcedure() VotingProcedure {
 	return VotingProcedure{
-		VotingPeriod: defaultVotingPeriod,
+		VotingPeriod:      defaultVotingPeriod,
+		VoteHistoryPeriod: defaultVoteHistoryPeriod,
 	}
 }
 
 // Gets procedure from store. TODO: move to global param store and allow for updating of this
 func (keeper Keeper) GetTallyingProcedure() TallyingProcedure {
 	return TallyingProcedure{
-		Threshold:         sdk.NewRat(1, 2),
-		Veto:              sdk.NewRat(1, 3),
-		GovernancePenalty: sdk.NewRat(1, 100),
+		Threshold:         sdk.NewDecWithPrec(5, 1),
+		Veto:              sdk.NewDec(1).Quo(sdk.NewDec(3)),
+		GovernancePenalty: sdk.NewDecWithPrec(1, 2),
 	}
 }
 
@@ -209,9 +277,16 @@ func (keeper Keeper) GetVotes(ctx sdk.Context, proposalID int64) sdk.Iterator {
 	return sdk.KVStorePrefixIterator(store, KeyVotesSubspace(proposalID))
 }
 
-func (keeper Keeper) deleteVote(ctx sdk.Context, proposalID int64, voterAddr sdk.AccAddress) {
+// Deletes all the votes on a specific proposal
+func (keeper Keeper) deleteVotes(ctx sdk.Context, proposalID int64) {
 	store := ctx.KVStore(keeper.storeKey)
-	store.Delete(KeyVote(proposalID, voterAddr))
+	votesIterator := keeper.GetVotes(ctx, proposalID)
+
+	for ; votesIterator.Valid(); votesIterator.Next() {
+		store.Delete(votesIterator.Key())
+	}
+
+	votesIterator.Close()
 }
 
 // =====================================================
@@ -250,7 +325,7 @@ func (keeper Keeper) AddDeposit(ctx sdk.Context, proposalID int64, depositerAddr
 	}
 
 	// Subtract coins from depositer's account
-	_, _, err := keeper.ck.SubtractCoins(ctx, depositerAddr, depositAmount)
+	_, err := keeper.ck.SubtractCoins(ctx, depositerAddr, depositAmount)
 	if err != nil {
 		return err, false
 	}
@@ -295,7 +370,7 @@ func (keeper Keeper) RefundDeposits(ctx sdk.Context, proposalID int64) {
 		deposit := &Deposit{}
 		keeper.cdc.MustUnmarshalBinary(depositsIterator.Value(), deposit)
 
-		_, _, err := keeper.ck.AddCoins(ctx, deposit.Depositer, deposit.Amount)
+		_, err := keeper.ck.AddCoins(ctx, deposit.Depositer, deposit.Amount)
 		if err != nil {
 			panic("should not happen")
 		}
@@ -306,12 +381,23 @@ func (keeper Keeper) RefundDeposits(ctx sdk.Context, proposalID int64) {
 	depositsIterator.Close()
 }
 
-// Deletes all the deposits on a specific proposal without refunding them
+// Deletes all the deposits on a specific proposal without refunding them, the
+// deposited coins are burned
 func (keeper Keeper) DeleteDeposits(ctx sdk.Context, proposalID int64) {
 	store := ctx.KVStore(keeper.storeKey)
 	depositsIterator := keeper.GetDeposits(ctx, proposalID)
 
 	for ; depositsIterator.Valid(); depositsIterator.Next() {
+		deposit := &Deposit{}
+		keeper.cdc.MustUnmarshalBinary(depositsIterator.Value(), deposit)
+
+		err := keeper.ck.DeflateSupply(ctx, deposit.Amount)
+		if err != nil {
+			// the supply doesn't hold the deposit, the deposit is still
+			// burned rather than halting the chain
+			ctx.Logger().With("module", "x/gov").Error(err.Error())
+		}
+
 		store.Delete(depositsIterator.Key())
 	}
 
@@ -411,3 +497,49 @@ func (keeper Keeper) InactiveProposalQueuePush(ctx sdk.Context, proposal Proposa
 	proposalQueue := append(keeper.getInactiveProposalQueue(ctx), proposal.GetProposalID())
 	keeper.setInactiveProposalQueue(ctx, proposalQueue)
 }
+
+func (keeper Keeper) getVotePruningQueue(ctx sdk.Context) ProposalQueue {
+	store := ctx.KVStore(keeper.storeKey)
+	bz := store.Get(KeyVotePruningQueue)
+	if bz == nil {
+		return nil
+	}
+
+	var proposalQueue ProposalQueue
+	keeper.cdc.MustUnmarshalBinary(bz, &proposalQueue)
+
+	return proposalQueue
+}
+
+func (keeper Keeper) setVotePruningQueue(ctx sdk.Context, proposalQueue ProposalQueue) {
+	store := ctx.KVStore(keeper.storeKey)
+	bz := keeper.cdc.MustMarshalBinary(proposalQueue)
+	store.Set(KeyVotePruningQueue, bz)
+}
+
+// Return the Proposal at the front of the vote pruning queue, the queue holds
+// the ended proposals whose votes are still kept
+func (keeper Keeper) VotePruningQueuePeek(ctx sdk.Context) Proposal {
+	proposalQueue := keeper.getVotePruningQueue(ctx)
+	if len(proposalQueue) == 0 {
+		return nil
+	}
+	return keeper.GetProposal(ctx, proposalQueue[0])
+}
+
+// Remove and return a Proposal from the front of the vote pruning queue
+func (keeper Keeper) VotePruningQueuePop(ctx sdk.Context) Proposal {
+	proposalQueue := keeper.getVotePruningQueue(ctx)
+	if len(proposalQueue) == 0 {
+		return nil
+	}
+	frontElement, proposalQueue := proposalQueue[0], proposalQueue[1:]
+	keeper.setVotePruningQueue(ctx, proposalQueue)
+	return keeper.GetProposal(ctx, frontElement)
+}
+
+// Add a proposalID to the back of the vote pruning queue
+func (keeper Keeper) VotePruningQueuePush(ctx sdk.Context, proposal Proposal) {
+	proposalQueue := append(keeper.getVotePruningQueue(ctx), proposal.GetProposalID())
+	keeper.setVotePruningQueue(ctx, proposalQueue)
+}
@@ -15,6 +15,7 @@ func init() {
 	defaultMinDeposit = 10
 	defaultMaxDepositPeriod = 200
 	defaultVotingPeriod = 200
+	defaultVoteHistoryPeriod = 100
 }
 
 func TestGetSetProposal(t *testing.T) {
@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank"
 )
 
 // name to idetify transaction types
@@ -40,7 +41,8 @@ func (msg MsgSubmitProposal) ValidateBasic() sdk.Error {
 	if len(msg.Description) == 0 {
 		return ErrInvalidDescription(DefaultCodespace, msg.Description) // TODO: Proper Error
 	}
-	if !validProposalType(msg.ProposalType) {
+	// denom metadata proposals are submitted with MsgSubmitDenomMetadataProposal
+	if !validProposalType(msg.ProposalType) || msg.ProposalType == ProposalTypeDenomMetadata {
 		return ErrInvalidProposalType(DefaultCodespace, msg.ProposalType)
 	}
 	if len(msg.Proposer) == 0 {
@@ -78,6 +80,125 @@ func (msg MsgSubmitProposal) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{msg.Proposer}
 }
 
+//-----------------------------------------------------------
+// MsgSubmitDenomMetadataProposal
+type MsgSubmitDenomMetadataProposal struct {
+	Title          string         //  Title of the proposal
+	Description    string         //  Description of the proposal
+	Metadata       bank.Metadata  //  Metadata to register for its base denom if the proposal passes
+	Proposer       sdk.AccAddress //  Address of the proposer
+	InitialDeposit sdk.Coins      //  Initial deposit paid by sender. Must be strictly positive.
+}
+
+func NewMsgSubmitDenomMetadataProposal(title string, description string, metadata bank.Metadata, proposer sdk.AccAddress, initialDeposit sdk.Coins) MsgSubmitDenomMetadataProposal {
+	return MsgSubmitDenomMetadataProposal{
+		Title:          title,
+		Description:    description,
+		Metadata:       metadata,
+		Proposer:       proposer,
+		InitialDeposit: initialDeposit,
+	}
+}
+
+// Implements Msg.
+func (msg MsgSubmitDenomMetadataProposal) Type() string { return MsgType }
+
+// Implements Msg.
+func (msg MsgSubmitDenomMetadataProposal) ValidateBasic() sdk.Error {
+	err := NewMsgSubmitProposal(msg.Title, msg.Description, ProposalTypeText, msg.Proposer, msg.InitialDeposit).ValidateBasic()
+	if err != nil {
+		return err
+	}
+	return msg.Metadata.ValidateBasic()
+}
+
+func (msg MsgSubmitDenomMetadataProposal) String() string {
+	return fmt.Sprintf("MsgSubmitDenomMetadataProposal{%s, %s, %v, %v}", msg.Title, msg.Description, msg.Metadata, msg.InitialDeposit)
+}
+
+// Implements Msg.
+func (msg MsgSubmitDenomMetadataProposal) Get(key interface{}) (value interface{}) {
+	return nil
+}
+
+// Implements Msg.
+func (msg MsgSubmitDenomMetadataProposal) GetSignBytes() []byte {
+	b, err := msgCdc.MarshalJSON(msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+// Implements Msg.
+func (msg MsgSubmitDenomMetadataProposal) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Proposer}
+}
+
+//-----------------------------------------------------------
+// MsgSubmitParameterChangeProposal
+type MsgSubmitParameterChangeProposal struct {
+	Title          string             //  Title of the proposal
+	Description    string             //  Description of the proposal
+	SendEnabled    []bank.SendEnabled //  Send-enabled flags of denoms to set in the bank if the proposal passes
+	Proposer       sdk.AccAddress     //  Address of the proposer
+	InitialDeposit sdk.Coins          //  Initial deposit paid by sender. Must be strictly positive.
+}
+
+func NewMsgSubmitParameterChangeProposal(title string, description string, sendEnabled []bank.SendEnabled, proposer sdk.AccAddress, initialDeposit sdk.Coins) MsgSubmitParameterChangeProposal {
+	return MsgSubmitParameterChangeProposal{
+		Title:          title,
+		Description:    description,
+		SendEnabled:    sendEnabled,
+		Proposer:       proposer,
+		InitialDeposit: initialDeposit,
+	}
+}
+
+// Implements Msg.
+func (msg MsgSubmitParameterChangeProposal) Type() string { return MsgType }
+
+// Implements Msg.
+func (msg MsgSubmitParameterChangeProposal) ValidateBasic() sdk.Error {
+	err := NewMsgSubmitProposal(msg.Title, msg.Description, ProposalTypeParameterChange, msg.Proposer, msg.InitialDeposit).ValidateBasic()
+	if err != nil {
+		return err
+	}
+	if len(msg.SendEnabled) == 0 {
+		return ErrNoParamChanges(DefaultCodespace)
+	}
+	for _, se := range msg.SendEnabled {
+		err = se.ValidateBasic()
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (msg MsgSubmitParameterChangeProposal) String() string {
+	return fmt.Sprintf("MsgSubmitParameterChangeProposal{%s, %s, %v, %v}", msg.Title, msg.Description, msg.SendEnabled, msg.InitialDeposit)
+}
+
+// Implements Msg.
+func (msg MsgSubmitParameterChangeProposal) Get(key interface{}) (value interface{}) {
+	return nil
+}
+
+// Implements Msg.
+func (msg MsgSubmitParameterChangeProposal) GetSignBytes() []byte {
+	b, err := msgCdc.MarshalJSON(msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+// Implements Msg.
+func (msg MsgSubmitParameterChangeProposal) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Proposer}
+}
+
 //-----------------------------------------------------------
 // MsgDeposit
 type MsgDeposit struct {
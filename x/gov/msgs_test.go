@@ -6,6 +6,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank"
 	"github.com/cosmos/cosmos-sdk/x/mock"
 )
 
@@ -32,6 +33,7 @@ func TestMsgSubmitProposal(t *testing.T) {
 		{"Test Proposal", "", ProposalTypeText, addrs[0], coinsPos, false},
 		{"Test Proposal", "the purpose of this proposal is to test", ProposalTypeParameterChange, addrs[0], coinsPos, true},
 		{"Test Proposal", "the purpose of this proposal is to test", ProposalTypeSoftwareUpgrade, addrs[0], coinsPos, true},
+		{"Test Proposal", "the purpose of this proposal is to test", ProposalTypeDenomMetadata, addrs[0], coinsPos, false},
 		{"Test Proposal", "the purpose of this proposal is to test", 0x05, addrs[0], coinsPos, false},
 		{"Test Proposal", "the purpose of this proposal is to test", ProposalTypeText, sdk.AccAddress{}, coinsPos, false},
 		{"Test Proposal", "the purpose of this proposal is to test", ProposalTypeText, addrs[0], coinsZero, true},
@@ -49,6 +51,63 @@ func TestMsgSubmitProposal(t *testing.T) {
 	}
 }
 
+// test ValidateBasic for MsgSubmitDenomMetadataProposal
+func TestMsgSubmitDenomMetadataProposal(t *testing.T) {
+	_, addrs, _, _ := mock.CreateGenAccounts(1, sdk.Coins{})
+	tests := []struct {
+		title, description string
+		metadata           bank.Metadata
+		proposerAddr       sdk.AccAddress
+		initialDeposit     sdk.Coins
+		expectPass         bool
+	}{
+		{"Test Proposal", "the purpose of this proposal is to test", bank.NewMetadata("usteak", "steak", 6, ""), addrs[0], coinsPos, true},
+		{"", "the purpose of this proposal is to test", bank.NewMetadata("usteak", "steak", 6, ""), addrs[0], coinsPos, false},
+		{"Test Proposal", "the purpose of this proposal is to test", bank.NewMetadata("usteak", "steak", 19, ""), addrs[0], coinsPos, false},
+		{"Test Proposal", "the purpose of this proposal is to test", bank.NewMetadata("usteak", "", 6, ""), addrs[0], coinsPos, false},
+		{"Test Proposal", "the purpose of this proposal is to test", bank.NewMetadata("usteak", "steak", 6, ""), sdk.AccAddress{}, coinsPos, false},
+		{"Test Proposal", "the purpose of this proposal is to test", bank.NewMetadata("usteak", "steak", 6, ""), addrs[0], coinsNeg, false},
+	}
+
+	for i, tc := range tests {
+		msg := NewMsgSubmitDenomMetadataProposal(tc.title, tc.description, tc.metadata, tc.proposerAddr, tc.initialDeposit)
+		if tc.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test: %v", i)
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test: %v", i)
+		}
+	}
+}
+
+// test ValidateBasic for MsgSubmitParameterChangeProposal
+func TestMsgSubmitParameterChangeProposal(t *testing.T) {
+	_, addrs, _, _ := mock.CreateGenAccounts(1, sdk.Coins{})
+	enableSteak := []bank.SendEnabled{bank.NewSendEnabled("steak", true)}
+	tests := []struct {
+		title, description string
+		sendEnabled        []bank.SendEnabled
+		proposerAddr       sdk.AccAddress
+		initialDeposit     sdk.Coins
+		expectPass         bool
+	}{
+		{"Test Proposal", "the purpose of this proposal is to test", enableSteak, addrs[0], coinsPos, true},
+		{"", "the purpose of this proposal is to test", enableSteak, addrs[0], coinsPos, false},
+		{"Test Proposal", "the purpose of this proposal is to test", nil, addrs[0], coinsPos, false},
+		{"Test Proposal", "the purpose of this proposal is to test", []bank.SendEnabled{bank.NewSendEnabled("", true)}, addrs[0], coinsPos, false},
+		{"Test Proposal", "the purpose of this proposal is to test", enableSteak, sdk.AccAddress{}, coinsPos, false},
+		{"Test Proposal", "the purpose of this proposal is to test", enableSteak, addrs[0], coinsNeg, false},
+	}
+
+	for i, tc := range tests {
+		msg := NewMsgSubmitParameterChangeProposal(tc.title, tc.description, tc.sendEnabled, tc.proposerAddr, tc.initialDeposit)
+		if tc.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test: %v", i)
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test: %v", i)
+		}
+	}
+}
+
 // test ValidateBasic for MsgDeposit
 func TestMsgDeposit(t *testing.T) {
 	_, addrs, _, _ := mock.CreateGenAccounts(1, sdk.Coins{})
@@ -76,6 +135,63 @@ func TestMsgDeposit(t *testing.T) {
 	}
 }
 
+// test ValidateBasic for MsgSubmitDenomMetadataProposal
+func TestMsgSubmitDenomMetadataProposal(t *testing.T) {
+	_, addrs, _, _ := mock.CreateGenAccounts(1, sdk.Coins{})
+	tests := []struct {
+		title, description string
+		metadata           bank.Metadata
+		proposerAddr       sdk.AccAddress
+		initialDeposit     sdk.Coins
+		expectPass         bool
+	}{
+		{"Test Proposal", "the purpose of this proposal is to test", bank.NewMetadata("usteak", "steak", 6, ""), addrs[0], coinsPos, true},
+		{"", "the purpose of this proposal is to test", bank.NewMetadata("usteak", "steak", 6, ""), addrs[0], coinsPos, false},
+		{"Test Proposal", "the purpose of this proposal is to test", bank.NewMetadata("usteak", "steak", 19, ""), addrs[0], coinsPos, false},
+		{"Test Proposal", "the purpose of this proposal is to test", bank.NewMetadata("usteak", "", 6, ""), addrs[0], coinsPos, false},
+		{"Test Proposal", "the purpose of this proposal is to test", bank.NewMetadata("usteak", "steak", 6, ""), sdk.AccAddress{}, coinsPos, false},
+		{"Test Proposal", "the purpose of this proposal is to test", bank.NewMetadata("usteak", "steak", 6, ""), addrs[0], coinsNeg, false},
+	}
+
+	for i, tc := range tests {
+		msg := NewMsgSubmitDenomMetadataProposal(tc.title, tc.description, tc.metadata, tc.proposerAddr, tc.initialDeposit)
+		if tc.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test: %v", i)
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test: %v", i)
+		}
+	}
+}
+
+// test ValidateBasic for MsgSubmitParameterChangeProposal
+func TestMsgSubmitParameterChangeProposal(t *testing.T) {
+	_, addrs, _, _ := mock.CreateGenAccounts(1, sdk.Coins{})
+	enableSteak := []bank.SendEnabled{bank.NewSendEnabled("steak", true)}
+	tests := []struct {
+		title, description string
+		sendEnabled        []bank.SendEnabled
+		proposerAddr       sdk.AccAddress
+		initialDeposit     sdk.Coins
+		expectPass         bool
+	}{
+		{"Test Proposal", "the purpose of this proposal is to test", enableSteak, addrs[0], coinsPos, true},
+		{"", "the purpose of this proposal is to test", enableSteak, addrs[0], coinsPos, false},
+		{"Test Proposal", "the purpose of this proposal is to test", nil, addrs[0], coinsPos, false},
+		{"Test Proposal", "the purpose of this proposal is to test", []bank.SendEnabled{bank.NewSendEnabled("", true)}, addrs[0], coinsPos, false},
+		{"Test Proposal", "the purpose of this proposal is to test", enableSteak, sdk.AccAddress{}, coinsPos, false},
+		{"Test Proposal", "the purpose of this proposal is to test", enableSteak, addrs[0], coinsNeg, false},
+	}
+
+	for i, tc := range tests {
+		msg := NewMsgSubmitParameterChangeProposal(tc.title, tc.description, tc.sendEnabled, tc.proposerAddr, tc.initialDeposit)
+		if tc.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test: %v", i)
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test: %v", i)
+		}
+	}
+}
+
 // test ValidateBasic for MsgDeposit
 func TestMsgVote(t *testing.T) {
 	_, addrs, _, _ := mock.CreateGenAccounts(1, sdk.Coins{})
@@ -12,12 +12,20 @@ type DepositProcedure struct {
 
 // Procedure around Tallying votes in governance
 type TallyingProcedure struct {
-	Threshold         sdk.Rat `json:"threshold"`          //  Minimum propotion of Yes votes for proposal to pass. Initial value: 0.5
-	Veto              sdk.Rat `json:"veto"`               //  Minimum value of Veto votes to Total votes ratio for proposal to be vetoed. Initial value: 1/3
-	GovernancePenalty sdk.Rat `json:"governance_penalty"` //  Penalty if validator does not vote
+	Threshold         sdk.Dec `json:"threshold"`          //  Minimum propotion of Yes votes for proposal to pass. Initial value: 0.5
+	Veto              sdk.Dec `json:"veto"`               //  Minimum value of Veto votes to Total votes ratio for proposal to be vetoed. Initial value: 1/3
+	GovernancePenalty sdk.Dec `json:"governance_penalty"` //  Penalty if validator does not vote
 }
 
 // Procedure around Voting in governance
 type VotingProcedure struct {
-	VotingPeriod int64 `json:"voting_period"` //  Length of the voting period.
+	VotingPeriod      int64 `json:"voting_period"`       //  Length of the voting period.
+	VoteHistoryPeriod int64 `json:"vote_history_period"` //  Number of blocks the votes are kept after the end of the voting period.
+}
+
+// All the procedures of governance
+type Params struct {
+	DepositProcedure  DepositProcedure  `json:"deposit_procedure"`  //  Procedure around deposits
+	VotingProcedure   VotingProcedure   `json:"voting_procedure"`   //  Procedure around voting
+	TallyingProcedure TallyingProcedure `json:"tallying_procedure"` //  Procedure around tallying
 }
@@ -7,6 +7,7 @@ import (
 	"github.com/pkg/errors"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank"
 )
 
 //-----------------------------------------------------------
@@ -35,6 +36,9 @@ type Proposal interface {
 
 	GetVotingStartBlock() int64
 	SetVotingStartBlock(int64)
+
+	GetTallyResult() TallyResult
+	SetTallyResult(TallyResult)
 }
 
 // checks if two proposals are equal
@@ -46,7 +50,8 @@ func ProposalEqual(proposalA Proposal, proposalB Proposal) bool {
 		proposalA.GetStatus() != proposalB.GetStatus() ||
 		proposalA.GetSubmitBlock() != proposalB.GetSubmitBlock() ||
 		!(proposalA.GetTotalDeposit().IsEqual(proposalB.GetTotalDeposit())) ||
-		proposalA.GetVotingStartBlock() != proposalB.GetVotingStartBlock() {
+		proposalA.GetVotingStartBlock() != proposalB.GetVotingStartBlock() ||
+		!proposalA.GetTallyResult().Equals(proposalB.GetTallyResult()) {
 		return false
 	}
 	return true
@@ -66,6 +71,8 @@ type TextProposal struct {
 	TotalDeposit sdk.Coins `json:"total_deposit"` //  Current deposit on this proposal. Initial value is set at InitialDeposit
 
 	VotingStartBlock int64 `json:"voting_start_block"` //  Height of the block where MinDeposit was reached. -1 if MinDeposit is not reached
+
+	TallyResult TallyResult `json:"tally_result"` //  Result of the tally, set when the voting period ends
 }
 
 // Implements Proposal Interface
@@ -90,6 +97,68 @@ func (tp TextProposal) GetVotingStartBlock() int64                 { return tp.V
 func (tp *TextProposal) SetVotingStartBlock(votingStartBlock int64) {
 	tp.VotingStartBlock = votingStartBlock
 }
+func (tp TextProposal) GetTallyResult() TallyResult             { return tp.TallyResult }
+func (tp *TextProposal) SetTallyResult(tallyResult TallyResult) { tp.TallyResult = tallyResult }
+
+//-----------------------------------------------------------
+// Denom Metadata Proposals
+
+// DenomMetadataProposal sets the metadata of a denom in the bank registry when
+// it passes
+type DenomMetadataProposal struct {
+	TextProposal `json:"text_proposal"`
+	Metadata     bank.Metadata `json:"metadata"` //  Metadata registered for its base denom if the proposal passes
+}
+
+// Implements Proposal Interface
+var _ Proposal = (*DenomMetadataProposal)(nil)
+
+//-----------------------------------------------------------
+// Parameter Change Proposals
+
+// ParameterChangeProposal changes module parameters when it passes
+type ParameterChangeProposal struct {
+	TextProposal `json:"text_proposal"`
+	SendEnabled  []bank.SendEnabled `json:"send_enabled"` //  Send-enabled flags of denoms set in the bank if the proposal passes
+}
+
+// Implements Proposal Interface
+var _ Proposal = (*ParameterChangeProposal)(nil)
+
+//-----------------------------------------------------------
+// Tally Results
+
+// TallyResult is the voting power which voted for each option of a proposal
+type TallyResult struct {
+	Yes        sdk.Dec `json:"yes"`
+	Abstain    sdk.Dec `json:"abstain"`
+	No         sdk.Dec `json:"no"`
+	NoWithVeto sdk.Dec `json:"no_with_veto"`
+}
+
+// EmptyTallyResult returns a tally result without any voting power
+func EmptyTallyResult() TallyResult {
+	return TallyResult{
+		Yes:        sdk.ZeroDec(),
+		Abstain:    sdk.ZeroDec(),
+		No:         sdk.ZeroDec(),
+		NoWithVeto: sdk.ZeroDec(),
+	}
+}
+
+// checks if two tally results are equal
+func (resultA TallyResult) Equals(resultB TallyResult) bool {
+	return resultA.Yes.Equal(resultB.Yes) &&
+		resultA.Abstain.Equal(resultB.Abstain) &&
+		resultA.No.Equal(resultB.No) &&
+		resultA.NoWithVeto.Equal(resultB.NoWithVeto)
+}
+
+// Return human readable tally result
+func (resultA TallyResult) String() string {
+	return fmt.Sprintf("Yes: %s, Abstain: %s, No: %s, NoWithVeto: %s",
+		resultA.Yes, resultA.Abstain, resultA.No, resultA.NoWithVeto)
+}
 
 //-----------------------------------------------------------
 // ProposalQueue
@@ -106,6 +175,7 @@ const (
 	ProposalTypeText            ProposalKind = 0x01
 	ProposalTypeParameterChange ProposalKind = 0x02
 	ProposalTypeSoftwareUpgrade ProposalKind = 0x03
+	ProposalTypeDenomMetadata   ProposalKind = 0x04
 )
 
 // String to proposalType byte.  Returns ff if invalid.
@@ -117,6 +187,8 @@ func ProposalTypeFromString(str string) (ProposalKind, error) {
 		return ProposalTypeParameterChange, nil
 	case "SoftwareUpgrade":
 		return ProposalTypeSoftwareUpgrade, nil
+	case "DenomMetadata":
+		return ProposalTypeDenomMetadata, nil
 	default:
 		return ProposalKind(0xff), errors.Errorf("'%s' is not a valid proposal type", str)
 	}
@@ -126,7 +198,8 @@ func ProposalTypeFromString(str string) (ProposalKind, error) {
 func validProposalType(pt ProposalKind) bool {
 	if pt == ProposalTypeText ||
 		pt == ProposalTypeParameterChange ||
-		pt == ProposalTypeSoftwareUpgrade {
+		pt == ProposalTypeSoftwareUpgrade ||
+		pt == ProposalTypeDenomMetadata {
 		return true
 	}
 	return false
@@ -173,6 +246,8 @@ func (pt ProposalKind) String() string {
 		return "ParameterChange"
 	case 0x02:
 		return "SoftwareUpgrade"
+	case ProposalTypeDenomMetadata:
+		return "DenomMetadata"
 	default:
 		return ""
 	}
@@ -196,6 +271,7 @@ type ProposalStatus byte
 
 //nolint
 const (
+	StatusNil           ProposalStatus = 0x00
 	StatusDepositPeriod ProposalStatus = 0x01
 	StatusVotingPeriod  ProposalStatus = 0x02
 	StatusPassed        ProposalStatus = 0x03
@@ -213,6 +289,8 @@ func ProposalStatusFromString(str string) (ProposalStatus, error) {
 		return StatusPassed, nil
 	case "Rejected":
 		return StatusRejected, nil
+	case "":
+		return StatusNil, nil
 	default:
 		return ProposalStatus(0xff), errors.Errorf("'%s' is not a valid proposal status", str)
 	}
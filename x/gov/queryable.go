@@ -0,0 +1,235 @@
+package gov
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+// query endpoints supported by the governance Querier
+const (
+	QueryTally     = "tally"
+	QueryProposals = "proposals"
+	QueryVotes     = "votes"
+	QueryDeposits  = "deposits"
+	QueryParams    = "params"
+)
+
+// NewQuerier returns the querier of the governance module, to be registered
+// on the custom query router of the app
+func NewQuerier(keeper Keeper) sdk.Querier {
+	return func(ctx sdk.Context, path []string, req abci.RequestQuery) (res []byte, err sdk.Error) {
+		if len(path) == 0 {
+			return nil, sdk.ErrUnknownRequest("no gov query endpoint specified")
+		}
+		switch path[0] {
+		case QueryTally:
+			return queryTally(ctx, req, keeper)
+		case QueryProposals:
+			return queryProposals(ctx, req, keeper)
+		case QueryVotes:
+			return queryVotes(ctx, req, keeper)
+		case QueryDeposits:
+			return queryDeposits(ctx, req, keeper)
+		case QueryParams:
+			return queryParams(keeper)
+		default:
+			return nil, sdk.ErrUnknownRequest(fmt.Sprintf("unknown gov query endpoint %s", path[0]))
+		}
+	}
+}
+
+// QueryTallyParams are the params of the tally query
+type QueryTallyParams struct {
+	ProposalID int64 `json:"proposal_id"`
+}
+
+// queryTally returns the tally of a proposal. The tally of a proposal in its
+// voting period is computed on the current state, the one of a proposal which
+// finished its voting period is the one stored at the end of it.
+func queryTally(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) (res []byte, err sdk.Error) {
+	var params QueryTallyParams
+	errRes := keeper.cdc.UnmarshalJSON(req.Data, &params)
+	if errRes != nil {
+		return nil, sdk.ErrUnknownRequest(fmt.Sprintf("incorrectly formatted request data - %s", errRes.Error()))
+	}
+
+	proposal := keeper.GetProposal(ctx, params.ProposalID)
+	if proposal == nil {
+		return nil, ErrUnknownProposal(keeper.codespace, params.ProposalID)
+	}
+
+	var tallyResult TallyResult
+	switch proposal.GetStatus() {
+	case StatusDepositPeriod:
+		tallyResult = EmptyTallyResult()
+	case StatusVotingPeriod:
+		_, tallyResult, _ = tally(ctx, keeper, proposal)
+	default:
+		tallyResult = proposal.GetTallyResult()
+	}
+
+	bz, errRes := keeper.cdc.MarshalJSON(tallyResult)
+	if errRes != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("could not marshal the tally result - %s", errRes.Error()))
+	}
+	return bz, nil
+}
+
+// QueryProposalsParams are the params of the proposals query. The proposals
+// can be filtered by voter, depositer and status, the empty values match any
+// proposal.
+type QueryProposalsParams struct {
+	Voter          sdk.AccAddress       `json:"voter"`
+	Depositer      sdk.AccAddress       `json:"depositer"`
+	ProposalStatus ProposalStatus       `json:"proposal_status"`
+	Pagination     sdk.PaginationParams `json:"pagination"`
+}
+
+// queryProposals returns a page of the proposals matching the filters, in
+// the order of their ids
+func queryProposals(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) (res []byte, err sdk.Error) {
+	var params QueryProposalsParams
+	errRes := keeper.cdc.UnmarshalJSON(req.Data, &params)
+	if errRes != nil {
+		return nil, sdk.ErrUnknownRequest(fmt.Sprintf("incorrectly formatted request data - %s", errRes.Error()))
+	}
+	err = params.Pagination.ValidateBasic()
+	if err != nil {
+		return nil, err
+	}
+
+	store := ctx.KVStore(keeper.storeKey)
+	bz := store.Get(KeyNextProposalID)
+	var nextProposalID int64
+	if bz != nil {
+		keeper.cdc.MustUnmarshalBinary(bz, &nextProposalID)
+	}
+
+	paginator := sdk.NewPaginator(params.Pagination)
+	proposals := []Proposal{}
+	for proposalID := int64(0); proposalID < nextProposalID && !paginator.Done(); proposalID++ {
+		if len(params.Voter) != 0 {
+			if _, found := keeper.GetVote(ctx, proposalID, params.Voter); !found {
+				continue
+			}
+		}
+		if len(params.Depositer) != 0 {
+			if _, found := keeper.GetDeposit(ctx, proposalID, params.Depositer); !found {
+				continue
+			}
+		}
+
+		proposal := keeper.GetProposal(ctx, proposalID)
+		if proposal == nil {
+			continue
+		}
+		if params.ProposalStatus != StatusNil && proposal.GetStatus() != params.ProposalStatus {
+			continue
+		}
+
+		if paginator.Next() {
+			proposals = append(proposals, proposal)
+		}
+	}
+
+	bz, errRes = keeper.cdc.MarshalJSON(proposals)
+	if errRes != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("could not marshal the proposals - %s", errRes.Error()))
+	}
+	return bz, nil
+}
+
+// QueryProposalRecordsParams are the params of the queries listing the votes
+// or the deposits on a proposal
+type QueryProposalRecordsParams struct {
+	ProposalID int64                `json:"proposal_id"`
+	Pagination sdk.PaginationParams `json:"pagination"`
+}
+
+// queryVotes returns a page of the votes on a proposal, in the order of the
+// voter addresses
+func queryVotes(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) (res []byte, err sdk.Error) {
+	params, err := unmarshalProposalRecordsParams(ctx, req, keeper)
+	if err != nil {
+		return nil, err
+	}
+
+	votesIterator := keeper.GetVotes(ctx, params.ProposalID)
+	defer votesIterator.Close()
+
+	paginator := sdk.NewPaginator(params.Pagination)
+	votes := []Vote{}
+	for ; votesIterator.Valid() && !paginator.Done(); votesIterator.Next() {
+		if paginator.Next() {
+			var vote Vote
+			keeper.cdc.MustUnmarshalBinary(votesIterator.Value(), &vote)
+			votes = append(votes, vote)
+		}
+	}
+
+	bz, errRes := keeper.cdc.MarshalJSON(votes)
+	if errRes != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("could not marshal the votes - %s", errRes.Error()))
+	}
+	return bz, nil
+}
+
+// queryDeposits returns a page of the deposits on a proposal, in the order of
+// the depositer addresses
+func queryDeposits(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) (res []byte, err sdk.Error) {
+	params, err := unmarshalProposalRecordsParams(ctx, req, keeper)
+	if err != nil {
+		return nil, err
+	}
+
+	depositsIterator := keeper.GetDeposits(ctx, params.ProposalID)
+	defer depositsIterator.Close()
+
+	paginator := sdk.NewPaginator(params.Pagination)
+	deposits := []Deposit{}
+	for ; depositsIterator.Valid() && !paginator.Done(); depositsIterator.Next() {
+		if paginator.Next() {
+			var deposit Deposit
+			keeper.cdc.MustUnmarshalBinary(depositsIterator.Value(), &deposit)
+			deposits = append(deposits, deposit)
+		}
+	}
+
+	bz, errRes := keeper.cdc.MarshalJSON(deposits)
+	if errRes != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("could not marshal the deposits - %s", errRes.Error()))
+	}
+	return bz, nil
+}
+
+func unmarshalProposalRecordsParams(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) (params QueryProposalRecordsParams, err sdk.Error) {
+	errRes := keeper.cdc.UnmarshalJSON(req.Data, &params)
+	if errRes != nil {
+		return params, sdk.ErrUnknownRequest(fmt.Sprintf("incorrectly formatted request data - %s", errRes.Error()))
+	}
+	err = params.Pagination.ValidateBasic()
+	if err != nil {
+		return params, err
+	}
+	if keeper.GetProposal(ctx, params.ProposalID) == nil {
+		return params, ErrUnknownProposal(keeper.codespace, params.ProposalID)
+	}
+	return params, nil
+}
+
+// queryParams returns the deposit, voting and tallying procedures
+func queryParams(keeper Keeper) (res []byte, err sdk.Error) {
+	params := Params{
+		DepositProcedure:  keeper.GetDepositProcedure(),
+		VotingProcedure:   keeper.GetVotingProcedure(),
+		TallyingProcedure: keeper.GetTallyingProcedure(),
+	}
+
+	bz, errRes := keeper.cdc.MarshalJSON(params)
+	if errRes != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("could not marshal the params - %s", errRes.Error()))
+	}
+	return bz, nil
+}
@@ -0,0 +1,229 @@
+package gov
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto"
+
+	"github.com/cosmos/cosmos-sdk/x/stake"
+)
+
+func queryTallyResult(t *testing.T, ctx sdk.Context, keeper Keeper, proposalID int64) (TallyResult, sdk.Error) {
+	bz, errRes := keeper.cdc.MarshalJSON(QueryTallyParams{ProposalID: proposalID})
+	require.Nil(t, errRes)
+
+	querier := NewQuerier(keeper)
+	res, err := querier(ctx, []string{QueryTally}, abci.RequestQuery{Data: bz})
+	if err != nil {
+		return TallyResult{}, err
+	}
+
+	var tallyResult TallyResult
+	require.Nil(t, keeper.cdc.UnmarshalJSON(res, &tallyResult))
+	return tallyResult, nil
+}
+
+func TestQueryTally(t *testing.T) {
+	mapp, keeper, sk, addrs, _, _ := getMockApp(t, 10)
+	mapp.BeginBlock(abci.RequestBeginBlock{})
+	ctx := mapp.BaseApp.NewContext(false, abci.Header{})
+	stakeHandler := stake.NewHandler(sk)
+
+	dummyDescription := stake.NewDescription("T", "E", "S", "T")
+	val1CreateMsg := stake.NewMsgCreateValidator(addrs[0], crypto.GenPrivKeyEd25519().PubKey(), sdk.NewCoin("steak", 5), dummyDescription)
+	res := stakeHandler(ctx, val1CreateMsg)
+	require.True(t, res.IsOK())
+	val2CreateMsg := stake.NewMsgCreateValidator(addrs[1], crypto.GenPrivKeyEd25519().PubKey(), sdk.NewCoin("steak", 7), dummyDescription)
+	res = stakeHandler(ctx, val2CreateMsg)
+	require.True(t, res.IsOK())
+
+	// unknown proposal
+	_, err := queryTallyResult(t, ctx, keeper, 1234)
+	require.NotNil(t, err)
+	require.Equal(t, CodeUnknownProposal, err.Code())
+
+	// proposal in its deposit period
+	proposal := keeper.NewTextProposal(ctx, "Test", "description", ProposalTypeText)
+	proposalID := proposal.GetProposalID()
+	tallyResult, err := queryTallyResult(t, ctx, keeper, proposalID)
+	require.Nil(t, err)
+	require.True(t, tallyResult.Equals(EmptyTallyResult()))
+
+	// proposal in its voting period, tallied on the current state
+	proposal.SetStatus(StatusVotingPeriod)
+	keeper.SetProposal(ctx, proposal)
+	require.Nil(t, keeper.AddVote(ctx, proposalID, addrs[0], OptionYes))
+	require.Nil(t, keeper.AddVote(ctx, proposalID, addrs[1], OptionNo))
+
+	tallyResult, err = queryTallyResult(t, ctx, keeper, proposalID)
+	require.Nil(t, err)
+	require.True(t, tallyResult.Yes.Equal(sdk.NewDec(5)))
+	require.True(t, tallyResult.No.Equal(sdk.NewDec(7)))
+	require.True(t, tallyResult.Abstain.IsZero())
+
+	// the query doesn't modify the votes
+	votesIterator := keeper.GetVotes(ctx, proposalID)
+	require.True(t, votesIterator.Valid())
+	votesIterator.Close()
+
+	// ended proposal, the stored tally result is returned
+	stored := EmptyTallyResult()
+	stored.Yes = sdk.NewDec(42)
+	proposal = keeper.GetProposal(ctx, proposalID)
+	proposal.SetStatus(StatusPassed)
+	proposal.SetTallyResult(stored)
+	keeper.SetProposal(ctx, proposal)
+
+	tallyResult, err = queryTallyResult(t, ctx, keeper, proposalID)
+	require.Nil(t, err)
+	require.True(t, tallyResult.Equals(stored))
+}
+
+func queryProposalsPage(t *testing.T, ctx sdk.Context, keeper Keeper, params QueryProposalsParams) []Proposal {
+	bz, errRes := keeper.cdc.MarshalJSON(params)
+	require.Nil(t, errRes)
+
+	querier := NewQuerier(keeper)
+	res, err := querier(ctx, []string{QueryProposals}, abci.RequestQuery{Data: bz})
+	require.Nil(t, err)
+
+	var proposals []Proposal
+	require.Nil(t, keeper.cdc.UnmarshalJSON(res, &proposals))
+	return proposals
+}
+
+func TestQueryProposals(t *testing.T) {
+	mapp, keeper, _, addrs, _, _ := getMockApp(t, 10)
+	mapp.BeginBlock(abci.RequestBeginBlock{})
+	ctx := mapp.BaseApp.NewContext(false, abci.Header{})
+
+	var proposalIDs []int64
+	for i := 0; i < 5; i++ {
+		proposal := keeper.NewTextProposal(ctx, "Test", "description", ProposalTypeText)
+		proposalIDs = append(proposalIDs, proposal.GetProposalID())
+	}
+
+	// proposals 1 and 3 are in their voting period and voted on by addrs[0]
+	for _, i := range []int{1, 3} {
+		proposal := keeper.GetProposal(ctx, proposalIDs[i])
+		proposal.SetStatus(StatusVotingPeriod)
+		keeper.SetProposal(ctx, proposal)
+		require.Nil(t, keeper.AddVote(ctx, proposalIDs[i], addrs[0], OptionYes))
+	}
+	// proposal 4 got a deposit from addrs[1]
+	err, _ := keeper.AddDeposit(ctx, proposalIDs[4], addrs[1], sdk.Coins{sdk.NewCoin("steak", 1)})
+	require.Nil(t, err)
+
+	// all the proposals, paginated
+	proposals := queryProposalsPage(t, ctx, keeper, QueryProposalsParams{Pagination: sdk.NewPaginationParams(1, 3)})
+	require.Len(t, proposals, 3)
+	require.Equal(t, proposalIDs[0], proposals[0].GetProposalID())
+	require.Equal(t, proposalIDs[2], proposals[2].GetProposalID())
+	proposals = queryProposalsPage(t, ctx, keeper, QueryProposalsParams{Pagination: sdk.NewPaginationParams(2, 3)})
+	require.Len(t, proposals, 2)
+	require.Equal(t, proposalIDs[3], proposals[0].GetProposalID())
+
+	// filtered by status
+	proposals = queryProposalsPage(t, ctx, keeper, QueryProposalsParams{
+		ProposalStatus: StatusVotingPeriod,
+		Pagination:     sdk.NewPaginationParams(1, 0),
+	})
+	require.Len(t, proposals, 2)
+	require.Equal(t, proposalIDs[1], proposals[0].GetProposalID())
+	require.Equal(t, proposalIDs[3], proposals[1].GetProposalID())
+
+	// filtered by voter, second page
+	proposals = queryProposalsPage(t, ctx, keeper, QueryProposalsParams{
+		Voter:      addrs[0],
+		Pagination: sdk.NewPaginationParams(2, 1),
+	})
+	require.Len(t, proposals, 1)
+	require.Equal(t, proposalIDs[3], proposals[0].GetProposalID())
+
+	// filtered by depositer and status
+	proposals = queryProposalsPage(t, ctx, keeper, QueryProposalsParams{
+		Depositer:  addrs[1],
+		Pagination: sdk.NewPaginationParams(1, 0),
+	})
+	require.Len(t, proposals, 1)
+	require.Equal(t, proposalIDs[4], proposals[0].GetProposalID())
+	proposals = queryProposalsPage(t, ctx, keeper, QueryProposalsParams{
+		Depositer:      addrs[1],
+		ProposalStatus: StatusPassed,
+		Pagination:     sdk.NewPaginationParams(1, 0),
+	})
+	require.Len(t, proposals, 0)
+}
+
+func TestQueryVotesAndDeposits(t *testing.T) {
+	mapp, keeper, _, addrs, _, _ := getMockApp(t, 10)
+	SortAddresses(addrs)
+	mapp.BeginBlock(abci.RequestBeginBlock{})
+	ctx := mapp.BaseApp.NewContext(false, abci.Header{})
+	querier := NewQuerier(keeper)
+
+	proposal := keeper.NewTextProposal(ctx, "Test", "description", ProposalTypeText)
+	proposalID := proposal.GetProposalID()
+	for i := 0; i < 3; i++ {
+		err, _ := keeper.AddDeposit(ctx, proposalID, addrs[i], sdk.Coins{sdk.NewCoin("steak", 1)})
+		require.Nil(t, err)
+	}
+	proposal = keeper.GetProposal(ctx, proposalID)
+	proposal.SetStatus(StatusVotingPeriod)
+	keeper.SetProposal(ctx, proposal)
+	require.Nil(t, keeper.AddVote(ctx, proposalID, addrs[0], OptionYes))
+	require.Nil(t, keeper.AddVote(ctx, proposalID, addrs[1], OptionNo))
+
+	queryRecords := func(endpoint string, proposalID int64, page, limit int) ([]byte, sdk.Error) {
+		bz, errRes := keeper.cdc.MarshalJSON(QueryProposalRecordsParams{proposalID, sdk.NewPaginationParams(page, limit)})
+		require.Nil(t, errRes)
+		return querier(ctx, []string{endpoint}, abci.RequestQuery{Data: bz})
+	}
+
+	res, err := queryRecords(QueryDeposits, proposalID, 1, 0)
+	require.Nil(t, err)
+	var deposits []Deposit
+	require.Nil(t, keeper.cdc.UnmarshalJSON(res, &deposits))
+	require.Len(t, deposits, 3)
+
+	res, err = queryRecords(QueryDeposits, proposalID, 2, 2)
+	require.Nil(t, err)
+	require.Nil(t, keeper.cdc.UnmarshalJSON(res, &deposits))
+	require.Len(t, deposits, 1)
+
+	res, err = queryRecords(QueryVotes, proposalID, 1, 0)
+	require.Nil(t, err)
+	var votes []Vote
+	require.Nil(t, keeper.cdc.UnmarshalJSON(res, &votes))
+	require.Len(t, votes, 2)
+	for _, vote := range votes {
+		expected, found := keeper.GetVote(ctx, proposalID, vote.Voter)
+		require.True(t, found)
+		require.Equal(t, expected, vote)
+	}
+
+	// unknown proposal
+	_, err = queryRecords(QueryVotes, proposalID+1, 1, 0)
+	require.NotNil(t, err)
+	require.Equal(t, CodeUnknownProposal, err.Code())
+}
+
+func TestQueryParams(t *testing.T) {
+	mapp, keeper, _, _, _, _ := getMockApp(t, 0)
+	mapp.BeginBlock(abci.RequestBeginBlock{})
+	ctx := mapp.BaseApp.NewContext(false, abci.Header{})
+
+	res, err := NewQuerier(keeper)(ctx, []string{QueryParams}, abci.RequestQuery{})
+	require.Nil(t, err)
+
+	var params Params
+	require.Nil(t, keeper.cdc.UnmarshalJSON(res, &params))
+	require.True(t, keeper.GetDepositProcedure().MinDeposit.IsEqual(params.DepositProcedure.MinDeposit))
+	require.Equal(t, keeper.GetDepositProcedure().MaxDepositPeriod, params.DepositProcedure.MaxDepositPeriod)
+	require.Equal(t, keeper.GetVotingProcedure(), params.VotingProcedure)
+	require.True(t, keeper.GetTallyingProcedure().Threshold.Equal(params.TallyingProcedure.Threshold))
+}
@@ -7,20 +7,23 @@ import (
 // validatorGovInfo used for tallying
 type validatorGovInfo struct {
 	Address         sdk.AccAddress // sdk.AccAddress of the validator owner
-	Power           sdk.Rat        // Power of a Validator
-	DelegatorShares sdk.Rat        // Total outstanding delegator shares
-	Minus           sdk.Rat        // Minus of validator, used to compute validator's voting power
+	Power           sdk.Dec        // Power of a Validator
+	DelegatorShares sdk.Dec        // Total outstanding delegator shares
+	Minus           sdk.Dec        // Minus of validator, used to compute validator's voting power
 	Vote            VoteOption     // Vote of the validator
 }
 
-func tally(ctx sdk.Context, keeper Keeper, proposal Proposal) (passes bool, nonVoting []sdk.AccAddress) {
-	results := make(map[VoteOption]sdk.Rat)
-	results[OptionYes] = sdk.ZeroRat()
-	results[OptionAbstain] = sdk.ZeroRat()
-	results[OptionNo] = sdk.ZeroRat()
-	results[OptionNoWithVeto] = sdk.ZeroRat()
-
-	totalVotingPower := sdk.ZeroRat()
+// tally counts the votes of a proposal, with the votes of the delegators
+// overriding the vote of their validator. It doesn't modify the state, so it
+// can also be used to query the current tally of a proposal.
+func tally(ctx sdk.Context, keeper Keeper, proposal Proposal) (passes bool, tallyResults TallyResult, nonVoting []sdk.AccAddress) {
+	results := make(map[VoteOption]sdk.Dec)
+	results[OptionYes] = sdk.ZeroDec()
+	results[OptionAbstain] = sdk.ZeroDec()
+	results[OptionNo] = sdk.ZeroDec()
+	results[OptionNoWithVeto] = sdk.ZeroDec()
+
+	totalVotingPower := sdk.ZeroDec()
 	currValidators := make(map[string]validatorGovInfo)
 
 	keeper.vs.IterateValidatorsBonded(ctx, func(index int64, validator sdk.Validator) (stop bool) {
@@ -28,7 +31,7 @@ func tally(ctx sdk.Context, keeper Keeper, proposal Proposal) (passes bool, nonV
 			Address:         validator.GetOwner(),
 			Power:           validator.GetPower(),
 			DelegatorShares: validator.GetDelegatorShares(),
-			Minus:           sdk.ZeroRat(),
+			Minus:           sdk.ZeroDec(),
 			Vote:            OptionEmpty,
 		}
 		return false
@@ -48,7 +51,11 @@ func tally(ctx sdk.Context, keeper Keeper, proposal Proposal) (passes bool, nonV
 		} else {
 
 			keeper.ds.IterateDelegations(ctx, vote.Voter, func(index int64, delegation sdk.Delegation) (stop bool) {
-				val := currValidators[delegation.GetValidator().String()]
+				// delegations to validators which aren't bonded have no voting power
+				val, ok := currValidators[delegation.GetValidator().String()]
+				if !ok {
+					return false
+				}
 				val.Minus = val.Minus.Add(delegation.GetBondShares())
 				currValidators[delegation.GetValidator().String()] = val
 
@@ -61,8 +68,6 @@ func tally(ctx sdk.Context, keeper Keeper, proposal Proposal) (passes bool, nonV
 				return false
 			})
 		}
-
-		keeper.deleteVote(ctx, vote.ProposalID, vote.Voter)
 	}
 	votesIterator.Close()
 
@@ -83,18 +88,25 @@ func tally(ctx sdk.Context, keeper Keeper, proposal Proposal) (passes bool, nonV
 
 	tallyingProcedure := keeper.GetTallyingProcedure()
 
+	tallyResults = TallyResult{
+		Yes:        results[OptionYes],
+		Abstain:    results[OptionAbstain],
+		No:         results[OptionNo],
+		NoWithVeto: results[OptionNoWithVeto],
+	}
+
 	// If no one votes, proposal fails
-	if totalVotingPower.Sub(results[OptionAbstain]).Equal(sdk.ZeroRat()) {
-		return false, nonVoting
+	if totalVotingPower.Sub(results[OptionAbstain]).Equal(sdk.ZeroDec()) {
+		return false, tallyResults, nonVoting
 	}
 	// If more than 1/3 of voters veto, proposal fails
 	if results[OptionNoWithVeto].Quo(totalVotingPower).GT(tallyingProcedure.Veto) {
-		return false, nonVoting
+		return false, tallyResults, nonVoting
 	}
 	// If more than 1/2 of non-abstaining voters vote Yes, proposal passes
 	if results[OptionYes].Quo(totalVotingPower.Sub(results[OptionAbstain])).GT(tallyingProcedure.Threshold) {
-		return true, nonVoting
+		return true, tallyResults, nonVoting
 	}
 	// If more than 1/2 of non-abstaining voters vote No, proposal fails
-	return false, nonVoting
+	return false, tallyResults, nonVoting
 }
@@ -10,9 +10,13 @@ func RegisterWire(cdc *wire.Codec) {
 	cdc.RegisterConcrete(MsgSubmitProposal{}, "cosmos-sdk/MsgSubmitProposal", nil)
 	cdc.RegisterConcrete(MsgDeposit{}, "cosmos-sdk/MsgDeposit", nil)
 	cdc.RegisterConcrete(MsgVote{}, "cosmos-sdk/MsgVote", nil)
+	cdc.RegisterConcrete(MsgSubmitDenomMetadataProposal{}, "cosmos-sdk/MsgSubmitDenomMetadataProposal", nil)
+	cdc.RegisterConcrete(MsgSubmitParameterChangeProposal{}, "cosmos-sdk/MsgSubmitParameterChangeProposal", nil)
 
 	cdc.RegisterInterface((*Proposal)(nil), nil)
 	cdc.RegisterConcrete(&TextProposal{}, "gov/TextProposal", nil)
+	cdc.RegisterConcrete(&DenomMetadataProposal{}, "gov/DenomMetadataProposal", nil)
+	cdc.RegisterConcrete(&ParameterChangeProposal{}, "gov/ParameterChangeProposal", nil)
 }
 
 var msgCdc = wire.NewCodec()
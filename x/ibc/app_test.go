@@ -15,21 +15,40 @@ import (
 )
 
 // initialize the mock application for this module
-func getMockApp(t *testing.T) *mock.App {
+func getMockApp(t *testing.T) (*mock.App, bank.Keeper) {
 	mapp := mock.NewApp()
 
 	RegisterWire(mapp.Cdc)
 	keyIBC := sdk.NewKVStoreKey("ibc")
 	ibcMapper := NewMapper(mapp.Cdc, keyIBC, mapp.RegisterCodespace(DefaultCodespace))
-	coinKeeper := bank.NewKeeper(mapp.AccountMapper)
+	keySupply := sdk.NewKVStoreKey("supply")
+	coinKeeper := bank.NewKeeper(mapp.AccountMapper, bank.NewSupplyKeeper(mapp.Cdc, keySupply))
 	mapp.Router().AddRoute("ibc", NewHandler(ibcMapper, coinKeeper))
+	mapp.SetInitChainer(getInitChainer(mapp, coinKeeper))
 
-	require.NoError(t, mapp.CompleteSetup([]*sdk.KVStoreKey{keyIBC}))
-	return mapp
+	require.NoError(t, mapp.CompleteSetup(keyIBC, keySupply))
+	return mapp, coinKeeper
+}
+
+// getInitChainer initializes the chainer of the mock app and sets the genesis
+// supply to the sum of the genesis accounts
+func getInitChainer(mapp *mock.App, keeper bank.Keeper) sdk.InitChainer {
+	return func(ctx sdk.Context, req abci.RequestInitChain) abci.ResponseInitChain {
+		mapp.InitChainer(ctx, req)
+
+		supply := sdk.Coins{}
+		for _, acc := range mapp.GenesisAccounts {
+			supply = supply.Plus(acc.GetCoins())
+		}
+		bank.InitGenesis(ctx, keeper, bank.NewGenesisState(supply, nil, nil, nil))
+
+		return abci.ResponseInitChain{}
+	}
 }
 
 func TestIBCMsgs(t *testing.T) {
-	mapp := getMockApp(t)
+	mapp, coinKeeper := getMockApp(t)
+	supplyInvariant := bank.SupplyInvariant(coinKeeper)
 
 	sourceChain := "source-chain"
 	destChain := "dest-chain"
@@ -70,10 +89,16 @@ func TestIBCMsgs(t *testing.T) {
 		Sequence:  0,
 	}
 
+	supplyInvariant(t, mapp, "genesis")
+
+	// the transferred coins are burned, and minted again when received
 	mock.SignCheckDeliver(t, mapp.BaseApp, []sdk.Msg{transferMsg}, []int64{0}, []int64{0}, true, priv1)
 	mock.CheckBalance(t, mapp, addr1, emptyCoins)
+	supplyInvariant(t, mapp, "transfer")
 	mock.SignCheckDeliver(t, mapp.BaseApp, []sdk.Msg{transferMsg}, []int64{0}, []int64{1}, false, priv1)
 	mock.SignCheckDeliver(t, mapp.BaseApp, []sdk.Msg{receiveMsg}, []int64{0}, []int64{2}, true, priv1)
 	mock.CheckBalance(t, mapp, addr1, coins)
+	supplyInvariant(t, mapp, "receive")
 	mock.SignCheckDeliver(t, mapp.BaseApp, []sdk.Msg{receiveMsg}, []int64{0}, []int64{2}, false, priv1)
+	supplyInvariant(t, mapp, "replayed receive")
 }
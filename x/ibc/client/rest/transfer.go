@@ -8,12 +8,14 @@ import (
 	"github.com/gorilla/mux"
 
 	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/utils"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/wire"
 	"github.com/cosmos/cosmos-sdk/x/ibc"
 )
 
-// RegisterRoutes - Central function to define routes that get registered by the main application
+// RegisterRoutes - Central function to define routes that get registered by the main application.
+// Without a keybase the routes only generate unsigned txs.
 func RegisterRoutes(ctx context.CoreContext, r *mux.Router, cdc *wire.Codec, kb keys.Keybase) {
 	r.HandleFunc("/ibc/{destchain}/{address}/send", TransferRequestHandlerFn(cdc, kb, ctx)).Methods("POST")
 }
@@ -27,6 +29,8 @@ type transferBody struct {
 	AccountNumber    int64     `json:"account_number"`
 	Sequence         int64     `json:"sequence"`
 	Gas              int64     `json:"gas"`
+	// address of the sender, used instead of the key name with generate_only
+	From string `json:"from"`
 }
 
 // TransferRequestHandler - http request handler to transfer coins to a address
@@ -59,23 +63,46 @@ func TransferRequestHandlerFn(cdc *wire.Codec, kb keys.Keybase, ctx context.Core
 			return
 		}
 
-		info, err := kb.Get(m.LocalAccountName)
-		if err != nil {
-			w.WriteHeader(http.StatusUnauthorized)
-			w.Write([]byte(err.Error()))
+		generateOnly := utils.HasGenerateOnlyArg(r)
+		var from sdk.AccAddress
+		switch {
+		case generateOnly:
+			from, err = sdk.AccAddressFromBech32(m.From)
+			if err != nil {
+				w.WriteHeader(http.StatusBadRequest)
+				w.Write([]byte(err.Error()))
+				return
+			}
+		case kb == nil:
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(utils.ErrKeybaseDisabled.Error()))
 			return
+		default:
+			info, err := kb.Get(m.LocalAccountName)
+			if err != nil {
+				w.WriteHeader(http.StatusUnauthorized)
+				w.Write([]byte(err.Error()))
+				return
+			}
+			from = sdk.AccAddress(info.GetPubKey().Address())
 		}
 
 		// build message
-		packet := ibc.NewIBCPacket(sdk.AccAddress(info.GetPubKey().Address()), to, m.Amount, m.SrcChainID, destChainID)
+		packet := ibc.NewIBCPacket(from, to, m.Amount, m.SrcChainID, destChainID)
 		msg := ibc.IBCTransferMsg{packet}
 
 		// add gas to context
 		ctx = ctx.WithGas(m.Gas)
 
-		// sign
 		ctx = ctx.WithAccountNumber(m.AccountNumber)
 		ctx = ctx.WithSequence(m.Sequence)
+		if generateOnly {
+			ctx = ctx.WithChainID(m.SrcChainID)
+			utils.WriteGenerateOnlyResponse(w, ctx, cdc, []sdk.Msg{msg})
+			return
+		}
+
+		// sign
 		txBytes, err := ctx.SignAndBuild(m.LocalAccountName, m.Password, []sdk.Msg{msg}, cdc)
 		if err != nil {
 			w.WriteHeader(http.StatusUnauthorized)
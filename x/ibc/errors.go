@@ -14,37 +14,15 @@ const (
 	CodeUnknownRequest  sdk.CodeType = sdk.CodeUnknownRequest
 )
 
-func codeToDefaultMsg(code sdk.CodeType) string {
-	switch code {
-	case CodeInvalidSequence:
-		return "invalid IBC packet sequence"
-	case CodeIdenticalChains:
-		return "source and destination chain cannot be identical"
-	default:
-		return sdk.CodeToDefaultMsg(code)
-	}
+func init() {
+	sdk.RegisterError(DefaultCodespace, CodeInvalidSequence, "invalid IBC packet sequence")
+	sdk.RegisterError(DefaultCodespace, CodeIdenticalChains, "source and destination chain cannot be identical")
 }
 
 // nolint
 func ErrInvalidSequence(codespace sdk.CodespaceType) sdk.Error {
-	return newError(codespace, CodeInvalidSequence, "")
+	return sdk.NewError(codespace, CodeInvalidSequence, "")
 }
 func ErrIdenticalChains(codespace sdk.CodespaceType) sdk.Error {
-	return newError(codespace, CodeIdenticalChains, "")
-}
-
-// -------------------------
-// Helpers
-
-// nolint: unparam
-func newError(codespace sdk.CodespaceType, code sdk.CodeType, msg string) sdk.Error {
-	msg = msgOrDefaultMsg(msg, code)
-	return sdk.NewError(codespace, code, msg)
-}
-
-func msgOrDefaultMsg(msg string, code sdk.CodeType) string {
-	if msg != "" {
-		return msg
-	}
-	return codeToDefaultMsg(code)
+	return sdk.NewError(codespace, CodeIdenticalChains, "")
 }
@@ -34,7 +34,7 @@ func newAddress() sdk.AccAddress {
 
 func getCoins(ck bank.Keeper, ctx sdk.Context, addr sdk.AccAddress) (sdk.Coins, sdk.Error) {
 	zero := sdk.Coins(nil)
-	coins, _, err := ck.AddCoins(ctx, addr, zero)
+	coins, err := ck.AddCoins(ctx, addr, zero)
 	return coins, err
 }
 
@@ -65,7 +65,7 @@ func TestIBC(t *testing.T) {
 	ctx := defaultContext(key)
 
 	am := auth.NewAccountMapper(cdc, key, auth.ProtoBaseAccount)
-	ck := bank.NewKeeper(am)
+	ck := bank.NewKeeper(am, bank.NewSupplyKeeper(cdc, key))
 
 	src := newAddress()
 	dest := newAddress()
@@ -73,9 +73,10 @@ func TestIBC(t *testing.T) {
 	zero := sdk.Coins(nil)
 	mycoins := sdk.Coins{sdk.NewCoin("mycoin", 10)}
 
-	coins, _, err := ck.AddCoins(ctx, src, mycoins)
+	coins, err := ck.MintCoins(ctx, src, mycoins)
 	require.Nil(t, err)
 	require.Equal(t, mycoins, coins)
+	require.Equal(t, mycoins, ck.GetTotalSupply(ctx))
 
 	ibcm := NewMapper(cdc, key, DefaultCodespace)
 	h := NewHandler(ibcm, ck)
@@ -107,6 +108,9 @@ func TestIBC(t *testing.T) {
 	require.Nil(t, err)
 	require.Equal(t, zero, coins)
 
+	// the sent coins leave the supply of this chain
+	require.True(t, ck.GetTotalSupply(ctx).IsZero())
+
 	egl = ibcm.getEgressLength(store, chainid)
 	require.Equal(t, egl, int64(1))
 
@@ -124,6 +128,7 @@ func TestIBC(t *testing.T) {
 	coins, err = getCoins(ck, ctx, dest)
 	require.Nil(t, err)
 	require.Equal(t, mycoins, coins)
+	require.Equal(t, mycoins, ck.GetTotalSupply(ctx))
 
 	igs = ibcm.GetIngressSequence(ctx, chainid)
 	require.Equal(t, igs, int64(1))
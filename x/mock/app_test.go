@@ -41,7 +41,7 @@ func getMockApp(t *testing.T) *App {
 	mApp := NewApp()
 
 	mApp.Router().AddRoute(msgType, func(ctx sdk.Context, msg sdk.Msg) (res sdk.Result) { return })
-	require.NoError(t, mApp.CompleteSetup([]*sdk.KVStoreKey{}))
+	require.NoError(t, mApp.CompleteSetup())
 
 	return mApp
 }
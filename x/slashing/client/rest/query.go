@@ -7,12 +7,14 @@ import (
 	"github.com/gorilla/mux"
 
 	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/utils"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/wire"
 	"github.com/cosmos/cosmos-sdk/x/slashing"
 )
 
-func registerQueryRoutes(ctx context.CoreContext, r *mux.Router, cdc *wire.Codec) {
+// RegisterQueryRoutes registers the REST routes querying the slashing state
+func RegisterQueryRoutes(ctx context.CoreContext, r *mux.Router, cdc *wire.Codec) {
 	r.HandleFunc(
 		"/slashing/signing_info/{validator}",
 		signingInfoHandlerFn(ctx, "slashing", cdc),
@@ -27,20 +29,25 @@ func signingInfoHandlerFn(ctx context.CoreContext, storeName string, cdc *wire.C
 		vars := mux.Vars(r)
 		bech32validator := vars["validator"]
 
-		validatorAddr, err := sdk.ValAddressFromBech32(bech32validator)
+		validatorAddr, err := sdk.ConsAddressFromBech32(bech32validator)
 		if err != nil {
 			w.WriteHeader(http.StatusBadRequest)
 			w.Write([]byte(err.Error()))
 			return
 		}
 
+		ctx, ok := utils.ParseQueryHeight(w, r, ctx)
+		if !ok {
+			return
+		}
+
 		key := slashing.GetValidatorSigningInfoKey(validatorAddr)
-		res, err := ctx.QueryStore(key, storeName)
+		res, height, err := ctx.QueryStoreWithHeight(key, storeName)
 		if err != nil {
-			w.WriteHeader(http.StatusInternalServerError)
-			w.Write([]byte(fmt.Sprintf("couldn't query signing info. Error: %s", err.Error())))
+			utils.WriteQueryError(w, "couldn't query signing info", err)
 			return
 		}
+		utils.WriteQueryHeight(w, height)
 
 		var signingInfo slashing.ValidatorSigningInfo
 		err = cdc.UnmarshalBinary(res, &signingInfo)
@@ -10,13 +10,16 @@ import (
 	"github.com/gorilla/mux"
 
 	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/utils"
 	"github.com/cosmos/cosmos-sdk/crypto/keys"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/wire"
 	"github.com/cosmos/cosmos-sdk/x/slashing"
 )
 
-func registerTxRoutes(ctx context.CoreContext, r *mux.Router, cdc *wire.Codec, kb keys.Keybase) {
+// RegisterTxRoutes registers the REST routes signing slashing txs with the keybase.
+// Without a keybase they only generate unsigned txs.
+func RegisterTxRoutes(ctx context.CoreContext, r *mux.Router, cdc *wire.Codec, kb keys.Keybase) {
 	r.HandleFunc(
 		"/slashing/unrevoke",
 		unrevokeRequestHandlerFn(cdc, kb, ctx),
@@ -50,13 +53,6 @@ func unrevokeRequestHandlerFn(cdc *wire.Codec, kb keys.Keybase, ctx context.Core
 			return
 		}
 
-		info, err := kb.Get(m.LocalAccountName)
-		if err != nil {
-			w.WriteHeader(http.StatusUnauthorized)
-			w.Write([]byte(err.Error()))
-			return
-		}
-
 		validatorAddr, err := sdk.AccAddressFromBech32(m.ValidatorAddr)
 		if err != nil {
 			w.WriteHeader(http.StatusInternalServerError)
@@ -64,10 +60,26 @@ func unrevokeRequestHandlerFn(cdc *wire.Codec, kb keys.Keybase, ctx context.Core
 			return
 		}
 
-		if !bytes.Equal(info.GetPubKey().Address(), validatorAddr) {
-			w.WriteHeader(http.StatusUnauthorized)
-			w.Write([]byte("Must use own validator address"))
-			return
+		generateOnly := utils.HasGenerateOnlyArg(r)
+		if !generateOnly {
+			if kb == nil {
+				w.WriteHeader(http.StatusBadRequest)
+				w.Write([]byte(utils.ErrKeybaseDisabled.Error()))
+				return
+			}
+
+			info, err := kb.Get(m.LocalAccountName)
+			if err != nil {
+				w.WriteHeader(http.StatusUnauthorized)
+				w.Write([]byte(err.Error()))
+				return
+			}
+
+			if !bytes.Equal(info.GetPubKey().Address(), validatorAddr) {
+				w.WriteHeader(http.StatusUnauthorized)
+				w.Write([]byte("Must use own validator address"))
+				return
+			}
 		}
 
 		ctx = ctx.WithGas(m.Gas)
@@ -77,6 +89,11 @@ func unrevokeRequestHandlerFn(cdc *wire.Codec, kb keys.Keybase, ctx context.Core
 
 		msg := slashing.NewMsgUnrevoke(validatorAddr)
 
+		if generateOnly {
+			utils.WriteGenerateOnlyResponse(w, ctx, cdc, []sdk.Msg{msg})
+			return
+		}
+
 		txBytes, err := ctx.SignAndBuild(m.LocalAccountName, m.Password, []sdk.Msg{msg}, cdc)
 		if err != nil {
 			w.WriteHeader(http.StatusUnauthorized)
@@ -17,6 +17,12 @@ const (
 	CodeValidatorNotRevoked CodeType = 103
 )
 
+func init() {
+	sdk.RegisterError(DefaultCodespace, CodeInvalidValidator, "invalid validator")
+	sdk.RegisterError(DefaultCodespace, CodeValidatorJailed, "validator jailed")
+	sdk.RegisterError(DefaultCodespace, CodeValidatorNotRevoked, "validator not revoked")
+}
+
 func ErrNoValidatorForAddress(codespace sdk.CodespaceType) sdk.Error {
 	return sdk.NewError(codespace, CodeInvalidValidator, "that address is not associated with any known validator")
 }
@@ -0,0 +1,16 @@
+// nolint
+package slashing
+
+// Events emitted by the slashing module.
+const (
+	EventTypeUnrevoke = "unrevoke"
+	EventTypeSlash    = "slash"
+
+	AttributeKeyValidator = "validator"
+	AttributeKeyPower     = "power"
+	AttributeKeyReason    = "reason"
+	AttributeKeyJailUntil = "jailed_until"
+
+	AttributeValueDoubleSign = "double_sign"
+	AttributeValueDowntime   = "downtime"
+)
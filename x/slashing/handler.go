@@ -30,7 +30,7 @@ func handleMsgUnrevoke(ctx sdk.Context, msg MsgUnrevoke, k Keeper) sdk.Result {
 		return ErrValidatorNotRevoked(k.codespace).Result()
 	}
 
-	addr := sdk.ValAddress(validator.GetPubKey().Address())
+	addr := validator.GetConsAddr()
 
 	// Signing info must exist
 	info, found := k.getValidatorSigningInfo(ctx, addr)
@@ -52,11 +52,11 @@ func handleMsgUnrevoke(ctx sdk.Context, msg MsgUnrevoke, k Keeper) sdk.Result {
 	k.setValidatorSigningInfo(ctx, addr, info)
 
 	// Unrevoke the validator
-	k.validatorSet.Unrevoke(ctx, validator.GetPubKey())
+	k.validatorSet.Unrevoke(ctx, addr)
 
-	tags := sdk.NewTags("action", []byte("unrevoke"), "validator", []byte(msg.ValidatorAddr.String()))
+	ctx.EventManager().EmitEvent(sdk.NewEvent(EventTypeUnrevoke,
+		sdk.NewAttribute(AttributeKeyValidator, msg.ValidatorAddr.String()),
+	))
 
-	return sdk.Result{
-		Tags: tags,
-	}
+	return sdk.Result{}
 }
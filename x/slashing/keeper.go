@@ -2,6 +2,7 @@ package slashing
 
 import (
 	"fmt"
+	"strconv"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/wire"
@@ -34,22 +35,22 @@ func (k Keeper) handleDoubleSign(ctx sdk.Context, pubkey crypto.PubKey, infracti
 	logger := ctx.Logger().With("module", "x/slashing")
 	time := ctx.BlockHeader().Time
 	age := time - timestamp
-	address := sdk.ValAddress(pubkey.Address())
+	address := sdk.GetConsAddress(pubkey)
 
 	// Double sign too old
 	if age > MaxEvidenceAge {
-		logger.Info(fmt.Sprintf("Ignored double sign from %s at height %d, age of %d past max age of %d", pubkey.Address(), infractionHeight, age, MaxEvidenceAge))
+		logger.Info(fmt.Sprintf("Ignored double sign from %s at height %d, age of %d past max age of %d", address, infractionHeight, age, MaxEvidenceAge))
 		return
 	}
 
 	// Double sign confirmed
-	logger.Info(fmt.Sprintf("Confirmed double sign from %s at height %d, age of %d less than max age of %d", pubkey.Address(), infractionHeight, age, MaxEvidenceAge))
+	logger.Info(fmt.Sprintf("Confirmed double sign from %s at height %d, age of %d less than max age of %d", address, infractionHeight, age, MaxEvidenceAge))
 
 	// Slash validator
-	k.validatorSet.Slash(ctx, pubkey, infractionHeight, power, SlashFractionDoubleSign)
+	k.validatorSet.Slash(ctx, address, infractionHeight, power, SlashFractionDoubleSign)
 
 	// Revoke validator
-	k.validatorSet.Revoke(ctx, pubkey)
+	k.validatorSet.Revoke(ctx, address)
 
 	// Jail validator
 	signInfo, found := k.getValidatorSigningInfo(ctx, address)
@@ -58,13 +59,14 @@ func (k Keeper) handleDoubleSign(ctx sdk.Context, pubkey crypto.PubKey, infracti
 	}
 	signInfo.JailedUntil = time + DoubleSignUnbondDuration
 	k.setValidatorSigningInfo(ctx, address, signInfo)
+	emitSlash(ctx, address, power, AttributeValueDoubleSign, signInfo.JailedUntil)
 }
 
 // handle a validator signature, must be called once per validator per block
 func (k Keeper) handleValidatorSignature(ctx sdk.Context, pubkey crypto.PubKey, power int64, signed bool) {
 	logger := ctx.Logger().With("module", "x/slashing")
 	height := ctx.BlockHeight()
-	address := sdk.ValAddress(pubkey.Address())
+	address := sdk.GetConsAddress(pubkey)
 
 	// Local index, so counts blocks validator *should* have signed
 	// Will use the 0-value default signing info if not present, except for start height
@@ -93,17 +95,27 @@ func (k Keeper) handleValidatorSignature(ctx sdk.Context, pubkey crypto.PubKey,
 	}
 
 	if !signed {
-		logger.Info(fmt.Sprintf("Absent validator %s at height %d, %d signed, threshold %d", pubkey.Address(), height, signInfo.SignedBlocksCounter, MinSignedPerWindow))
+		logger.Info(fmt.Sprintf("Absent validator %s at height %d, %d signed, threshold %d", address, height, signInfo.SignedBlocksCounter, MinSignedPerWindow))
 	}
 	minHeight := signInfo.StartHeight + SignedBlocksWindow
 	if height > minHeight && signInfo.SignedBlocksCounter < MinSignedPerWindow {
 		// Downtime confirmed, slash, revoke, and jail the validator
-		logger.Info(fmt.Sprintf("Validator %s past min height of %d and below signed blocks threshold of %d", pubkey.Address(), minHeight, MinSignedPerWindow))
-		k.validatorSet.Slash(ctx, pubkey, height, power, SlashFractionDowntime)
-		k.validatorSet.Revoke(ctx, pubkey)
+		logger.Info(fmt.Sprintf("Validator %s past min height of %d and below signed blocks threshold of %d", address, minHeight, MinSignedPerWindow))
+		k.validatorSet.Slash(ctx, address, height, power, SlashFractionDowntime)
+		k.validatorSet.Revoke(ctx, address)
 		signInfo.JailedUntil = ctx.BlockHeader().Time + DowntimeUnbondDuration
+		emitSlash(ctx, address, power, AttributeValueDowntime, signInfo.JailedUntil)
 	}
 
 	// Set the updated signing info
 	k.setValidatorSigningInfo(ctx, address, signInfo)
 }
+
+func emitSlash(ctx sdk.Context, address sdk.ConsAddress, power int64, reason string, jailedUntil int64) {
+	ctx.EventManager().EmitEvent(sdk.NewEvent(EventTypeSlash,
+		sdk.NewAttribute(AttributeKeyValidator, address.String()),
+		sdk.NewAttribute(AttributeKeyPower, strconv.FormatInt(power, 10)),
+		sdk.NewAttribute(AttributeKeyReason, reason),
+		sdk.NewAttribute(AttributeKeyJailUntil, strconv.FormatInt(jailedUntil, 10)),
+	))
+}
@@ -32,7 +32,7 @@ func TestHandleDoubleSign(t *testing.T) {
 	require.True(t, got.IsOK())
 	stake.EndBlocker(ctx, sk)
 	require.Equal(t, ck.GetCoins(ctx, addr), sdk.Coins{{sk.GetParams(ctx).BondDenom, initCoins.Sub(amt)}})
-	require.True(t, sdk.NewRatFromInt(amt).Equal(sk.Validator(ctx, addr).GetPower()))
+	require.True(t, sdk.NewDecFromInt(amt).Equal(sk.Validator(ctx, addr).GetPower()))
 
 	// handle a signature to set signing info
 	keeper.handleValidatorSignature(ctx, val, amtInt, true)
@@ -43,14 +43,14 @@ func TestHandleDoubleSign(t *testing.T) {
 	// should be revoked
 	require.True(t, sk.Validator(ctx, addr).GetRevoked())
 	// unrevoke to measure power
-	sk.Unrevoke(ctx, val)
+	sk.Unrevoke(ctx, sdk.GetConsAddress(val))
 	// power should be reduced
-	require.Equal(t, sdk.NewRatFromInt(amt).Mul(sdk.NewRat(19).Quo(sdk.NewRat(20))), sk.Validator(ctx, addr).GetPower())
+	require.Equal(t, sdk.NewDecFromInt(amt).Mul(sdk.NewDec(19).Quo(sdk.NewDec(20))), sk.Validator(ctx, addr).GetPower())
 	ctx = ctx.WithBlockHeader(abci.Header{Time: 1 + MaxEvidenceAge})
 
 	// double sign past max age
 	keeper.handleDoubleSign(ctx, val, 0, 0, amtInt)
-	require.Equal(t, sdk.NewRatFromInt(amt).Mul(sdk.NewRat(19).Quo(sdk.NewRat(20))), sk.Validator(ctx, addr).GetPower())
+	require.Equal(t, sdk.NewDecFromInt(amt).Mul(sdk.NewDec(19).Quo(sdk.NewDec(20))), sk.Validator(ctx, addr).GetPower())
 }
 
 // Test a validator through uptime, downtime, revocation,
@@ -67,8 +67,8 @@ func TestHandleAbsentValidator(t *testing.T) {
 	require.True(t, got.IsOK())
 	stake.EndBlocker(ctx, sk)
 	require.Equal(t, ck.GetCoins(ctx, addr), sdk.Coins{{sk.GetParams(ctx).BondDenom, initCoins.Sub(amt)}})
-	require.True(t, sdk.NewRatFromInt(amt).Equal(sk.Validator(ctx, addr).GetPower()))
-	info, found := keeper.getValidatorSigningInfo(ctx, sdk.ValAddress(val.Address()))
+	require.True(t, sdk.NewDecFromInt(amt).Equal(sk.Validator(ctx, addr).GetPower()))
+	info, found := keeper.getValidatorSigningInfo(ctx, sdk.GetConsAddress(val))
 	require.False(t, found)
 	require.Equal(t, int64(0), info.StartHeight)
 	require.Equal(t, int64(0), info.IndexOffset)
@@ -81,7 +81,7 @@ func TestHandleAbsentValidator(t *testing.T) {
 		ctx = ctx.WithBlockHeight(height)
 		keeper.handleValidatorSignature(ctx, val, amtInt, true)
 	}
-	info, found = keeper.getValidatorSigningInfo(ctx, sdk.ValAddress(val.Address()))
+	info, found = keeper.getValidatorSigningInfo(ctx, sdk.GetConsAddress(val))
 	require.True(t, found)
 	require.Equal(t, int64(0), info.StartHeight)
 	require.Equal(t, SignedBlocksWindow, info.SignedBlocksCounter)
@@ -91,13 +91,13 @@ func TestHandleAbsentValidator(t *testing.T) {
 		ctx = ctx.WithBlockHeight(height)
 		keeper.handleValidatorSignature(ctx, val, amtInt, false)
 	}
-	info, found = keeper.getValidatorSigningInfo(ctx, sdk.ValAddress(val.Address()))
+	info, found = keeper.getValidatorSigningInfo(ctx, sdk.GetConsAddress(val))
 	require.True(t, found)
 	require.Equal(t, int64(0), info.StartHeight)
 	require.Equal(t, SignedBlocksWindow-MinSignedPerWindow, info.SignedBlocksCounter)
 
 	// validator should be bonded still
-	validator, _ := sk.GetValidatorByPubKey(ctx, val)
+	validator, _ := sk.GetValidatorByConsAddr(ctx, sdk.GetConsAddress(val))
 	require.Equal(t, sdk.Bonded, validator.GetStatus())
 	pool := sk.GetPool(ctx)
 	require.Equal(t, int64(amtInt), pool.BondedTokens.RoundInt64())
@@ -105,13 +105,13 @@ func TestHandleAbsentValidator(t *testing.T) {
 	// 501st block missed
 	ctx = ctx.WithBlockHeight(height)
 	keeper.handleValidatorSignature(ctx, val, amtInt, false)
-	info, found = keeper.getValidatorSigningInfo(ctx, sdk.ValAddress(val.Address()))
+	info, found = keeper.getValidatorSigningInfo(ctx, sdk.GetConsAddress(val))
 	require.True(t, found)
 	require.Equal(t, int64(0), info.StartHeight)
 	require.Equal(t, SignedBlocksWindow-MinSignedPerWindow-1, info.SignedBlocksCounter)
 
 	// validator should have been revoked
-	validator, _ = sk.GetValidatorByPubKey(ctx, val)
+	validator, _ = sk.GetValidatorByConsAddr(ctx, sdk.GetConsAddress(val))
 	require.Equal(t, sdk.Unbonded, validator.GetStatus())
 
 	// unrevocation should fail prior to jail expiration
@@ -124,7 +124,7 @@ func TestHandleAbsentValidator(t *testing.T) {
 	require.True(t, got.IsOK())
 
 	// validator should be rebonded now
-	validator, _ = sk.GetValidatorByPubKey(ctx, val)
+	validator, _ = sk.GetValidatorByConsAddr(ctx, sdk.GetConsAddress(val))
 	require.Equal(t, sdk.Bonded, validator.GetStatus())
 
 	// validator should have been slashed
@@ -132,7 +132,7 @@ func TestHandleAbsentValidator(t *testing.T) {
 	require.Equal(t, int64(amtInt-1), pool.BondedTokens.RoundInt64())
 
 	// validator start height should have been changed
-	info, found = keeper.getValidatorSigningInfo(ctx, sdk.ValAddress(val.Address()))
+	info, found = keeper.getValidatorSigningInfo(ctx, sdk.GetConsAddress(val))
 	require.True(t, found)
 	require.Equal(t, height, info.StartHeight)
 	require.Equal(t, SignedBlocksWindow-MinSignedPerWindow-1, info.SignedBlocksCounter)
@@ -141,7 +141,7 @@ func TestHandleAbsentValidator(t *testing.T) {
 	height++
 	ctx = ctx.WithBlockHeight(height)
 	keeper.handleValidatorSignature(ctx, val, amtInt, false)
-	validator, _ = sk.GetValidatorByPubKey(ctx, val)
+	validator, _ = sk.GetValidatorByConsAddr(ctx, sdk.GetConsAddress(val))
 	require.Equal(t, sdk.Bonded, validator.GetStatus())
 
 	// 500 signed blocks
@@ -157,7 +157,7 @@ func TestHandleAbsentValidator(t *testing.T) {
 		ctx = ctx.WithBlockHeight(height)
 		keeper.handleValidatorSignature(ctx, val, amtInt, false)
 	}
-	validator, _ = sk.GetValidatorByPubKey(ctx, val)
+	validator, _ = sk.GetValidatorByConsAddr(ctx, sdk.GetConsAddress(val))
 	require.Equal(t, sdk.Unbonded, validator.GetStatus())
 }
 
@@ -173,7 +173,7 @@ func TestHandleNewValidator(t *testing.T) {
 	require.True(t, got.IsOK())
 	stake.EndBlocker(ctx, sk)
 	require.Equal(t, ck.GetCoins(ctx, addr), sdk.Coins{{sk.GetParams(ctx).BondDenom, initCoins.SubRaw(amt)}})
-	require.Equal(t, sdk.NewRat(amt), sk.Validator(ctx, addr).GetPower())
+	require.Equal(t, sdk.NewDec(amt), sk.Validator(ctx, addr).GetPower())
 
 	// 1000 first blocks not a validator
 	ctx = ctx.WithBlockHeight(SignedBlocksWindow + 1)
@@ -183,7 +183,7 @@ func TestHandleNewValidator(t *testing.T) {
 	ctx = ctx.WithBlockHeight(SignedBlocksWindow + 2)
 	keeper.handleValidatorSignature(ctx, val, 100, false)
 
-	info, found := keeper.getValidatorSigningInfo(ctx, sdk.ValAddress(val.Address()))
+	info, found := keeper.getValidatorSigningInfo(ctx, sdk.GetConsAddress(val))
 	require.True(t, found)
 	require.Equal(t, int64(SignedBlocksWindow+1), info.StartHeight)
 	require.Equal(t, int64(2), info.IndexOffset)
@@ -191,7 +191,7 @@ func TestHandleNewValidator(t *testing.T) {
 	require.Equal(t, int64(0), info.JailedUntil)
 
 	// validator should be bonded still, should not have been revoked or slashed
-	validator, _ := sk.GetValidatorByPubKey(ctx, val)
+	validator, _ := sk.GetValidatorByConsAddr(ctx, sdk.GetConsAddress(val))
 	require.Equal(t, sdk.Bonded, validator.GetStatus())
 	pool := sk.GetPool(ctx)
 	require.Equal(t, int64(100), pool.BondedTokens.RoundInt64())
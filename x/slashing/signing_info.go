@@ -7,8 +7,8 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
-// Stored by *validator* address (not owner address)
-func (k Keeper) getValidatorSigningInfo(ctx sdk.Context, address sdk.ValAddress) (info ValidatorSigningInfo, found bool) {
+// Stored by *consensus* address (not owner address)
+func (k Keeper) getValidatorSigningInfo(ctx sdk.Context, address sdk.ConsAddress) (info ValidatorSigningInfo, found bool) {
 	store := ctx.KVStore(k.storeKey)
 	bz := store.Get(GetValidatorSigningInfoKey(address))
 	if bz == nil {
@@ -20,15 +20,15 @@ func (k Keeper) getValidatorSigningInfo(ctx sdk.Context, address sdk.ValAddress)
 	return
 }
 
-// Stored by *validator* address (not owner address)
-func (k Keeper) setValidatorSigningInfo(ctx sdk.Context, address sdk.ValAddress, info ValidatorSigningInfo) {
+// Stored by *consensus* address (not owner address)
+func (k Keeper) setValidatorSigningInfo(ctx sdk.Context, address sdk.ConsAddress, info ValidatorSigningInfo) {
 	store := ctx.KVStore(k.storeKey)
 	bz := k.cdc.MustMarshalBinary(info)
 	store.Set(GetValidatorSigningInfoKey(address), bz)
 }
 
-// Stored by *validator* address (not owner address)
-func (k Keeper) getValidatorSigningBitArray(ctx sdk.Context, address sdk.ValAddress, index int64) (signed bool) {
+// Stored by *consensus* address (not owner address)
+func (k Keeper) getValidatorSigningBitArray(ctx sdk.Context, address sdk.ConsAddress, index int64) (signed bool) {
 	store := ctx.KVStore(k.storeKey)
 	bz := store.Get(GetValidatorSigningBitArrayKey(address, index))
 	if bz == nil {
@@ -40,8 +40,8 @@ func (k Keeper) getValidatorSigningBitArray(ctx sdk.Context, address sdk.ValAddr
 	return
 }
 
-// Stored by *validator* address (not owner address)
-func (k Keeper) setValidatorSigningBitArray(ctx sdk.Context, address sdk.ValAddress, index int64, signed bool) {
+// Stored by *consensus* address (not owner address)
+func (k Keeper) setValidatorSigningBitArray(ctx sdk.Context, address sdk.ConsAddress, index int64, signed bool) {
 	store := ctx.KVStore(k.storeKey)
 	bz := k.cdc.MustMarshalBinary(signed)
 	store.Set(GetValidatorSigningBitArrayKey(address, index), bz)
@@ -71,13 +71,13 @@ func (i ValidatorSigningInfo) HumanReadableString() string {
 		i.StartHeight, i.IndexOffset, i.JailedUntil, i.SignedBlocksCounter)
 }
 
-// Stored by *validator* address (not owner address)
-func GetValidatorSigningInfoKey(v sdk.ValAddress) []byte {
+// Stored by *consensus* address (not owner address)
+func GetValidatorSigningInfoKey(v sdk.ConsAddress) []byte {
 	return append([]byte{0x01}, v.Bytes()...)
 }
 
-// Stored by *validator* address (not owner address)
-func GetValidatorSigningBitArrayKey(v sdk.ValAddress, i int64) []byte {
+// Stored by *consensus* address (not owner address)
+func GetValidatorSigningBitArrayKey(v sdk.ConsAddress, i int64) []byte {
 	b := make([]byte, 8)
 	binary.LittleEndian.PutUint64(b, uint64(i))
 	return append([]byte{0x02}, append(v.Bytes(), b...)...)
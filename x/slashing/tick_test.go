@@ -21,7 +21,7 @@ func TestBeginBlocker(t *testing.T) {
 	require.True(t, got.IsOK())
 	stake.EndBlocker(ctx, sk)
 	require.Equal(t, ck.GetCoins(ctx, addr), sdk.Coins{{sk.GetParams(ctx).BondDenom, initCoins.Sub(amt)}})
-	require.True(t, sdk.NewRatFromInt(amt).Equal(sk.Validator(ctx, addr).GetPower()))
+	require.True(t, sdk.NewDecFromInt(amt).Equal(sk.Validator(ctx, addr).GetPower()))
 
 	val := abci.Validator{
 		PubKey: tmtypes.TM2PB.PubKey(pk),
@@ -37,7 +37,7 @@ func TestBeginBlocker(t *testing.T) {
 	}
 	BeginBlocker(ctx, req, keeper)
 
-	info, found := keeper.getValidatorSigningInfo(ctx, sdk.ValAddress(pk.Address()))
+	info, found := keeper.getValidatorSigningInfo(ctx, sdk.GetConsAddress(pk))
 	require.True(t, found)
 	require.Equal(t, ctx.BlockHeight(), info.StartHeight)
 	require.Equal(t, int64(1), info.IndexOffset)
@@ -71,7 +71,7 @@ func TestBeginBlocker(t *testing.T) {
 	}
 
 	// validator should be revoked
-	validator, found := sk.GetValidatorByPubKey(ctx, pk)
+	validator, found := sk.GetValidatorByConsAddr(ctx, sdk.GetConsAddress(pk))
 	require.True(t, found)
 	require.Equal(t, sdk.Unbonded, validator.GetStatus())
 }
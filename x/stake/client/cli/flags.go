@@ -14,6 +14,7 @@ const (
 	FlagAmount              = "amount"
 	FlagSharesAmount        = "shares-amount"
 	FlagSharesPercent       = "shares-percent"
+	FlagStatus              = "status"
 
 	FlagMoniker  = "moniker"
 	FlagIdentity = "keybase-sig"
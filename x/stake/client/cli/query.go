@@ -7,6 +7,7 @@ import (
 	"github.com/spf13/viper"
 	"github.com/tendermint/tendermint/libs/cli"
 
+	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/context"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/wire"
@@ -60,27 +61,35 @@ func GetCmdQueryValidator(storeName string, cdc *wire.Codec) *cobra.Command {
 	return cmd
 }
 
-// get the command to query a validator
-func GetCmdQueryValidators(storeName string, cdc *wire.Codec) *cobra.Command {
+// get the command to query a page of the validators
+func GetCmdQueryValidators(queryRoute string, cdc *wire.Codec) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "validators",
 		Short: "Query for all validators",
 		RunE: func(cmd *cobra.Command, args []string) error {
 
-			key := stake.ValidatorsKey
+			status := viper.GetString(FlagStatus)
+			if !stake.ValidBondStatusFilter(status) {
+				return fmt.Errorf("invalid validator status %s", status)
+			}
+			params := stake.QueryValidatorsParams{
+				Status:     status,
+				Pagination: sdk.NewPaginationParams(viper.GetInt(client.FlagPage), viper.GetInt(client.FlagLimit)),
+			}
+			bz, err := cdc.MarshalJSON(params)
+			if err != nil {
+				return err
+			}
+
 			ctx := context.NewCoreContextFromViper()
-			resKVs, err := ctx.QuerySubspace(cdc, key, storeName)
+			res, _, err := ctx.QueryWithData(fmt.Sprintf("/custom/%s/%s", queryRoute, stake.QueryValidators), bz)
 			if err != nil {
 				return err
 			}
 
 			// parse out the validators
 			var validators []stake.Validator
-			for _, kv := range resKVs {
-				addr := kv.Key[1:]
-				validator := types.MustUnmarshalValidator(cdc, addr, kv.Value)
-				validators = append(validators, validator)
-			}
+			cdc.MustUnmarshalJSON(res, &validators)
 
 			switch viper.Get(cli.OutputFlag) {
 			case "text":
@@ -104,6 +113,9 @@ func GetCmdQueryValidators(storeName string, cdc *wire.Codec) *cobra.Command {
 			// TODO output with proofs / machine parseable etc.
 		},
 	}
+
+	cmd.Flags().String(FlagStatus, "", "(optional) filter validators by status (Bonded|Unbonding|Unbonded)")
+	client.AddPaginationFlags(cmd)
 	return cmd
 }
 
@@ -158,8 +170,8 @@ func GetCmdQueryDelegation(storeName string, cdc *wire.Codec) *cobra.Command {
 	return cmd
 }
 
-// get the command to query all the delegations made from one delegator
-func GetCmdQueryDelegations(storeName string, cdc *wire.Codec) *cobra.Command {
+// get the command to query a page of the delegations made from one delegator
+func GetCmdQueryDelegations(queryRoute string, cdc *wire.Codec) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "delegations [delegator-addr]",
 		Short: "Query all delegations made from one delegator",
@@ -170,19 +182,13 @@ func GetCmdQueryDelegations(storeName string, cdc *wire.Codec) *cobra.Command {
 			if err != nil {
 				return err
 			}
-			key := stake.GetDelegationsKey(delegatorAddr)
-			ctx := context.NewCoreContextFromViper()
-			resKVs, err := ctx.QuerySubspace(cdc, key, storeName)
+			res, err := queryDelegatorRecords(queryRoute, stake.QueryDelegatorDelegations, delegatorAddr, cdc)
 			if err != nil {
 				return err
 			}
 
-			// parse out the validators
 			var delegations []stake.Delegation
-			for _, kv := range resKVs {
-				delegation := types.MustUnmarshalDelegation(cdc, kv.Key, kv.Value)
-				delegations = append(delegations, delegation)
-			}
+			cdc.MustUnmarshalJSON(res, &delegations)
 
 			output, err := wire.MarshalJSONIndent(cdc, delegations)
 			if err != nil {
@@ -194,6 +200,8 @@ func GetCmdQueryDelegations(storeName string, cdc *wire.Codec) *cobra.Command {
 			// TODO output with proofs / machine parseable etc.
 		},
 	}
+
+	client.AddPaginationFlags(cmd)
 	return cmd
 }
 
@@ -248,8 +256,8 @@ func GetCmdQueryUnbondingDelegation(storeName string, cdc *wire.Codec) *cobra.Co
 	return cmd
 }
 
-// get the command to query all the unbonding-delegation records for a delegator
-func GetCmdQueryUnbondingDelegations(storeName string, cdc *wire.Codec) *cobra.Command {
+// get the command to query a page of the unbonding-delegation records for a delegator
+func GetCmdQueryUnbondingDelegations(queryRoute string, cdc *wire.Codec) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "unbonding-delegations [delegator-addr]",
 		Short: "Query all unbonding-delegations records for one delegator",
@@ -260,19 +268,13 @@ func GetCmdQueryUnbondingDelegations(storeName string, cdc *wire.Codec) *cobra.C
 			if err != nil {
 				return err
 			}
-			key := stake.GetUBDsKey(delegatorAddr)
-			ctx := context.NewCoreContextFromViper()
-			resKVs, err := ctx.QuerySubspace(cdc, key, storeName)
+			res, err := queryDelegatorRecords(queryRoute, stake.QueryDelegatorUnbondingDelegations, delegatorAddr, cdc)
 			if err != nil {
 				return err
 			}
 
-			// parse out the validators
 			var ubds []stake.UnbondingDelegation
-			for _, kv := range resKVs {
-				ubd := types.MustUnmarshalUBD(cdc, kv.Key, kv.Value)
-				ubds = append(ubds, ubd)
-			}
+			cdc.MustUnmarshalJSON(res, &ubds)
 
 			output, err := wire.MarshalJSONIndent(cdc, ubds)
 			if err != nil {
@@ -284,6 +286,8 @@ func GetCmdQueryUnbondingDelegations(storeName string, cdc *wire.Codec) *cobra.C
 			// TODO output with proofs / machine parseable etc.
 		},
 	}
+
+	client.AddPaginationFlags(cmd)
 	return cmd
 }
 
@@ -341,11 +345,11 @@ func GetCmdQueryRedelegation(storeName string, cdc *wire.Codec) *cobra.Command {
 	return cmd
 }
 
-// get the command to query all the unbonding-delegation records for a delegator
-func GetCmdQueryRedelegations(storeName string, cdc *wire.Codec) *cobra.Command {
+// get the command to query a page of the redelegation records for a delegator
+func GetCmdQueryRedelegations(queryRoute string, cdc *wire.Codec) *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "unbonding-delegations [delegator-addr]",
-		Short: "Query all unbonding-delegations records for one delegator",
+		Use:   "redelegations [delegator-addr]",
+		Short: "Query all redelegations records for one delegator",
 		Args:  cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 
@@ -353,19 +357,13 @@ func GetCmdQueryRedelegations(storeName string, cdc *wire.Codec) *cobra.Command
 			if err != nil {
 				return err
 			}
-			key := stake.GetREDsKey(delegatorAddr)
-			ctx := context.NewCoreContextFromViper()
-			resKVs, err := ctx.QuerySubspace(cdc, key, storeName)
+			res, err := queryDelegatorRecords(queryRoute, stake.QueryDelegatorRedelegations, delegatorAddr, cdc)
 			if err != nil {
 				return err
 			}
 
-			// parse out the validators
 			var reds []stake.Redelegation
-			for _, kv := range resKVs {
-				red := types.MustUnmarshalRED(cdc, kv.Key, kv.Value)
-				reds = append(reds, red)
-			}
+			cdc.MustUnmarshalJSON(res, &reds)
 
 			output, err := wire.MarshalJSONIndent(cdc, reds)
 			if err != nil {
@@ -377,5 +375,23 @@ func GetCmdQueryRedelegations(storeName string, cdc *wire.Codec) *cobra.Command
 			// TODO output with proofs / machine parseable etc.
 		},
 	}
+
+	client.AddPaginationFlags(cmd)
 	return cmd
 }
+
+// query a page of the records of a delegator through a custom query
+func queryDelegatorRecords(queryRoute, endpoint string, delegatorAddr sdk.AccAddress, cdc *wire.Codec) ([]byte, error) {
+	params := stake.QueryDelegatorParams{
+		DelegatorAddr: delegatorAddr,
+		Pagination:    sdk.NewPaginationParams(viper.GetInt(client.FlagPage), viper.GetInt(client.FlagLimit)),
+	}
+	bz, err := cdc.MarshalJSON(params)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := context.NewCoreContextFromViper()
+	res, _, err := ctx.QueryWithData(fmt.Sprintf("/custom/%s/%s", queryRoute, endpoint), bz)
+	return res, err
+}
@@ -12,19 +12,21 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/wire"
 	authcmd "github.com/cosmos/cosmos-sdk/x/auth/client/cli"
+	bankclient "github.com/cosmos/cosmos-sdk/x/bank/client"
 	"github.com/cosmos/cosmos-sdk/x/stake"
 	"github.com/cosmos/cosmos-sdk/x/stake/types"
 )
 
-// create create validator command
-func GetCmdCreateValidator(cdc *wire.Codec) *cobra.Command {
+// create create validator command, an amount in display units is converted
+// with the denom metadata of the supply store
+func GetCmdCreateValidator(supplyStoreName string, cdc *wire.Codec) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "create-validator",
 		Short: "create new validator initialized with a self-delegation to it",
 		RunE: func(cmd *cobra.Command, args []string) error {
 			ctx := context.NewCoreContextFromViper().WithDecoder(authcmd.GetAccountDecoder(cdc))
 
-			amount, err := sdk.ParseCoin(viper.GetString(FlagAmount))
+			amount, err := bankclient.ParseCoin(ctx, cdc, supplyStoreName, viper.GetString(FlagAmount))
 			if err != nil {
 				return err
 			}
@@ -115,13 +117,16 @@ func GetCmdEditValidator(cdc *wire.Codec) *cobra.Command {
 	return cmd
 }
 
-// delegate command
-func GetCmdDelegate(cdc *wire.Codec) *cobra.Command {
+// delegate command, an amount in display units is converted with the denom
+// metadata of the supply store
+func GetCmdDelegate(supplyStoreName string, cdc *wire.Codec) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "delegate",
 		Short: "delegate liquid tokens to an validator",
 		RunE: func(cmd *cobra.Command, args []string) error {
-			amount, err := sdk.ParseCoin(viper.GetString(FlagAmount))
+			ctx := context.NewCoreContextFromViper().WithDecoder(authcmd.GetAccountDecoder(cdc))
+
+			amount, err := bankclient.ParseCoin(ctx, cdc, supplyStoreName, viper.GetString(FlagAmount))
 			if err != nil {
 				return err
 			}
@@ -138,8 +143,6 @@ func GetCmdDelegate(cdc *wire.Codec) *cobra.Command {
 			msg := stake.NewMsgDelegate(delegatorAddr, validatorAddr, amount)
 
 			// build and sign the transaction, then broadcast to Tendermint
-			ctx := context.NewCoreContextFromViper().WithDecoder(authcmd.GetAccountDecoder(cdc))
-
 			err = ctx.EnsureSignBuildBroadcast(ctx.FromAddressName, []sdk.Msg{msg}, cdc)
 			if err != nil {
 				return err
@@ -222,7 +225,7 @@ func GetCmdBeginRedelegate(storeName string, cdc *wire.Codec) *cobra.Command {
 // nolint: gocyclo
 // TODO: Make this pass gocyclo linting
 func getShares(storeName string, cdc *wire.Codec, sharesAmountStr, sharesPercentStr string,
-	delegatorAddr, validatorAddr sdk.AccAddress) (sharesAmount sdk.Rat, err error) {
+	delegatorAddr, validatorAddr sdk.AccAddress) (sharesAmount sdk.Dec, err error) {
 
 	switch {
 	case sharesAmountStr != "" && sharesPercentStr != "":
@@ -230,20 +233,20 @@ func getShares(storeName string, cdc *wire.Codec, sharesAmountStr, sharesPercent
 	case sharesAmountStr == "" && sharesPercentStr == "":
 		return sharesAmount, errors.Errorf("can either specify the amount OR the percent of the shares, not both")
 	case sharesAmountStr != "":
-		sharesAmount, err = sdk.NewRatFromDecimal(sharesAmountStr, types.MaxBondDenominatorPrecision)
+		sharesAmount, err = sdk.NewDecFromStr(sharesAmountStr)
 		if err != nil {
 			return sharesAmount, err
 		}
-		if !sharesAmount.GT(sdk.ZeroRat()) {
+		if !sharesAmount.GT(sdk.ZeroDec()) {
 			return sharesAmount, errors.Errorf("shares amount must be positive number (ex. 123, 1.23456789)")
 		}
 	case sharesPercentStr != "":
-		var sharesPercent sdk.Rat
-		sharesPercent, err = sdk.NewRatFromDecimal(sharesPercentStr, types.MaxBondDenominatorPrecision)
+		var sharesPercent sdk.Dec
+		sharesPercent, err = sdk.NewDecFromStr(sharesPercentStr)
 		if err != nil {
 			return sharesAmount, err
 		}
-		if !sharesPercent.GT(sdk.ZeroRat()) || !sharesPercent.LTE(sdk.OneRat()) {
+		if !sharesPercent.GT(sdk.ZeroDec()) || !sharesPercent.LTE(sdk.OneDec()) {
 			return sharesAmount, errors.Errorf("shares percent must be >0 and <=1 (ex. 0.01, 0.75, 1)")
 		}
 
@@ -7,6 +7,7 @@ import (
 	"github.com/gorilla/mux"
 
 	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/utils"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/wire"
 
@@ -16,7 +17,8 @@ import (
 
 const storeName = "stake"
 
-func registerQueryRoutes(ctx context.CoreContext, r *mux.Router, cdc *wire.Codec) {
+// RegisterQueryRoutes registers the REST routes querying the stake state
+func RegisterQueryRoutes(ctx context.CoreContext, r *mux.Router, cdc *wire.Codec) {
 
 	r.HandleFunc(
 		"/stake/{delegator}/delegation/{validator}",
@@ -33,6 +35,21 @@ func registerQueryRoutes(ctx context.CoreContext, r *mux.Router, cdc *wire.Codec
 		redHandlerFn(ctx, cdc),
 	).Methods("GET")
 
+	r.HandleFunc(
+		"/stake/{delegator}/delegations",
+		delegatorRecordsHandlerFn(ctx, cdc, stake.QueryDelegatorDelegations),
+	).Methods("GET")
+
+	r.HandleFunc(
+		"/stake/{delegator}/ubds",
+		delegatorRecordsHandlerFn(ctx, cdc, stake.QueryDelegatorUnbondingDelegations),
+	).Methods("GET")
+
+	r.HandleFunc(
+		"/stake/{delegator}/reds",
+		delegatorRecordsHandlerFn(ctx, cdc, stake.QueryDelegatorRedelegations),
+	).Methods("GET")
+
 	r.HandleFunc(
 		"/stake/validators",
 		validatorsHandlerFn(ctx, cdc),
@@ -62,14 +79,19 @@ func delegationHandlerFn(ctx context.CoreContext, cdc *wire.Codec) http.HandlerF
 			return
 		}
 
+		ctx, ok := utils.ParseQueryHeight(w, r, ctx)
+		if !ok {
+			return
+		}
+
 		key := stake.GetDelegationKey(delegatorAddr, validatorAddr)
 
-		res, err := ctx.QueryStore(key, storeName)
+		res, height, err := ctx.QueryStoreWithHeight(key, storeName)
 		if err != nil {
-			w.WriteHeader(http.StatusInternalServerError)
-			w.Write([]byte(fmt.Sprintf("couldn't query delegation. Error: %s", err.Error())))
+			utils.WriteQueryError(w, "couldn't query delegation", err)
 			return
 		}
+		utils.WriteQueryHeight(w, height)
 
 		// the query will return empty if there is no data for this record
 		if len(res) == 0 {
@@ -118,14 +140,19 @@ func ubdHandlerFn(ctx context.CoreContext, cdc *wire.Codec) http.HandlerFunc {
 			return
 		}
 
+		ctx, ok := utils.ParseQueryHeight(w, r, ctx)
+		if !ok {
+			return
+		}
+
 		key := stake.GetUBDKey(delegatorAddr, validatorAddr)
 
-		res, err := ctx.QueryStore(key, storeName)
+		res, height, err := ctx.QueryStoreWithHeight(key, storeName)
 		if err != nil {
-			w.WriteHeader(http.StatusInternalServerError)
-			w.Write([]byte(fmt.Sprintf("couldn't query unbonding-delegation. Error: %s", err.Error())))
+			utils.WriteQueryError(w, "couldn't query unbonding-delegation", err)
 			return
 		}
+		utils.WriteQueryHeight(w, height)
 
 		// the query will return empty if there is no data for this record
 		if len(res) == 0 {
@@ -182,14 +209,19 @@ func redHandlerFn(ctx context.CoreContext, cdc *wire.Codec) http.HandlerFunc {
 			return
 		}
 
+		ctx, ok := utils.ParseQueryHeight(w, r, ctx)
+		if !ok {
+			return
+		}
+
 		key := stake.GetREDKey(delegatorAddr, validatorSrcAddr, validatorDstAddr)
 
-		res, err := ctx.QueryStore(key, storeName)
+		res, height, err := ctx.QueryStoreWithHeight(key, storeName)
 		if err != nil {
-			w.WriteHeader(http.StatusInternalServerError)
-			w.Write([]byte(fmt.Sprintf("couldn't query redelegation. Error: %s", err.Error())))
+			utils.WriteQueryError(w, "couldn't query redelegation", err)
 			return
 		}
+		utils.WriteQueryHeight(w, height)
 
 		// the query will return empty if there is no data for this record
 		if len(res) == 0 {
@@ -215,45 +247,71 @@ func redHandlerFn(ctx context.CoreContext, cdc *wire.Codec) http.HandlerFunc {
 	}
 }
 
-// TODO bech32
-// http request handler to query list of validators
+// http request handler to query a page of the validators, optionally
+// filtered by status
 func validatorsHandlerFn(ctx context.CoreContext, cdc *wire.Codec) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
-		kvs, err := ctx.QuerySubspace(cdc, stake.ValidatorsKey, storeName)
+		status := r.URL.Query().Get("status")
+		if !stake.ValidBondStatusFilter(status) {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(fmt.Sprintf("invalid validator status %s", status)))
+			return
+		}
+
+		pagination, ok := utils.ParsePaginationParams(w, r)
+		if !ok {
+			return
+		}
+
+		ctx, ok := utils.ParseQueryHeight(w, r, ctx)
+		if !ok {
+			return
+		}
+
+		params := stake.QueryValidatorsParams{
+			Status:     status,
+			Pagination: pagination,
+		}
+		bz, err := cdc.MarshalJSON(params)
 		if err != nil {
-			w.WriteHeader(http.StatusInternalServerError)
-			w.Write([]byte(fmt.Sprintf("couldn't query validators. Error: %s", err.Error())))
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(err.Error()))
 			return
 		}
 
-		// the query will return empty if there are no validators
-		if len(kvs) == 0 {
-			w.WriteHeader(http.StatusNoContent)
+		res, height, err := ctx.QueryWithData(fmt.Sprintf("/custom/%s/%s", storeName, stake.QueryValidators), bz)
+		if err != nil {
+			utils.WriteQueryError(w, "couldn't query validators", err)
 			return
 		}
+		utils.WriteQueryHeight(w, height)
 
-		// parse out the validators
-		validators := make([]types.BechValidator, len(kvs))
-		for i, kv := range kvs {
+		var validators []types.Validator
+		err = cdc.UnmarshalJSON(res, &validators)
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte(fmt.Sprintf("couldn't decode validators. Error: %s", err.Error())))
+			return
+		}
 
-			addr := kv.Key[1:]
-			validator, err := types.UnmarshalValidator(cdc, addr, kv.Value)
-			if err != nil {
-				w.WriteHeader(http.StatusInternalServerError)
-				w.Write([]byte(fmt.Sprintf("couldn't query unbonding-delegation. Error: %s", err.Error())))
-				return
-			}
+		// the query will return empty if there are no validators on the page
+		if len(validators) == 0 {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
 
+		bech32Validators := make([]types.BechValidator, len(validators))
+		for i, validator := range validators {
 			bech32Validator, err := validator.Bech32Validator()
 			if err != nil {
 				w.WriteHeader(http.StatusBadRequest)
 				w.Write([]byte(err.Error()))
 				return
 			}
-			validators[i] = bech32Validator
+			bech32Validators[i] = bech32Validator
 		}
 
-		output, err := cdc.MarshalJSON(validators)
+		output, err := cdc.MarshalJSON(bech32Validators)
 		if err != nil {
 			w.WriteHeader(http.StatusInternalServerError)
 			w.Write([]byte(err.Error()))
@@ -263,3 +321,52 @@ func validatorsHandlerFn(ctx context.CoreContext, cdc *wire.Codec) http.HandlerF
 		w.Write(output)
 	}
 }
+
+// http request handler to query a page of the delegations, unbonding
+// delegations or redelegations of a delegator, as selected by the endpoint
+func delegatorRecordsHandlerFn(ctx context.CoreContext, cdc *wire.Codec, endpoint string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+
+		// read parameters
+		vars := mux.Vars(r)
+		bech32delegator := vars["delegator"]
+
+		delegatorAddr, err := sdk.AccAddressFromBech32(bech32delegator)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(err.Error()))
+			return
+		}
+
+		pagination, ok := utils.ParsePaginationParams(w, r)
+		if !ok {
+			return
+		}
+
+		ctx, ok := utils.ParseQueryHeight(w, r, ctx)
+		if !ok {
+			return
+		}
+
+		params := stake.QueryDelegatorParams{
+			DelegatorAddr: delegatorAddr,
+			Pagination:    pagination,
+		}
+		bz, err := cdc.MarshalJSON(params)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(err.Error()))
+			return
+		}
+
+		res, height, err := ctx.QueryWithData(fmt.Sprintf("/custom/%s/%s", storeName, endpoint), bz)
+		if err != nil {
+			utils.WriteQueryError(w, "couldn't query delegator records", err)
+			return
+		}
+		utils.WriteQueryHeight(w, height)
+
+		// the querier already returns the JSON encoded records
+		w.Write(res)
+	}
+}
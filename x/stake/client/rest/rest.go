@@ -10,6 +10,6 @@ import (
 
 // RegisterRoutes registers staking-related REST handlers to a router
 func RegisterRoutes(ctx context.CoreContext, r *mux.Router, cdc *wire.Codec, kb keys.Keybase) {
-	registerQueryRoutes(ctx, r, cdc)
-	registerTxRoutes(ctx, r, cdc, kb)
+	RegisterQueryRoutes(ctx, r, cdc)
+	RegisterTxRoutes(ctx, r, cdc, kb)
 }
@@ -11,13 +11,15 @@ import (
 	ctypes "github.com/tendermint/tendermint/rpc/core/types"
 
 	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/utils"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/wire"
 	"github.com/cosmos/cosmos-sdk/x/stake"
-	"github.com/cosmos/cosmos-sdk/x/stake/types"
 )
 
-func registerTxRoutes(ctx context.CoreContext, r *mux.Router, cdc *wire.Codec, kb keys.Keybase) {
+// RegisterTxRoutes registers the REST routes signing stake txs with the keybase.
+// Without a keybase they only generate unsigned txs.
+func RegisterTxRoutes(ctx context.CoreContext, r *mux.Router, cdc *wire.Codec, kb keys.Keybase) {
 	r.HandleFunc(
 		"/stake/delegations",
 		editDelegationsRequestHandlerFn(cdc, kb, ctx),
@@ -83,11 +85,23 @@ func editDelegationsRequestHandlerFn(cdc *wire.Codec, kb keys.Keybase, ctx conte
 			return
 		}
 
-		info, err := kb.Get(m.LocalAccountName)
-		if err != nil {
-			w.WriteHeader(http.StatusUnauthorized)
-			w.Write([]byte(err.Error()))
-			return
+		// the delegator of every msg must be the named key, unless the txs are
+		// only generated for the client to sign them
+		generateOnly := utils.HasGenerateOnlyArg(r)
+		var own sdk.AccAddress
+		if !generateOnly {
+			if kb == nil {
+				w.WriteHeader(http.StatusBadRequest)
+				w.Write([]byte(utils.ErrKeybaseDisabled.Error()))
+				return
+			}
+			info, err := kb.Get(m.LocalAccountName)
+			if err != nil {
+				w.WriteHeader(http.StatusUnauthorized)
+				w.Write([]byte(err.Error()))
+				return
+			}
+			own = sdk.AccAddress(info.GetPubKey().Address())
 		}
 
 		// build messages
@@ -111,7 +125,7 @@ func editDelegationsRequestHandlerFn(cdc *wire.Codec, kb keys.Keybase, ctx conte
 				w.Write([]byte(fmt.Sprintf("Couldn't decode validator. Error: %s", err.Error())))
 				return
 			}
-			if !bytes.Equal(info.GetPubKey().Address(), delegatorAddr) {
+			if !generateOnly && !bytes.Equal(own, delegatorAddr) {
 				w.WriteHeader(http.StatusUnauthorized)
 				w.Write([]byte("Must use own delegator address"))
 				return
@@ -131,7 +145,7 @@ func editDelegationsRequestHandlerFn(cdc *wire.Codec, kb keys.Keybase, ctx conte
 				w.Write([]byte(fmt.Sprintf("Couldn't decode delegator. Error: %s", err.Error())))
 				return
 			}
-			if !bytes.Equal(info.GetPubKey().Address(), delegatorAddr) {
+			if !generateOnly && !bytes.Equal(own, delegatorAddr) {
 				w.WriteHeader(http.StatusUnauthorized)
 				w.Write([]byte("Must use own delegator address"))
 				return
@@ -148,7 +162,7 @@ func editDelegationsRequestHandlerFn(cdc *wire.Codec, kb keys.Keybase, ctx conte
 				w.Write([]byte(fmt.Sprintf("Couldn't decode validator. Error: %s", err.Error())))
 				return
 			}
-			shares, err := sdk.NewRatFromDecimal(msg.SharesAmount, types.MaxBondDenominatorPrecision)
+			shares, err := sdk.NewDecFromStr(msg.SharesAmount)
 			if err != nil {
 				w.WriteHeader(http.StatusInternalServerError)
 				w.Write([]byte(fmt.Sprintf("Couldn't decode shares amount. Error: %s", err.Error())))
@@ -182,7 +196,7 @@ func editDelegationsRequestHandlerFn(cdc *wire.Codec, kb keys.Keybase, ctx conte
 				w.Write([]byte(fmt.Sprintf("Couldn't decode validator. Error: %s", err.Error())))
 				return
 			}
-			if !bytes.Equal(info.GetPubKey().Address(), delegatorAddr) {
+			if !generateOnly && !bytes.Equal(own, delegatorAddr) {
 				w.WriteHeader(http.StatusUnauthorized)
 				w.Write([]byte("Must use own delegator address"))
 				return
@@ -202,7 +216,7 @@ func editDelegationsRequestHandlerFn(cdc *wire.Codec, kb keys.Keybase, ctx conte
 				w.Write([]byte(fmt.Sprintf("Couldn't decode delegator. Error: %s", err.Error())))
 				return
 			}
-			if !bytes.Equal(info.GetPubKey().Address(), delegatorAddr) {
+			if !generateOnly && !bytes.Equal(own, delegatorAddr) {
 				w.WriteHeader(http.StatusUnauthorized)
 				w.Write([]byte("Must use own delegator address"))
 				return
@@ -213,7 +227,7 @@ func editDelegationsRequestHandlerFn(cdc *wire.Codec, kb keys.Keybase, ctx conte
 				w.Write([]byte(fmt.Sprintf("Couldn't decode validator. Error: %s", err.Error())))
 				return
 			}
-			shares, err := sdk.NewRatFromDecimal(msg.SharesAmount, types.MaxBondDenominatorPrecision)
+			shares, err := sdk.NewDecFromStr(msg.SharesAmount)
 			if err != nil {
 				w.WriteHeader(http.StatusInternalServerError)
 				w.Write([]byte(fmt.Sprintf("Couldn't decode shares amount. Error: %s", err.Error())))
@@ -240,7 +254,7 @@ func editDelegationsRequestHandlerFn(cdc *wire.Codec, kb keys.Keybase, ctx conte
 				w.Write([]byte(fmt.Sprintf("Couldn't decode validator. Error: %s", err.Error())))
 				return
 			}
-			if !bytes.Equal(info.GetPubKey().Address(), delegatorAddr) {
+			if !generateOnly && !bytes.Equal(own, delegatorAddr) {
 				w.WriteHeader(http.StatusUnauthorized)
 				w.Write([]byte("Must use own delegator address"))
 				return
@@ -255,6 +269,31 @@ func editDelegationsRequestHandlerFn(cdc *wire.Codec, kb keys.Keybase, ctx conte
 		// add gas to context
 		ctx = ctx.WithGas(m.Gas)
 
+		if generateOnly {
+			ctx = ctx.WithChainID(m.ChainID)
+
+			// one tx per message, like the signed ones
+			txs := make([]utils.GeneratedTx, len(messages))
+			for i, msg := range messages {
+				ctx = ctx.WithAccountNumber(m.AccountNumber)
+				ctx = ctx.WithSequence(m.Sequence + int64(i))
+				txs[i], err = utils.BuildGeneratedTx(ctx, []sdk.Msg{msg})
+				if err != nil {
+					w.WriteHeader(http.StatusBadRequest)
+					w.Write([]byte(err.Error()))
+					return
+				}
+			}
+			output, err := wire.MarshalJSONIndent(cdc, txs)
+			if err != nil {
+				w.WriteHeader(http.StatusInternalServerError)
+				w.Write([]byte(err.Error()))
+				return
+			}
+			w.Write(output)
+			return
+		}
+
 		// sign messages
 		signedTxs := make([][]byte, len(messages[:]))
 		for i, msg := range messages {
@@ -7,15 +7,13 @@ import (
 	tmtypes "github.com/tendermint/tendermint/types"
 )
 
-// InitGenesis sets the pool and parameters for the provided keeper and
-// initializes the IntraTxCounter. For each validator in data, it sets that
-// validator in the keeper along with manually setting the indexes. In
-// addition, it also sets any delegations found in data. Finally, it updates
-// the bonded validators.
+// InitGenesis sets the pool and parameters for the provided keeper. For each
+// validator in data, it sets that validator in the keeper along with manually
+// setting the indexes. In addition, it also sets any delegations found in
+// data. Finally, it updates the bonded validators.
 func InitGenesis(ctx sdk.Context, keeper Keeper, data types.GenesisState) error {
 	keeper.SetPool(ctx, data.Pool)
 	keeper.SetNewParams(ctx, data.Params)
-	keeper.InitIntraTxCounter(ctx)
 
 	for _, validator := range data.Validators {
 		keeper.SetValidator(ctx, validator)
@@ -28,7 +26,7 @@ func InitGenesis(ctx sdk.Context, keeper Keeper, data types.GenesisState) error
 		}
 
 		// Manually set indexes for the first time
-		keeper.SetValidatorByPubKeyIndex(ctx, validator)
+		keeper.SetValidatorByConsAddr(ctx, validator)
 		keeper.SetValidatorByPowerIndex(ctx, validator, data.Pool)
 
 		if validator.Status == sdk.Bonded {
@@ -14,7 +14,7 @@ func TestInitGenesis(t *testing.T) {
 	ctx, _, keeper := keep.CreateTestInput(t, false, 1000)
 
 	pool := keeper.GetPool(ctx)
-	pool.LooseTokens = sdk.OneRat()
+	pool.LooseTokens = sdk.OneDec()
 
 	params := keeper.GetParams(ctx)
 	var delegations []Delegation
@@ -27,8 +27,8 @@ func TestInitGenesis(t *testing.T) {
 	err := InitGenesis(ctx, keeper, genesisState)
 	require.Error(t, err)
 
-	validators[0].Tokens = sdk.OneRat()
-	validators[0].DelegatorShares = sdk.OneRat()
+	validators[0].Tokens = sdk.OneDec()
+	validators[0].DelegatorShares = sdk.OneDec()
 
 	genesisState = types.NewGenesisState(pool, params, validators, delegations)
 	err = InitGenesis(ctx, keeper, genesisState)
@@ -3,7 +3,6 @@ package stake
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/stake/keeper"
-	"github.com/cosmos/cosmos-sdk/x/stake/tags"
 	"github.com/cosmos/cosmos-sdk/x/stake/types"
 	abci "github.com/tendermint/tendermint/abci/types"
 )
@@ -40,8 +39,10 @@ func EndBlocker(ctx sdk.Context, k keeper.Keeper) (ValidatorUpdates []abci.Valid
 	// Process types.Validator Provisions
 	blockTime := ctx.BlockHeader().Time
 	if pool.InflationLastTime+blockTime >= 3600 {
+		oldPool := pool
 		pool.InflationLastTime = blockTime
 		pool = pool.ProcessProvisions(params)
+		k.UpdateSupply(ctx, oldPool, pool)
 	}
 
 	// save the params
@@ -68,7 +69,7 @@ func handleMsgCreateValidator(ctx sdk.Context, msg types.MsgCreateValidator, k k
 	if found {
 		return ErrValidatorOwnerExists(k.Codespace()).Result()
 	}
-	_, found = k.GetValidatorByPubKey(ctx, msg.PubKey)
+	_, found = k.GetValidatorByConsAddr(ctx, sdk.GetConsAddress(msg.PubKey))
 	if found {
 		return ErrValidatorPubKeyExists(k.Codespace()).Result()
 	}
@@ -78,7 +79,7 @@ func handleMsgCreateValidator(ctx sdk.Context, msg types.MsgCreateValidator, k k
 
 	validator := NewValidator(msg.ValidatorAddr, msg.PubKey, msg.Description)
 	k.SetValidator(ctx, validator)
-	k.SetValidatorByPubKeyIndex(ctx, validator)
+	k.SetValidatorByConsAddr(ctx, validator)
 
 	// move coins from the msg.Address account to a (self-delegation) delegator account
 	// the validator account and global shares are updated within here
@@ -87,15 +88,14 @@ func handleMsgCreateValidator(ctx sdk.Context, msg types.MsgCreateValidator, k k
 		return err.Result()
 	}
 
-	tags := sdk.NewTags(
-		tags.Action, tags.ActionCreateValidator,
-		tags.DstValidator, []byte(msg.ValidatorAddr.String()),
-		tags.Moniker, []byte(msg.Description.Moniker),
-		tags.Identity, []byte(msg.Description.Identity),
-	)
-	return sdk.Result{
-		Tags: tags,
-	}
+	ctx.EventManager().EmitEvent(sdk.NewEvent(types.EventTypeCreateValidator,
+		sdk.NewAttribute(types.AttributeKeyValidator, msg.ValidatorAddr.String()),
+		sdk.NewAttribute(types.AttributeKeyDelegator, msg.DelegatorAddr.String()),
+		sdk.NewAttribute(types.AttributeKeyAmount, msg.Delegation.String()),
+		sdk.NewAttribute(types.AttributeKeyMoniker, msg.Description.Moniker),
+		sdk.NewAttribute(types.AttributeKeyIdentity, msg.Description.Identity),
+	))
+	return sdk.Result{}
 }
 
 func handleMsgEditValidator(ctx sdk.Context, msg types.MsgEditValidator, k keeper.Keeper) sdk.Result {
@@ -114,15 +114,12 @@ func handleMsgEditValidator(ctx sdk.Context, msg types.MsgEditValidator, k keepe
 	validator.Description = description
 
 	k.UpdateValidator(ctx, validator)
-	tags := sdk.NewTags(
-		tags.Action, tags.ActionEditValidator,
-		tags.DstValidator, []byte(msg.ValidatorAddr.String()),
-		tags.Moniker, []byte(description.Moniker),
-		tags.Identity, []byte(description.Identity),
-	)
-	return sdk.Result{
-		Tags: tags,
-	}
+	ctx.EventManager().EmitEvent(sdk.NewEvent(types.EventTypeEditValidator,
+		sdk.NewAttribute(types.AttributeKeyValidator, msg.ValidatorAddr.String()),
+		sdk.NewAttribute(types.AttributeKeyMoniker, description.Moniker),
+		sdk.NewAttribute(types.AttributeKeyIdentity, description.Identity),
+	))
+	return sdk.Result{}
 }
 
 func handleMsgDelegate(ctx sdk.Context, msg types.MsgDelegate, k keeper.Keeper) sdk.Result {
@@ -142,14 +139,12 @@ func handleMsgDelegate(ctx sdk.Context, msg types.MsgDelegate, k keeper.Keeper)
 		return err.Result()
 	}
 
-	tags := sdk.NewTags(
-		tags.Action, tags.ActionDelegate,
-		tags.Delegator, []byte(msg.DelegatorAddr.String()),
-		tags.DstValidator, []byte(msg.ValidatorAddr.String()),
-	)
-	return sdk.Result{
-		Tags: tags,
-	}
+	ctx.EventManager().EmitEvent(sdk.NewEvent(types.EventTypeDelegate,
+		sdk.NewAttribute(types.AttributeKeyDelegator, msg.DelegatorAddr.String()),
+		sdk.NewAttribute(types.AttributeKeyValidator, msg.ValidatorAddr.String()),
+		sdk.NewAttribute(types.AttributeKeyAmount, msg.Delegation.String()),
+	))
+	return sdk.Result{}
 }
 
 func handleMsgBeginUnbonding(ctx sdk.Context, msg types.MsgBeginUnbonding, k keeper.Keeper) sdk.Result {
@@ -158,12 +153,12 @@ func handleMsgBeginUnbonding(ctx sdk.Context, msg types.MsgBeginUnbonding, k kee
 		return err.Result()
 	}
 
-	tags := sdk.NewTags(
-		tags.Action, tags.ActionBeginUnbonding,
-		tags.Delegator, []byte(msg.DelegatorAddr.String()),
-		tags.SrcValidator, []byte(msg.ValidatorAddr.String()),
-	)
-	return sdk.Result{Tags: tags}
+	ctx.EventManager().EmitEvent(sdk.NewEvent(types.EventTypeBeginUnbonding,
+		sdk.NewAttribute(types.AttributeKeyDelegator, msg.DelegatorAddr.String()),
+		sdk.NewAttribute(types.AttributeKeyValidator, msg.ValidatorAddr.String()),
+		sdk.NewAttribute(types.AttributeKeyShares, msg.SharesAmount.String()),
+	))
+	return sdk.Result{}
 }
 
 func handleMsgCompleteUnbonding(ctx sdk.Context, msg types.MsgCompleteUnbonding, k keeper.Keeper) sdk.Result {
@@ -173,13 +168,11 @@ func handleMsgCompleteUnbonding(ctx sdk.Context, msg types.MsgCompleteUnbonding,
 		return err.Result()
 	}
 
-	tags := sdk.NewTags(
-		tags.Action, ActionCompleteUnbonding,
-		tags.Delegator, []byte(msg.DelegatorAddr.String()),
-		tags.SrcValidator, []byte(msg.ValidatorAddr.String()),
-	)
-
-	return sdk.Result{Tags: tags}
+	ctx.EventManager().EmitEvent(sdk.NewEvent(types.EventTypeCompleteUnbonding,
+		sdk.NewAttribute(types.AttributeKeyDelegator, msg.DelegatorAddr.String()),
+		sdk.NewAttribute(types.AttributeKeyValidator, msg.ValidatorAddr.String()),
+	))
+	return sdk.Result{}
 }
 
 func handleMsgBeginRedelegate(ctx sdk.Context, msg types.MsgBeginRedelegate, k keeper.Keeper) sdk.Result {
@@ -189,13 +182,13 @@ func handleMsgBeginRedelegate(ctx sdk.Context, msg types.MsgBeginRedelegate, k k
 		return err.Result()
 	}
 
-	tags := sdk.NewTags(
-		tags.Action, tags.ActionBeginRedelegation,
-		tags.Delegator, []byte(msg.DelegatorAddr.String()),
-		tags.SrcValidator, []byte(msg.ValidatorSrcAddr.String()),
-		tags.DstValidator, []byte(msg.ValidatorDstAddr.String()),
-	)
-	return sdk.Result{Tags: tags}
+	ctx.EventManager().EmitEvent(sdk.NewEvent(types.EventTypeBeginRedelegation,
+		sdk.NewAttribute(types.AttributeKeyDelegator, msg.DelegatorAddr.String()),
+		sdk.NewAttribute(types.AttributeKeySrcValidator, msg.ValidatorSrcAddr.String()),
+		sdk.NewAttribute(types.AttributeKeyDstValidator, msg.ValidatorDstAddr.String()),
+		sdk.NewAttribute(types.AttributeKeyShares, msg.SharesAmount.String()),
+	))
+	return sdk.Result{}
 }
 
 func handleMsgCompleteRedelegate(ctx sdk.Context, msg types.MsgCompleteRedelegate, k keeper.Keeper) sdk.Result {
@@ -204,11 +197,10 @@ func handleMsgCompleteRedelegate(ctx sdk.Context, msg types.MsgCompleteRedelegat
 		return err.Result()
 	}
 
-	tags := sdk.NewTags(
-		tags.Action, tags.ActionCompleteRedelegation,
-		tags.Delegator, []byte(msg.DelegatorAddr.String()),
-		tags.SrcValidator, []byte(msg.ValidatorSrcAddr.String()),
-		tags.DstValidator, []byte(msg.ValidatorDstAddr.String()),
-	)
-	return sdk.Result{Tags: tags}
+	ctx.EventManager().EmitEvent(sdk.NewEvent(types.EventTypeCompleteRedelegation,
+		sdk.NewAttribute(types.AttributeKeyDelegator, msg.DelegatorAddr.String()),
+		sdk.NewAttribute(types.AttributeKeySrcValidator, msg.ValidatorSrcAddr.String()),
+		sdk.NewAttribute(types.AttributeKeyDstValidator, msg.ValidatorDstAddr.String()),
+	))
+	return sdk.Result{}
 }
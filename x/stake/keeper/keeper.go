@@ -11,6 +11,7 @@ import (
 // keeper of the stake store
 type Keeper struct {
 	storeKey   sdk.StoreKey
+	storeTKey  sdk.StoreKey
 	cdc        *wire.Codec
 	coinKeeper bank.Keeper
 
@@ -18,9 +19,10 @@ type Keeper struct {
 	codespace sdk.CodespaceType
 }
 
-func NewKeeper(cdc *wire.Codec, key sdk.StoreKey, ck bank.Keeper, codespace sdk.CodespaceType) Keeper {
+func NewKeeper(cdc *wire.Codec, key, tkey sdk.StoreKey, ck bank.Keeper, codespace sdk.CodespaceType) Keeper {
 	keeper := Keeper{
 		storeKey:   key,
+		storeTKey:  tkey,
 		cdc:        cdc,
 		coinKeeper: ck,
 		codespace:  codespace,
@@ -94,21 +96,34 @@ func (k Keeper) SetPool(ctx sdk.Context, pool types.Pool) {
 	store.Set(PoolKey, b)
 }
 
-//__________________________________________________________________________
-
-// get the current in-block validator operation counter
-func (k Keeper) InitIntraTxCounter(ctx sdk.Context) {
-	store := ctx.KVStore(k.storeKey)
-	b := store.Get(IntraTxCounterKey)
-	if b == nil {
-		k.SetIntraTxCounter(ctx, 0)
+// record a change of the total pool tokens, from provisions or burns, in the
+// supply of the bond denom. The supply is kept at the rounded pool token supply.
+func (k Keeper) UpdateSupply(ctx sdk.Context, oldPool, newPool types.Pool) {
+	diff := newPool.TokenSupply().RoundInt().Sub(oldPool.TokenSupply().RoundInt())
+	denom := k.GetParams(ctx).BondDenom
+	switch diff.Sign() {
+	case 1:
+		k.coinKeeper.InflateSupply(ctx, sdk.Coins{{denom, diff}})
+	case -1:
+		err := k.coinKeeper.DeflateSupply(ctx, sdk.Coins{{denom, diff.Neg()}})
+		if err != nil {
+			// the supply doesn't hold the burned tokens, the burn still
+			// happens rather than halting the chain
+			ctx.Logger().With("module", "x/stake").Error(err.Error())
+		}
 	}
 }
 
-// get the current in-block validator operation counter
+//__________________________________________________________________________
+
+// get the current in-block validator operation counter, the counter lives in
+// the transient store and starts from zero every block
 func (k Keeper) GetIntraTxCounter(ctx sdk.Context) int16 {
-	store := ctx.KVStore(k.storeKey)
+	store := ctx.KVStore(k.storeTKey)
 	b := store.Get(IntraTxCounterKey)
+	if b == nil {
+		return 0
+	}
 	var counter int16
 	k.cdc.MustUnmarshalBinary(b, &counter)
 	return counter
@@ -116,7 +131,7 @@ func (k Keeper) GetIntraTxCounter(ctx sdk.Context) int16 {
 
 // set the current in-block validator operation counter
 func (k Keeper) SetIntraTxCounter(ctx sdk.Context, counter int16) {
-	store := ctx.KVStore(k.storeKey)
+	store := ctx.KVStore(k.storeTKey)
 	bz := k.cdc.MustMarshalBinary(counter)
 	store.Set(IntraTxCounterKey, bz)
 }
@@ -3,8 +3,6 @@ package keeper
 import (
 	"encoding/binary"
 
-	"github.com/tendermint/tendermint/crypto"
-
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/stake/types"
 )
@@ -17,13 +15,13 @@ var (
 	ParamKey                         = []byte{0x00} // key for parameters relating to staking
 	PoolKey                          = []byte{0x01} // key for the staking pools
 	ValidatorsKey                    = []byte{0x02} // prefix for each key to a validator
-	ValidatorsByPubKeyIndexKey       = []byte{0x03} // prefix for each key to a validator index, by pubkey
+	ValidatorsByConsAddrKey          = []byte{0x03} // prefix for each key to a validator index, by consensus address
 	ValidatorsBondedIndexKey         = []byte{0x04} // prefix for each key to a validator index, for bonded validators
 	ValidatorsByPowerIndexKey        = []byte{0x05} // prefix for each key to a validator index, sorted by power
 	ValidatorCliffIndexKey           = []byte{0x06} // key for the validator index of the cliff validator
 	ValidatorPowerCliffKey           = []byte{0x07} // key for the power of the validator on the cliff
-	TendermintUpdatesKey             = []byte{0x08} // prefix for each key to a validator which is being updated
-	IntraTxCounterKey                = []byte{0x09} // key for intra-block tx index
+	TendermintUpdatesKey             = []byte{0x08} // prefix for each key to a validator which is being updated (transient store)
+	IntraTxCounterKey                = []byte{0x09} // key for intra-block tx index (transient store)
 	DelegationKey                    = []byte{0x0A} // key for a delegation
 	UnbondingDelegationKey           = []byte{0x0B} // key for an unbonding-delegation
 	UnbondingDelegationByValIndexKey = []byte{0x0C} // prefix for each key for an unbonding-delegation, by validator owner
@@ -40,10 +38,10 @@ func GetValidatorKey(ownerAddr sdk.AccAddress) []byte {
 	return append(ValidatorsKey, ownerAddr.Bytes()...)
 }
 
-// get the key for the validator with pubkey.
+// get the key for the validator with consensus address.
 // VALUE: validator owner address ([]byte)
-func GetValidatorByPubKeyIndexKey(pubkey crypto.PubKey) []byte {
-	return append(ValidatorsByPubKeyIndexKey, pubkey.Bytes()...)
+func GetValidatorByConsAddrKey(consAddr sdk.ConsAddress) []byte {
+	return append(ValidatorsByConsAddrKey, consAddr.Bytes()...)
 }
 
 // get the key for the current validator group
@@ -0,0 +1,195 @@
+package keeper
+
+import (
+	"fmt"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/stake/types"
+)
+
+// query endpoints supported by the stake Querier
+const (
+	QueryValidators                    = "validators"
+	QueryDelegatorDelegations          = "delegatorDelegations"
+	QueryDelegatorUnbondingDelegations = "delegatorUnbondingDelegations"
+	QueryDelegatorRedelegations        = "delegatorRedelegations"
+)
+
+// NewQuerier returns the querier of the stake module, to be registered on the
+// custom query router of the app
+func NewQuerier(k Keeper) sdk.Querier {
+	return func(ctx sdk.Context, path []string, req abci.RequestQuery) (res []byte, err sdk.Error) {
+		if len(path) == 0 {
+			return nil, sdk.ErrUnknownRequest("no stake query endpoint specified")
+		}
+		switch path[0] {
+		case QueryValidators:
+			return queryValidators(ctx, req, k)
+		case QueryDelegatorDelegations:
+			return queryDelegatorDelegations(ctx, req, k)
+		case QueryDelegatorUnbondingDelegations:
+			return queryDelegatorUnbondingDelegations(ctx, req, k)
+		case QueryDelegatorRedelegations:
+			return queryDelegatorRedelegations(ctx, req, k)
+		default:
+			return nil, sdk.ErrUnknownRequest(fmt.Sprintf("unknown stake query endpoint %s", path[0]))
+		}
+	}
+}
+
+// QueryValidatorsParams are the params of the validators query. The
+// validators can be filtered by their bond status, "Bonded", "Unbonding" or
+// "Unbonded", the empty status matches any validator.
+type QueryValidatorsParams struct {
+	Status     string               `json:"status"`
+	Pagination sdk.PaginationParams `json:"pagination"`
+}
+
+// QueryDelegatorParams are the params of the queries listing the records of
+// a delegator
+type QueryDelegatorParams struct {
+	DelegatorAddr sdk.AccAddress       `json:"delegator_addr"`
+	Pagination    sdk.PaginationParams `json:"pagination"`
+}
+
+// ValidBondStatusFilter returns whether the status is a bond status or the
+// empty status matching any validator
+func ValidBondStatusFilter(status string) bool {
+	switch status {
+	case "", sdk.BondStatusToString(sdk.Bonded), sdk.BondStatusToString(sdk.Unbonding), sdk.BondStatusToString(sdk.Unbonded):
+		return true
+	}
+	return false
+}
+
+// queryValidators returns a page of the validators with the requested status,
+// in the order of their owner addresses
+func queryValidators(ctx sdk.Context, req abci.RequestQuery, k Keeper) (res []byte, err sdk.Error) {
+	var params QueryValidatorsParams
+	err = k.unmarshalQueryParams(req, &params)
+	if err != nil {
+		return nil, err
+	}
+	err = params.Pagination.ValidateBasic()
+	if err != nil {
+		return nil, err
+	}
+	if !ValidBondStatusFilter(params.Status) {
+		return nil, sdk.ErrUnknownRequest(fmt.Sprintf("invalid validator status %s", params.Status))
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, ValidatorsKey)
+	defer iterator.Close()
+
+	paginator := sdk.NewPaginator(params.Pagination)
+	validators := []types.Validator{}
+	for ; iterator.Valid() && !paginator.Done(); iterator.Next() {
+		addr := iterator.Key()[1:]
+		validator := types.MustUnmarshalValidator(k.cdc, addr, iterator.Value())
+		if params.Status != "" && sdk.BondStatusToString(validator.Status) != params.Status {
+			continue
+		}
+		if paginator.Next() {
+			validators = append(validators, validator)
+		}
+	}
+
+	return k.marshalQueryResult(validators)
+}
+
+// queryDelegatorDelegations returns a page of the delegations of a delegator
+func queryDelegatorDelegations(ctx sdk.Context, req abci.RequestQuery, k Keeper) (res []byte, err sdk.Error) {
+	var params QueryDelegatorParams
+	err = k.unmarshalDelegatorParams(req, &params)
+	if err != nil {
+		return nil, err
+	}
+
+	delegations := []types.Delegation{}
+	k.iteratePage(ctx, GetDelegationsKey(params.DelegatorAddr), params.Pagination, func(key, value []byte) {
+		delegations = append(delegations, types.MustUnmarshalDelegation(k.cdc, key, value))
+	})
+
+	return k.marshalQueryResult(delegations)
+}
+
+// queryDelegatorUnbondingDelegations returns a page of the unbonding
+// delegations of a delegator
+func queryDelegatorUnbondingDelegations(ctx sdk.Context, req abci.RequestQuery, k Keeper) (res []byte, err sdk.Error) {
+	var params QueryDelegatorParams
+	err = k.unmarshalDelegatorParams(req, &params)
+	if err != nil {
+		return nil, err
+	}
+
+	ubds := []types.UnbondingDelegation{}
+	k.iteratePage(ctx, GetUBDsKey(params.DelegatorAddr), params.Pagination, func(key, value []byte) {
+		ubds = append(ubds, types.MustUnmarshalUBD(k.cdc, key, value))
+	})
+
+	return k.marshalQueryResult(ubds)
+}
+
+// queryDelegatorRedelegations returns a page of the redelegations of a
+// delegator
+func queryDelegatorRedelegations(ctx sdk.Context, req abci.RequestQuery, k Keeper) (res []byte, err sdk.Error) {
+	var params QueryDelegatorParams
+	err = k.unmarshalDelegatorParams(req, &params)
+	if err != nil {
+		return nil, err
+	}
+
+	reds := []types.Redelegation{}
+	k.iteratePage(ctx, GetREDsKey(params.DelegatorAddr), params.Pagination, func(key, value []byte) {
+		reds = append(reds, types.MustUnmarshalRED(k.cdc, key, value))
+	})
+
+	return k.marshalQueryResult(reds)
+}
+
+//______________________________________________________________________
+
+// iteratePage calls process with the records under the prefix which are on
+// the requested page, without reading the records after it
+func (k Keeper) iteratePage(ctx sdk.Context, prefix []byte, pagination sdk.PaginationParams, process func(key, value []byte)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
+	defer iterator.Close()
+
+	paginator := sdk.NewPaginator(pagination)
+	for ; iterator.Valid() && !paginator.Done(); iterator.Next() {
+		if paginator.Next() {
+			process(iterator.Key(), iterator.Value())
+		}
+	}
+}
+
+func (k Keeper) unmarshalDelegatorParams(req abci.RequestQuery, params *QueryDelegatorParams) sdk.Error {
+	err := k.unmarshalQueryParams(req, params)
+	if err != nil {
+		return err
+	}
+	if len(params.DelegatorAddr) == 0 {
+		return types.ErrNilDelegatorAddr(k.codespace)
+	}
+	return params.Pagination.ValidateBasic()
+}
+
+func (k Keeper) unmarshalQueryParams(req abci.RequestQuery, params interface{}) sdk.Error {
+	err := k.cdc.UnmarshalJSON(req.Data, params)
+	if err != nil {
+		return sdk.ErrUnknownRequest(fmt.Sprintf("incorrectly formatted request data - %s", err.Error()))
+	}
+	return nil
+}
+
+func (k Keeper) marshalQueryResult(result interface{}) ([]byte, sdk.Error) {
+	bz, err := k.cdc.MarshalJSON(result)
+	if err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("could not marshal the query result - %s", err.Error()))
+	}
+	return bz, nil
+}
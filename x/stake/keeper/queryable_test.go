@@ -0,0 +1,103 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/stake/types"
+)
+
+func query(t *testing.T, ctx sdk.Context, keeper Keeper, endpoint string, params interface{}) ([]byte, sdk.Error) {
+	bz, err := keeper.cdc.MarshalJSON(params)
+	require.Nil(t, err)
+	return NewQuerier(keeper)(ctx, []string{endpoint}, abci.RequestQuery{Data: bz})
+}
+
+func TestQueryValidators(t *testing.T) {
+	ctx, _, keeper := CreateTestInput(t, false, 0)
+
+	for i := 0; i < 3; i++ {
+		validator := types.NewValidator(addrVals[i], PKs[i], types.Description{})
+		if i == 1 {
+			validator.Status = sdk.Bonded
+		}
+		keeper.SetValidator(ctx, validator)
+	}
+
+	queryValidators := func(status string, page, limit int) []types.Validator {
+		res, err := query(t, ctx, keeper, QueryValidators, QueryValidatorsParams{status, sdk.NewPaginationParams(page, limit)})
+		require.Nil(t, err)
+		var validators []types.Validator
+		require.Nil(t, keeper.cdc.UnmarshalJSON(res, &validators))
+		return validators
+	}
+
+	require.Len(t, queryValidators("", 1, 0), 3)
+	require.Len(t, queryValidators("", 1, 2), 2)
+	require.Len(t, queryValidators("", 2, 2), 1)
+	require.Len(t, queryValidators("", 3, 2), 0)
+
+	bonded := queryValidators("Bonded", 1, 0)
+	require.Len(t, bonded, 1)
+	require.Equal(t, addrVals[1], bonded[0].Owner)
+	require.Len(t, queryValidators("Unbonded", 1, 0), 2)
+	require.Len(t, queryValidators("Unbonded", 2, 1), 1)
+	require.Len(t, queryValidators("Unbonding", 1, 0), 0)
+
+	// invalid status
+	_, err := query(t, ctx, keeper, QueryValidators, QueryValidatorsParams{"Jailed", sdk.NewPaginationParams(1, 0)})
+	require.NotNil(t, err)
+}
+
+func TestQueryDelegatorRecords(t *testing.T) {
+	ctx, _, keeper := CreateTestInput(t, false, 0)
+
+	for i := 0; i < 3; i++ {
+		keeper.SetDelegation(ctx, types.Delegation{
+			DelegatorAddr: addrDels[0],
+			ValidatorAddr: addrVals[i],
+			Shares:        sdk.NewDec(int64(i + 1)),
+		})
+	}
+	keeper.SetUnbondingDelegation(ctx, types.UnbondingDelegation{
+		DelegatorAddr: addrDels[0],
+		ValidatorAddr: addrVals[0],
+		Balance:       sdk.NewCoin("steak", 5),
+	})
+
+	queryDelegations := func(delegator sdk.AccAddress, page, limit int) []types.Delegation {
+		res, err := query(t, ctx, keeper, QueryDelegatorDelegations, QueryDelegatorParams{delegator, sdk.NewPaginationParams(page, limit)})
+		require.Nil(t, err)
+		var delegations []types.Delegation
+		require.Nil(t, keeper.cdc.UnmarshalJSON(res, &delegations))
+		return delegations
+	}
+
+	require.Len(t, queryDelegations(addrDels[0], 1, 0), 3)
+	require.Len(t, queryDelegations(addrDels[0], 1, 2), 2)
+	delegations := queryDelegations(addrDels[0], 2, 2)
+	require.Len(t, delegations, 1)
+	require.Equal(t, addrDels[0], delegations[0].DelegatorAddr)
+	require.Len(t, queryDelegations(addrDels[1], 1, 0), 0)
+
+	res, err := query(t, ctx, keeper, QueryDelegatorUnbondingDelegations, QueryDelegatorParams{addrDels[0], sdk.NewPaginationParams(1, 0)})
+	require.Nil(t, err)
+	var ubds []types.UnbondingDelegation
+	require.Nil(t, keeper.cdc.UnmarshalJSON(res, &ubds))
+	require.Len(t, ubds, 1)
+	require.Equal(t, addrVals[0], ubds[0].ValidatorAddr)
+
+	res, err = query(t, ctx, keeper, QueryDelegatorRedelegations, QueryDelegatorParams{addrDels[0], sdk.NewPaginationParams(1, 0)})
+	require.Nil(t, err)
+	var reds []types.Redelegation
+	require.Nil(t, keeper.cdc.UnmarshalJSON(res, &reds))
+	require.Len(t, reds, 0)
+
+	// the delegator is required
+	_, err = query(t, ctx, keeper, QueryDelegatorDelegations, QueryDelegatorParams{nil, sdk.NewPaginationParams(1, 0)})
+	require.NotNil(t, err)
+}
@@ -57,8 +57,17 @@ func (k Keeper) Validator(ctx sdk.Context, address sdk.AccAddress) sdk.Validator
 	return val
 }
 
+// get the sdk.validator for a particular consensus address
+func (k Keeper) ValidatorByConsAddr(ctx sdk.Context, consAddr sdk.ConsAddress) sdk.Validator {
+	val, found := k.GetValidatorByConsAddr(ctx, consAddr)
+	if !found {
+		return nil
+	}
+	return val
+}
+
 // total power from the bond
-func (k Keeper) TotalPower(ctx sdk.Context) sdk.Rat {
+func (k Keeper) TotalPower(ctx sdk.Context) sdk.Dec {
 	pool := k.GetPool(ctx)
 	return pool.BondedTokens
 }
@@ -18,7 +18,7 @@ func setupHelper(t *testing.T, amt int64) (sdk.Context, Keeper, types.Params) {
 	params := keeper.GetParams(ctx)
 	pool := keeper.GetPool(ctx)
 	numVals := 3
-	pool.LooseTokens = sdk.NewRat(amt * int64(numVals))
+	pool.LooseTokens = sdk.NewDec(amt * int64(numVals))
 
 	// add numVals validators
 	for i := 0; i < numVals; i++ {
@@ -26,7 +26,7 @@ func setupHelper(t *testing.T, amt int64) (sdk.Context, Keeper, types.Params) {
 		validator, pool, _ = validator.AddTokensFromDel(pool, amt)
 		keeper.SetPool(ctx, pool)
 		validator = keeper.UpdateValidator(ctx, validator)
-		keeper.SetValidatorByPubKeyIndex(ctx, validator)
+		keeper.SetValidatorByConsAddr(ctx, validator)
 	}
 	pool = keeper.GetPool(ctx)
 
@@ -38,7 +38,7 @@ func TestRevocation(t *testing.T) {
 	// setup
 	ctx, keeper, _ := setupHelper(t, 10)
 	addr := addrVals[0]
-	pk := PKs[0]
+	consAddr := sdk.ConsAddress(PKs[0].Address())
 
 	// initial state
 	val, found := keeper.GetValidator(ctx, addr)
@@ -46,13 +46,13 @@ func TestRevocation(t *testing.T) {
 	require.False(t, val.GetRevoked())
 
 	// test revoke
-	keeper.Revoke(ctx, pk)
+	keeper.Revoke(ctx, consAddr)
 	val, found = keeper.GetValidator(ctx, addr)
 	require.True(t, found)
 	require.True(t, val.GetRevoked())
 
 	// test unrevoke
-	keeper.Unrevoke(ctx, pk)
+	keeper.Unrevoke(ctx, consAddr)
 	val, found = keeper.GetValidator(ctx, addr)
 	require.True(t, found)
 	require.False(t, val.GetRevoked())
@@ -62,7 +62,7 @@ func TestRevocation(t *testing.T) {
 // tests slashUnbondingDelegation
 func TestSlashUnbondingDelegation(t *testing.T) {
 	ctx, keeper, params := setupHelper(t, 10)
-	fraction := sdk.NewRat(1, 2)
+	fraction := sdk.NewDecWithPrec(5, 1)
 
 	// set an unbonding delegation
 	ubd := types.UnbondingDelegation{
@@ -105,7 +105,7 @@ func TestSlashUnbondingDelegation(t *testing.T) {
 // tests slashRedelegation
 func TestSlashRedelegation(t *testing.T) {
 	ctx, keeper, params := setupHelper(t, 10)
-	fraction := sdk.NewRat(1, 2)
+	fraction := sdk.NewDecWithPrec(5, 1)
 
 	// set a redelegation
 	rd := types.Redelegation{
@@ -115,8 +115,8 @@ func TestSlashRedelegation(t *testing.T) {
 		CreationHeight:   0,
 		// expiration timestamp (beyond which the redelegation shouldn't be slashed)
 		MinTime:        0,
-		SharesSrc:      sdk.NewRat(10),
-		SharesDst:      sdk.NewRat(10),
+		SharesSrc:      sdk.NewDec(10),
+		SharesDst:      sdk.NewDec(10),
 		InitialBalance: sdk.NewCoin(params.BondDenom, 10),
 		Balance:        sdk.NewCoin(params.BondDenom, 10),
 	}
@@ -126,7 +126,7 @@ func TestSlashRedelegation(t *testing.T) {
 	del := types.Delegation{
 		DelegatorAddr: addrDels[0],
 		ValidatorAddr: addrVals[1],
-		Shares:        sdk.NewRat(10),
+		Shares:        sdk.NewDec(10),
 	}
 	keeper.SetDelegation(ctx, del)
 
@@ -170,38 +170,41 @@ func TestSlashRedelegation(t *testing.T) {
 // tests Slash at a future height (must panic)
 func TestSlashAtFutureHeight(t *testing.T) {
 	ctx, keeper, _ := setupHelper(t, 10)
-	pk := PKs[0]
-	fraction := sdk.NewRat(1, 2)
-	require.Panics(t, func() { keeper.Slash(ctx, pk, 1, 10, fraction) })
+	consAddr := sdk.ConsAddress(PKs[0].Address())
+	fraction := sdk.NewDecWithPrec(5, 1)
+	require.Panics(t, func() { keeper.Slash(ctx, consAddr, 1, 10, fraction) })
 }
 
 // tests Slash at the current height
 func TestSlashAtCurrentHeight(t *testing.T) {
-	ctx, keeper, _ := setupHelper(t, 10)
-	pk := PKs[0]
-	fraction := sdk.NewRat(1, 2)
+	ctx, keeper, params := setupHelper(t, 10)
+	consAddr := sdk.ConsAddress(PKs[0].Address())
+	fraction := sdk.NewDecWithPrec(5, 1)
 
 	oldPool := keeper.GetPool(ctx)
-	validator, found := keeper.GetValidatorByPubKey(ctx, pk)
+	oldSupply := keeper.coinKeeper.GetSupply(ctx, params.BondDenom)
+	validator, found := keeper.GetValidatorByConsAddr(ctx, consAddr)
 	require.True(t, found)
-	keeper.Slash(ctx, pk, ctx.BlockHeight(), 10, fraction)
+	keeper.Slash(ctx, consAddr, ctx.BlockHeight(), 10, fraction)
 
 	// read updated state
-	validator, found = keeper.GetValidatorByPubKey(ctx, pk)
+	validator, found = keeper.GetValidatorByConsAddr(ctx, consAddr)
 	require.True(t, found)
 	newPool := keeper.GetPool(ctx)
 
 	// power decreased
-	require.Equal(t, sdk.NewRat(5), validator.GetPower())
+	require.Equal(t, sdk.NewDec(5), validator.GetPower())
 	// pool bonded shares decreased
-	require.Equal(t, sdk.NewRat(5).RoundInt64(), oldPool.BondedTokens.Sub(newPool.BondedTokens).RoundInt64())
+	require.Equal(t, sdk.NewDec(5).RoundInt64(), oldPool.BondedTokens.Sub(newPool.BondedTokens).RoundInt64())
+	// the burned tokens are removed from the supply
+	require.True(t, oldSupply.Sub(sdk.NewInt(5)).Equal(keeper.coinKeeper.GetSupply(ctx, params.BondDenom)))
 }
 
 // tests Slash at a previous height with an unbonding delegation
 func TestSlashWithUnbondingDelegation(t *testing.T) {
 	ctx, keeper, params := setupHelper(t, 10)
-	pk := PKs[0]
-	fraction := sdk.NewRat(1, 2)
+	consAddr := sdk.ConsAddress(PKs[0].Address())
+	fraction := sdk.NewDecWithPrec(5, 1)
 
 	// set an unbonding delegation
 	ubd := types.UnbondingDelegation{
@@ -218,9 +221,9 @@ func TestSlashWithUnbondingDelegation(t *testing.T) {
 	// slash validator for the first time
 	ctx = ctx.WithBlockHeight(12)
 	oldPool := keeper.GetPool(ctx)
-	validator, found := keeper.GetValidatorByPubKey(ctx, pk)
+	validator, found := keeper.GetValidatorByConsAddr(ctx, consAddr)
 	require.True(t, found)
-	keeper.Slash(ctx, pk, 10, 10, fraction)
+	keeper.Slash(ctx, consAddr, 10, 10, fraction)
 
 	// read updating unbonding delegation
 	ubd, found = keeper.GetUnbondingDelegation(ctx, addrDels[0], addrVals[0])
@@ -232,17 +235,17 @@ func TestSlashWithUnbondingDelegation(t *testing.T) {
 	// bonded tokens burned
 	require.Equal(t, int64(3), oldPool.BondedTokens.Sub(newPool.BondedTokens).RoundInt64())
 	// read updated validator
-	validator, found = keeper.GetValidatorByPubKey(ctx, pk)
+	validator, found = keeper.GetValidatorByConsAddr(ctx, consAddr)
 	require.True(t, found)
 	// power decreased by 3 - 6 stake originally bonded at the time of infraction
 	// was still bonded at the time of discovery and was slashed by half, 4 stake
 	// bonded at the time of discovery hadn't been bonded at the time of infraction
 	// and wasn't slashed
-	require.Equal(t, sdk.NewRat(7), validator.GetPower())
+	require.Equal(t, sdk.NewDec(7), validator.GetPower())
 
 	// slash validator again
 	ctx = ctx.WithBlockHeight(13)
-	keeper.Slash(ctx, pk, 9, 10, fraction)
+	keeper.Slash(ctx, consAddr, 9, 10, fraction)
 	ubd, found = keeper.GetUnbondingDelegation(ctx, addrDels[0], addrVals[0])
 	require.True(t, found)
 	// balance decreased again
@@ -252,17 +255,17 @@ func TestSlashWithUnbondingDelegation(t *testing.T) {
 	// bonded tokens burned again
 	require.Equal(t, int64(6), oldPool.BondedTokens.Sub(newPool.BondedTokens).RoundInt64())
 	// read updated validator
-	validator, found = keeper.GetValidatorByPubKey(ctx, pk)
+	validator, found = keeper.GetValidatorByConsAddr(ctx, consAddr)
 	require.True(t, found)
 	// power decreased by 3 again
-	require.Equal(t, sdk.NewRat(4), validator.GetPower())
+	require.Equal(t, sdk.NewDec(4), validator.GetPower())
 
 	// slash validator again
 	// all originally bonded stake has been slashed, so this will have no effect
 	// on the unbonding delegation, but it will slash stake bonded since the infraction
 	// this may not be the desirable behaviour, ref https://github.com/cosmos/cosmos-sdk/issues/1440
 	ctx = ctx.WithBlockHeight(13)
-	keeper.Slash(ctx, pk, 9, 10, fraction)
+	keeper.Slash(ctx, consAddr, 9, 10, fraction)
 	ubd, found = keeper.GetUnbondingDelegation(ctx, addrDels[0], addrVals[0])
 	require.True(t, found)
 	// balance unchanged
@@ -272,17 +275,17 @@ func TestSlashWithUnbondingDelegation(t *testing.T) {
 	// bonded tokens burned again
 	require.Equal(t, int64(9), oldPool.BondedTokens.Sub(newPool.BondedTokens).RoundInt64())
 	// read updated validator
-	validator, found = keeper.GetValidatorByPubKey(ctx, pk)
+	validator, found = keeper.GetValidatorByConsAddr(ctx, consAddr)
 	require.True(t, found)
 	// power decreased by 3 again
-	require.Equal(t, sdk.NewRat(1), validator.GetPower())
+	require.Equal(t, sdk.NewDec(1), validator.GetPower())
 
 	// slash validator again
 	// all originally bonded stake has been slashed, so this will have no effect
 	// on the unbonding delegation, but it will slash stake bonded since the infraction
 	// this may not be the desirable behaviour, ref https://github.com/cosmos/cosmos-sdk/issues/1440
 	ctx = ctx.WithBlockHeight(13)
-	keeper.Slash(ctx, pk, 9, 10, fraction)
+	keeper.Slash(ctx, consAddr, 9, 10, fraction)
 	ubd, found = keeper.GetUnbondingDelegation(ctx, addrDels[0], addrVals[0])
 	require.True(t, found)
 	// balance unchanged
@@ -294,15 +297,15 @@ func TestSlashWithUnbondingDelegation(t *testing.T) {
 	// read updated validator
 	// power decreased by 1 again, validator is out of stake
 	// ergo validator should have been removed from the store
-	_, found = keeper.GetValidatorByPubKey(ctx, pk)
+	_, found = keeper.GetValidatorByConsAddr(ctx, consAddr)
 	require.False(t, found)
 }
 
 // tests Slash at a previous height with a redelegation
 func TestSlashWithRedelegation(t *testing.T) {
 	ctx, keeper, params := setupHelper(t, 10)
-	pk := PKs[0]
-	fraction := sdk.NewRat(1, 2)
+	consAddr := sdk.ConsAddress(PKs[0].Address())
+	fraction := sdk.NewDecWithPrec(5, 1)
 
 	// set a redelegation
 	rd := types.Redelegation{
@@ -311,8 +314,8 @@ func TestSlashWithRedelegation(t *testing.T) {
 		ValidatorDstAddr: addrVals[1],
 		CreationHeight:   11,
 		MinTime:          0,
-		SharesSrc:        sdk.NewRat(6),
-		SharesDst:        sdk.NewRat(6),
+		SharesSrc:        sdk.NewDec(6),
+		SharesDst:        sdk.NewDec(6),
 		InitialBalance:   sdk.NewCoin(params.BondDenom, 6),
 		Balance:          sdk.NewCoin(params.BondDenom, 6),
 	}
@@ -322,21 +325,21 @@ func TestSlashWithRedelegation(t *testing.T) {
 	del := types.Delegation{
 		DelegatorAddr: addrDels[0],
 		ValidatorAddr: addrVals[1],
-		Shares:        sdk.NewRat(6),
+		Shares:        sdk.NewDec(6),
 	}
 	keeper.SetDelegation(ctx, del)
 
 	// update bonded tokens
 	pool := keeper.GetPool(ctx)
-	pool.BondedTokens = pool.BondedTokens.Add(sdk.NewRat(6))
+	pool.BondedTokens = pool.BondedTokens.Add(sdk.NewDec(6))
 	keeper.SetPool(ctx, pool)
 
 	// slash validator
 	ctx = ctx.WithBlockHeight(12)
 	oldPool := keeper.GetPool(ctx)
-	validator, found := keeper.GetValidatorByPubKey(ctx, pk)
+	validator, found := keeper.GetValidatorByConsAddr(ctx, consAddr)
 	require.True(t, found)
-	keeper.Slash(ctx, pk, 10, 10, fraction)
+	keeper.Slash(ctx, consAddr, 10, 10, fraction)
 
 	// read updating redelegation
 	rd, found = keeper.GetRedelegation(ctx, addrDels[0], addrVals[0], addrVals[1])
@@ -348,19 +351,19 @@ func TestSlashWithRedelegation(t *testing.T) {
 	// bonded tokens burned
 	require.Equal(t, int64(5), oldPool.BondedTokens.Sub(newPool.BondedTokens).RoundInt64())
 	// read updated validator
-	validator, found = keeper.GetValidatorByPubKey(ctx, pk)
+	validator, found = keeper.GetValidatorByConsAddr(ctx, consAddr)
 	require.True(t, found)
 	// power decreased by 2 - 4 stake originally bonded at the time of infraction
 	// was still bonded at the time of discovery and was slashed by half, 4 stake
 	// bonded at the time of discovery hadn't been bonded at the time of infraction
 	// and wasn't slashed
-	require.Equal(t, sdk.NewRat(8), validator.GetPower())
+	require.Equal(t, sdk.NewDec(8), validator.GetPower())
 
 	// slash the validator again
 	ctx = ctx.WithBlockHeight(12)
-	validator, found = keeper.GetValidatorByPubKey(ctx, pk)
+	validator, found = keeper.GetValidatorByConsAddr(ctx, consAddr)
 	require.True(t, found)
-	require.NotPanics(t, func() { keeper.Slash(ctx, pk, 10, 10, sdk.OneRat()) })
+	require.NotPanics(t, func() { keeper.Slash(ctx, consAddr, 10, 10, sdk.OneDec()) })
 
 	// read updating redelegation
 	rd, found = keeper.GetRedelegation(ctx, addrDels[0], addrVals[0], addrVals[1])
@@ -372,16 +375,16 @@ func TestSlashWithRedelegation(t *testing.T) {
 	// seven bonded tokens burned
 	require.Equal(t, int64(12), oldPool.BondedTokens.Sub(newPool.BondedTokens).RoundInt64())
 	// read updated validator
-	validator, found = keeper.GetValidatorByPubKey(ctx, pk)
+	validator, found = keeper.GetValidatorByConsAddr(ctx, consAddr)
 	require.True(t, found)
 	// power decreased by 4
-	require.Equal(t, sdk.NewRat(4), validator.GetPower())
+	require.Equal(t, sdk.NewDec(4), validator.GetPower())
 
 	// slash the validator again, by 100%
 	ctx = ctx.WithBlockHeight(12)
-	validator, found = keeper.GetValidatorByPubKey(ctx, pk)
+	validator, found = keeper.GetValidatorByConsAddr(ctx, consAddr)
 	require.True(t, found)
-	keeper.Slash(ctx, pk, 10, 10, sdk.OneRat())
+	keeper.Slash(ctx, consAddr, 10, 10, sdk.OneDec())
 
 	// read updating redelegation
 	rd, found = keeper.GetRedelegation(ctx, addrDels[0], addrVals[0], addrVals[1])
@@ -394,16 +397,16 @@ func TestSlashWithRedelegation(t *testing.T) {
 	require.Equal(t, int64(16), oldPool.BondedTokens.Sub(newPool.BondedTokens).RoundInt64())
 	// read updated validator
 	// validator decreased to zero power, should have been removed from the store
-	_, found = keeper.GetValidatorByPubKey(ctx, pk)
+	_, found = keeper.GetValidatorByConsAddr(ctx, consAddr)
 	require.False(t, found)
 
 	// slash the validator again, by 100%
 	// no stake remains to be slashed
 	ctx = ctx.WithBlockHeight(12)
 	// validator no longer in the store
-	_, found = keeper.GetValidatorByPubKey(ctx, pk)
+	_, found = keeper.GetValidatorByConsAddr(ctx, consAddr)
 	require.False(t, found)
-	keeper.Slash(ctx, pk, 10, 10, sdk.OneRat())
+	keeper.Slash(ctx, consAddr, 10, 10, sdk.OneDec())
 
 	// read updating redelegation
 	rd, found = keeper.GetRedelegation(ctx, addrDels[0], addrVals[0], addrVals[1])
@@ -416,14 +419,14 @@ func TestSlashWithRedelegation(t *testing.T) {
 	require.Equal(t, int64(16), oldPool.BondedTokens.Sub(newPool.BondedTokens).RoundInt64())
 	// read updated validator
 	// power still zero, still not in the store
-	_, found = keeper.GetValidatorByPubKey(ctx, pk)
+	_, found = keeper.GetValidatorByConsAddr(ctx, consAddr)
 	require.False(t, found)
 }
 
 // tests Slash at a previous height with both an unbonding delegation and a redelegation
 func TestSlashBoth(t *testing.T) {
 	ctx, keeper, params := setupHelper(t, 10)
-	fraction := sdk.NewRat(1, 2)
+	fraction := sdk.NewDecWithPrec(5, 1)
 
 	// set a redelegation
 	rdA := types.Redelegation{
@@ -433,8 +436,8 @@ func TestSlashBoth(t *testing.T) {
 		CreationHeight:   11,
 		// expiration timestamp (beyond which the redelegation shouldn't be slashed)
 		MinTime:        0,
-		SharesSrc:      sdk.NewRat(6),
-		SharesDst:      sdk.NewRat(6),
+		SharesSrc:      sdk.NewDec(6),
+		SharesDst:      sdk.NewDec(6),
 		InitialBalance: sdk.NewCoin(params.BondDenom, 6),
 		Balance:        sdk.NewCoin(params.BondDenom, 6),
 	}
@@ -444,7 +447,7 @@ func TestSlashBoth(t *testing.T) {
 	delA := types.Delegation{
 		DelegatorAddr: addrDels[0],
 		ValidatorAddr: addrVals[1],
-		Shares:        sdk.NewRat(6),
+		Shares:        sdk.NewDec(6),
 	}
 	keeper.SetDelegation(ctx, delA)
 
@@ -463,9 +466,9 @@ func TestSlashBoth(t *testing.T) {
 	// slash validator
 	ctx = ctx.WithBlockHeight(12)
 	oldPool := keeper.GetPool(ctx)
-	validator, found := keeper.GetValidatorByPubKey(ctx, PKs[0])
+	validator, found := keeper.GetValidatorByConsAddr(ctx, sdk.ConsAddress(PKs[0].Address()))
 	require.True(t, found)
-	keeper.Slash(ctx, PKs[0], 10, 10, fraction)
+	keeper.Slash(ctx, sdk.ConsAddress(PKs[0].Address()), 10, 10, fraction)
 
 	// read updating redelegation
 	rdA, found = keeper.GetRedelegation(ctx, addrDels[0], addrVals[0], addrVals[1])
@@ -479,8 +482,8 @@ func TestSlashBoth(t *testing.T) {
 	// bonded tokens burned
 	require.Equal(t, int64(3), oldPool.BondedTokens.Sub(newPool.BondedTokens).RoundInt64())
 	// read updated validator
-	validator, found = keeper.GetValidatorByPubKey(ctx, PKs[0])
+	validator, found = keeper.GetValidatorByConsAddr(ctx, sdk.ConsAddress(PKs[0].Address()))
 	require.True(t, found)
 	// power not decreased, all stake was bonded since
-	require.Equal(t, sdk.NewRat(10), validator.GetPower())
+	require.Equal(t, sdk.NewDec(10), validator.GetPower())
 }
@@ -5,7 +5,6 @@ import (
 	"fmt"
 
 	abci "github.com/tendermint/tendermint/abci/types"
-	"github.com/tendermint/tendermint/crypto"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/stake/types"
@@ -22,10 +21,10 @@ func (k Keeper) GetValidator(ctx sdk.Context, addr sdk.AccAddress) (validator ty
 	return validator, true
 }
 
-// get a single validator by pubkey
-func (k Keeper) GetValidatorByPubKey(ctx sdk.Context, pubkey crypto.PubKey) (validator types.Validator, found bool) {
+// get a single validator by consensus address
+func (k Keeper) GetValidatorByConsAddr(ctx sdk.Context, consAddr sdk.ConsAddress) (validator types.Validator, found bool) {
 	store := ctx.KVStore(k.storeKey)
-	addr := store.Get(GetValidatorByPubKeyIndexKey(pubkey))
+	addr := store.Get(GetValidatorByConsAddrKey(consAddr))
 	if addr == nil {
 		return validator, false
 	}
@@ -40,9 +39,9 @@ func (k Keeper) SetValidator(ctx sdk.Context, validator types.Validator) {
 }
 
 // validator index
-func (k Keeper) SetValidatorByPubKeyIndex(ctx sdk.Context, validator types.Validator) {
+func (k Keeper) SetValidatorByConsAddr(ctx sdk.Context, validator types.Validator) {
 	store := ctx.KVStore(k.storeKey)
-	store.Set(GetValidatorByPubKeyIndexKey(validator.PubKey), validator.Owner)
+	store.Set(GetValidatorByConsAddrKey(validator.GetConsAddr()), validator.Owner)
 }
 
 // validator index
@@ -164,9 +163,9 @@ func (k Keeper) GetValidatorsByPower(ctx sdk.Context) []types.Validator {
 
 // get the most recently updated validators
 func (k Keeper) GetTendermintUpdates(ctx sdk.Context) (updates []abci.Validator) {
-	store := ctx.KVStore(k.storeKey)
+	tstore := ctx.KVStore(k.storeTKey)
 
-	iterator := sdk.KVStorePrefixIterator(store, TendermintUpdatesKey) //smallest to largest
+	iterator := sdk.KVStorePrefixIterator(tstore, TendermintUpdatesKey) //smallest to largest
 	for ; iterator.Valid(); iterator.Next() {
 		valBytes := iterator.Value()
 		var val abci.Validator
@@ -179,12 +178,12 @@ func (k Keeper) GetTendermintUpdates(ctx sdk.Context) (updates []abci.Validator)
 
 // remove all validator update entries after applied to Tendermint
 func (k Keeper) ClearTendermintUpdates(ctx sdk.Context) {
-	store := ctx.KVStore(k.storeKey)
+	tstore := ctx.KVStore(k.storeTKey)
 
 	// delete subspace
-	iterator := sdk.KVStorePrefixIterator(store, TendermintUpdatesKey)
+	iterator := sdk.KVStorePrefixIterator(tstore, TendermintUpdatesKey)
 	for ; iterator.Valid(); iterator.Next() {
-		store.Delete(iterator.Key())
+		tstore.Delete(iterator.Key())
 	}
 	iterator.Close()
 }
@@ -199,7 +198,7 @@ func (k Keeper) ClearTendermintUpdates(ctx sdk.Context) {
 // nolint: gocyclo
 // TODO: Remove above nolint, function needs to be simplified
 func (k Keeper) UpdateValidator(ctx sdk.Context, validator types.Validator) types.Validator {
-	store := ctx.KVStore(k.storeKey)
+	tstore := ctx.KVStore(k.storeTKey)
 	pool := k.GetPool(ctx)
 	oldValidator, oldFound := k.GetValidator(ctx, validator.Owner)
 
@@ -215,7 +214,7 @@ func (k Keeper) UpdateValidator(ctx sdk.Context, validator types.Validator) type
 		(oldFound && oldValidator.Status == sdk.Bonded):
 
 		bz := k.cdc.MustMarshalBinary(validator.ABCIValidator())
-		store.Set(GetTendermintUpdatesKey(validator.Owner), bz)
+		tstore.Set(GetTendermintUpdatesKey(validator.Owner), bz)
 
 	// if is a new validator and the new power is less than the cliff validator
 	case cliffPower != nil && !oldFound &&
@@ -244,7 +243,7 @@ func (k Keeper) UpdateValidator(ctx sdk.Context, validator types.Validator) type
 		// if decreased in power but still bonded, update Tendermint validator
 		if oldFound && oldValidator.BondedTokens().GT(validator.BondedTokens()) {
 			bz := k.cdc.MustMarshalBinary(validator.ABCIValidator())
-			store.Set(GetTendermintUpdatesKey(validator.Owner), bz)
+			tstore.Set(GetTendermintUpdatesKey(validator.Owner), bz)
 		}
 
 	}
@@ -496,7 +495,8 @@ func (k Keeper) unbondValidator(ctx sdk.Context, validator types.Validator) type
 
 	// add to accumulated changes for tendermint
 	bzABCI := k.cdc.MustMarshalBinary(validator.ABCIValidatorZero())
-	store.Set(GetTendermintUpdatesKey(validator.Owner), bzABCI)
+	tstore := ctx.KVStore(k.storeTKey)
+	tstore.Set(GetTendermintUpdatesKey(validator.Owner), bzABCI)
 
 	// also remove from the Bonded types.Validators Store
 	store.Delete(GetValidatorsBondedIndexKey(validator.Owner))
@@ -524,7 +524,8 @@ func (k Keeper) bondValidator(ctx sdk.Context, validator types.Validator) types.
 
 	// add to accumulated changes for tendermint
 	bzABCI := k.cdc.MustMarshalBinary(validator.ABCIValidator())
-	store.Set(GetTendermintUpdatesKey(validator.Owner), bzABCI)
+	tstore := ctx.KVStore(k.storeTKey)
+	tstore.Set(GetTendermintUpdatesKey(validator.Owner), bzABCI)
 
 	return validator
 }
@@ -542,7 +543,7 @@ func (k Keeper) RemoveValidator(ctx sdk.Context, address sdk.AccAddress) {
 	store := ctx.KVStore(k.storeKey)
 	pool := k.GetPool(ctx)
 	store.Delete(GetValidatorKey(address))
-	store.Delete(GetValidatorByPubKeyIndexKey(validator.PubKey))
+	store.Delete(GetValidatorByConsAddrKey(validator.GetConsAddr()))
 	store.Delete(GetValidatorsByPowerIndexKey(validator, pool))
 
 	// delete from the current and power weighted validator groups if the validator
@@ -553,7 +554,8 @@ func (k Keeper) RemoveValidator(ctx sdk.Context, address sdk.AccAddress) {
 	store.Delete(GetValidatorsBondedIndexKey(validator.Owner))
 
 	bz := k.cdc.MustMarshalBinary(validator.ABCIValidatorZero())
-	store.Set(GetTendermintUpdatesKey(address), bz)
+	tstore := ctx.KVStore(k.storeTKey)
+	tstore.Set(GetTendermintUpdatesKey(address), bz)
 }
 
 //__________________________________________________________________________
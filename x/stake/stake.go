@@ -3,7 +3,6 @@ package stake
 
 import (
 	"github.com/cosmos/cosmos-sdk/x/stake/keeper"
-	"github.com/cosmos/cosmos-sdk/x/stake/tags"
 	"github.com/cosmos/cosmos-sdk/x/stake/types"
 )
 
@@ -25,13 +24,19 @@ type (
 	MsgBeginRedelegate    = types.MsgBeginRedelegate
 	MsgCompleteRedelegate = types.MsgCompleteRedelegate
 	GenesisState          = types.GenesisState
+
+	QueryValidatorsParams = keeper.QueryValidatorsParams
+	QueryDelegatorParams  = keeper.QueryDelegatorParams
 )
 
 var (
-	NewKeeper = keeper.NewKeeper
+	NewKeeper  = keeper.NewKeeper
+	NewQuerier = keeper.NewQuerier
+
+	ValidBondStatusFilter = keeper.ValidBondStatusFilter
 
 	GetValidatorKey              = keeper.GetValidatorKey
-	GetValidatorByPubKeyIndexKey = keeper.GetValidatorByPubKeyIndexKey
+	GetValidatorByConsAddrKey    = keeper.GetValidatorByConsAddrKey
 	GetValidatorsBondedIndexKey  = keeper.GetValidatorsBondedIndexKey
 	GetValidatorsByPowerIndexKey = keeper.GetValidatorsByPowerIndexKey
 	GetTendermintUpdatesKey      = keeper.GetTendermintUpdatesKey
@@ -40,7 +45,7 @@ var (
 	ParamKey                     = keeper.ParamKey
 	PoolKey                      = keeper.PoolKey
 	ValidatorsKey                = keeper.ValidatorsKey
-	ValidatorsByPubKeyIndexKey   = keeper.ValidatorsByPubKeyIndexKey
+	ValidatorsByConsAddrKey      = keeper.ValidatorsByConsAddrKey
 	ValidatorsBondedIndexKey     = keeper.ValidatorsBondedIndexKey
 	ValidatorsByPowerIndexKey    = keeper.ValidatorsByPowerIndexKey
 	ValidatorCliffIndexKey       = keeper.ValidatorCliffIndexKey
@@ -68,6 +73,8 @@ var (
 	DefaultGenesisState = types.DefaultGenesisState
 	RegisterWire        = types.RegisterWire
 
+	MigrateRatGenesisState = types.MigrateRatGenesisState
+
 	NewMsgCreateValidator           = types.NewMsgCreateValidator
 	NewMsgCreateValidatorOnBehalfOf = types.NewMsgCreateValidatorOnBehalfOf
 	NewMsgEditValidator             = types.NewMsgEditValidator
@@ -122,19 +129,28 @@ var (
 	ErrMissingSignature      = types.ErrMissingSignature
 )
 
-var (
-	ActionCreateValidator      = tags.ActionCreateValidator
-	ActionEditValidator        = tags.ActionEditValidator
-	ActionDelegate             = tags.ActionDelegate
-	ActionBeginUnbonding       = tags.ActionBeginUnbonding
-	ActionCompleteUnbonding    = tags.ActionCompleteUnbonding
-	ActionBeginRedelegation    = tags.ActionBeginRedelegation
-	ActionCompleteRedelegation = tags.ActionCompleteRedelegation
-
-	TagAction       = tags.Action
-	TagSrcValidator = tags.SrcValidator
-	TagDstValidator = tags.DstValidator
-	TagDelegator    = tags.Delegator
-	TagMoniker      = tags.Moniker
-	TagIdentity     = tags.Identity
+const (
+	EventTypeCreateValidator      = types.EventTypeCreateValidator
+	EventTypeEditValidator        = types.EventTypeEditValidator
+	EventTypeDelegate             = types.EventTypeDelegate
+	EventTypeBeginUnbonding       = types.EventTypeBeginUnbonding
+	EventTypeCompleteUnbonding    = types.EventTypeCompleteUnbonding
+	EventTypeBeginRedelegation    = types.EventTypeBeginRedelegation
+	EventTypeCompleteRedelegation = types.EventTypeCompleteRedelegation
+
+	AttributeKeyValidator    = types.AttributeKeyValidator
+	AttributeKeySrcValidator = types.AttributeKeySrcValidator
+	AttributeKeyDstValidator = types.AttributeKeyDstValidator
+	AttributeKeyDelegator    = types.AttributeKeyDelegator
+	AttributeKeyMoniker      = types.AttributeKeyMoniker
+	AttributeKeyIdentity     = types.AttributeKeyIdentity
+	AttributeKeyAmount       = types.AttributeKeyAmount
+	AttributeKeyShares       = types.AttributeKeyShares
+)
+
+const (
+	QueryValidators                    = keeper.QueryValidators
+	QueryDelegatorDelegations          = keeper.QueryDelegatorDelegations
+	QueryDelegatorUnbondingDelegations = keeper.QueryDelegatorUnbondingDelegations
+	QueryDelegatorRedelegations        = keeper.QueryDelegatorRedelegations
 )
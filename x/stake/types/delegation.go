@@ -15,12 +15,12 @@ import (
 type Delegation struct {
 	DelegatorAddr sdk.AccAddress `json:"delegator_addr"`
 	ValidatorAddr sdk.AccAddress `json:"validator_addr"`
-	Shares        sdk.Rat        `json:"shares"`
+	Shares        sdk.Dec        `json:"shares"`
 	Height        int64          `json:"height"` // Last height bond updated
 }
 
 type delegationValue struct {
-	Shares sdk.Rat
+	Shares sdk.Dec
 	Height int64
 }
 
@@ -80,7 +80,7 @@ var _ sdk.Delegation = Delegation{}
 // nolint - for sdk.Delegation
 func (d Delegation) GetDelegator() sdk.AccAddress { return d.DelegatorAddr }
 func (d Delegation) GetValidator() sdk.AccAddress { return d.ValidatorAddr }
-func (d Delegation) GetBondShares() sdk.Rat       { return d.Shares }
+func (d Delegation) GetBondShares() sdk.Dec       { return d.Shares }
 
 // HumanReadableString returns a human readable string representation of a
 // Delegation. An error is returned if the Delegation's delegator or validator
@@ -189,8 +189,8 @@ type Redelegation struct {
 	MinTime          int64          `json:"min_time"`           // unix time for redelegation completion
 	InitialBalance   sdk.Coin       `json:"initial_balance"`    // initial balance when redelegation started
 	Balance          sdk.Coin       `json:"balance"`            // current balance
-	SharesSrc        sdk.Rat        `json:"shares_src"`         // amount of source shares redelegating
-	SharesDst        sdk.Rat        `json:"shares_dst"`         // amount of destination shares redelegating
+	SharesSrc        sdk.Dec        `json:"shares_src"`         // amount of source shares redelegating
+	SharesDst        sdk.Dec        `json:"shares_dst"`         // amount of destination shares redelegating
 }
 
 type redValue struct {
@@ -198,8 +198,8 @@ type redValue struct {
 	MinTime        int64
 	InitialBalance sdk.Coin
 	Balance        sdk.Coin
-	SharesSrc      sdk.Rat
-	SharesDst      sdk.Rat
+	SharesSrc      sdk.Dec
+	SharesDst      sdk.Dec
 }
 
 // return the redelegation without fields contained within the key for the store
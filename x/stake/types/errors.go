@@ -21,6 +21,13 @@ const (
 	CodeUnknownRequest    CodeType = sdk.CodeUnknownRequest
 )
 
+func init() {
+	sdk.RegisterError(DefaultCodespace, CodeInvalidValidator, "invalid validator")
+	sdk.RegisterError(DefaultCodespace, CodeInvalidDelegation, "invalid delegation")
+	sdk.RegisterError(DefaultCodespace, CodeInvalidInput, "invalid input")
+	sdk.RegisterError(DefaultCodespace, CodeValidatorJailed, "validator jailed")
+}
+
 //validator
 func ErrNilValidatorAddr(codespace sdk.CodespaceType) sdk.Error {
 	return sdk.NewError(codespace, CodeInvalidInput, "validator address is nil")
@@ -99,13 +106,6 @@ func ErrBadSharesAmount(codespace sdk.CodespaceType) sdk.Error {
 	return sdk.NewError(codespace, CodeInvalidDelegation, "shares must be > 0")
 }
 
-func ErrBadSharesPrecision(codespace sdk.CodespaceType) sdk.Error {
-	return sdk.NewError(codespace, CodeInvalidDelegation,
-		fmt.Sprintf("shares denominator must be < %s, try reducing the number of decimal points",
-			maximumBondingRationalDenominator.String()),
-	)
-}
-
 func ErrBadSharesPercent(codespace sdk.CodespaceType) sdk.Error {
 	return sdk.NewError(codespace, CodeInvalidDelegation, "shares percent must be >0 and <=1")
 }
@@ -0,0 +1,24 @@
+// nolint
+package types
+
+// Events emitted by the stake module. The event type names the action which
+// was performed, the attributes describe the validators and delegators
+// involved.
+const (
+	EventTypeCreateValidator      = "create_validator"
+	EventTypeEditValidator        = "edit_validator"
+	EventTypeDelegate             = "delegate"
+	EventTypeBeginUnbonding       = "begin_unbonding"
+	EventTypeCompleteUnbonding    = "complete_unbonding"
+	EventTypeBeginRedelegation    = "begin_redelegation"
+	EventTypeCompleteRedelegation = "complete_redelegation"
+
+	AttributeKeyValidator    = "validator"
+	AttributeKeySrcValidator = "source_validator"
+	AttributeKeyDstValidator = "destination_validator"
+	AttributeKeyDelegator    = "delegator"
+	AttributeKeyMoniker      = "moniker"
+	AttributeKeyIdentity     = "identity"
+	AttributeKeyAmount       = "amount"
+	AttributeKeyShares       = "shares"
+)
@@ -0,0 +1,130 @@
+package types
+
+import (
+	"encoding/json"
+
+	"github.com/tendermint/tendermint/crypto"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Before the staking state moved to sdk.Dec every token, share and rate
+// amount was an sdk.Rat, encoded as a "num/denom" string which doesn't decode
+// as a decimal. The types below mirror the staking genesis state of that
+// encoding so that existing genesis files can be migrated.
+
+type ratPool struct {
+	LooseTokens             sdk.Rat `json:"loose_tokens"`
+	BondedTokens            sdk.Rat `json:"bonded_tokens"`
+	InflationLastTime       int64   `json:"inflation_last_time"`
+	Inflation               sdk.Rat `json:"inflation"`
+	DateLastCommissionReset int64   `json:"date_last_commission_reset"`
+	PrevBondedShares        sdk.Rat `json:"prev_bonded_shares"`
+}
+
+type ratParams struct {
+	InflationRateChange sdk.Rat `json:"inflation_rate_change"`
+	InflationMax        sdk.Rat `json:"inflation_max"`
+	InflationMin        sdk.Rat `json:"inflation_min"`
+	GoalBonded          sdk.Rat `json:"goal_bonded"`
+	UnbondingTime       int64   `json:"unbonding_time"`
+	MaxValidators       uint16  `json:"max_validators"`
+	BondDenom           string  `json:"bond_denom"`
+}
+
+type ratValidator struct {
+	Owner                 sdk.AccAddress `json:"owner"`
+	PubKey                crypto.PubKey  `json:"pub_key"`
+	Revoked               bool           `json:"revoked"`
+	Status                sdk.BondStatus `json:"status"`
+	Tokens                sdk.Rat        `json:"tokens"`
+	DelegatorShares       sdk.Rat        `json:"delegator_shares"`
+	Description           Description    `json:"description"`
+	BondHeight            int64          `json:"bond_height"`
+	BondIntraTxCounter    int16          `json:"bond_intra_tx_counter"`
+	ProposerRewardPool    sdk.Coins      `json:"proposer_reward_pool"`
+	Commission            sdk.Rat        `json:"commission"`
+	CommissionMax         sdk.Rat        `json:"commission_max"`
+	CommissionChangeRate  sdk.Rat        `json:"commission_change_rate"`
+	CommissionChangeToday sdk.Rat        `json:"commission_change_today"`
+	LastBondedTokens      sdk.Rat        `json:"prev_bonded_tokens"`
+}
+
+type ratDelegation struct {
+	DelegatorAddr sdk.AccAddress `json:"delegator_addr"`
+	ValidatorAddr sdk.AccAddress `json:"validator_addr"`
+	Shares        sdk.Rat        `json:"shares"`
+	Height        int64          `json:"height"`
+}
+
+type ratGenesisState struct {
+	Pool       ratPool         `json:"pool"`
+	Params     ratParams       `json:"params"`
+	Validators []ratValidator  `json:"validators"`
+	Bonds      []ratDelegation `json:"bonds"`
+}
+
+// convert a Rat to a Dec, a missing Rat is zero
+func ratToDec(r sdk.Rat) sdk.Dec {
+	if r.Rat == nil {
+		return sdk.ZeroDec()
+	}
+	return sdk.NewDecFromRat(r)
+}
+
+// MigrateRatGenesisState decodes a JSON staking genesis state written with
+// sdk.Rat amounts, rounding every amount to the precision of sdk.Dec with
+// bankers rounding
+func MigrateRatGenesisState(bz json.RawMessage) (data GenesisState, err error) {
+	var old ratGenesisState
+	err = MsgCdc.UnmarshalJSON(bz, &old)
+	if err != nil {
+		return data, err
+	}
+
+	data.Pool = Pool{
+		LooseTokens:             ratToDec(old.Pool.LooseTokens),
+		BondedTokens:            ratToDec(old.Pool.BondedTokens),
+		InflationLastTime:       old.Pool.InflationLastTime,
+		Inflation:               ratToDec(old.Pool.Inflation),
+		DateLastCommissionReset: old.Pool.DateLastCommissionReset,
+		PrevBondedShares:        ratToDec(old.Pool.PrevBondedShares),
+	}
+	data.Params = Params{
+		InflationRateChange: ratToDec(old.Params.InflationRateChange),
+		InflationMax:        ratToDec(old.Params.InflationMax),
+		InflationMin:        ratToDec(old.Params.InflationMin),
+		GoalBonded:          ratToDec(old.Params.GoalBonded),
+		UnbondingTime:       old.Params.UnbondingTime,
+		MaxValidators:       old.Params.MaxValidators,
+		BondDenom:           old.Params.BondDenom,
+	}
+	for _, v := range old.Validators {
+		data.Validators = append(data.Validators, Validator{
+			Owner:                 v.Owner,
+			PubKey:                v.PubKey,
+			Revoked:               v.Revoked,
+			Status:                v.Status,
+			Tokens:                ratToDec(v.Tokens),
+			DelegatorShares:       ratToDec(v.DelegatorShares),
+			Description:           v.Description,
+			BondHeight:            v.BondHeight,
+			BondIntraTxCounter:    v.BondIntraTxCounter,
+			ProposerRewardPool:    v.ProposerRewardPool,
+			Commission:            ratToDec(v.Commission),
+			CommissionMax:         ratToDec(v.CommissionMax),
+			CommissionChangeRate:  ratToDec(v.CommissionChangeRate),
+			CommissionChangeToday: ratToDec(v.CommissionChangeToday),
+			LastBondedTokens:      ratToDec(v.LastBondedTokens),
+		})
+	}
+	for _, d := range old.Bonds {
+		data.Bonds = append(data.Bonds, Delegation{
+			DelegatorAddr: d.DelegatorAddr,
+			ValidatorAddr: d.ValidatorAddr,
+			Shares:        ratToDec(d.Shares),
+			Height:        d.Height,
+		})
+	}
+	return data, nil
+}
@@ -0,0 +1,85 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestMigrateRatGenesisState(t *testing.T) {
+	old := ratGenesisState{
+		Pool: ratPool{
+			LooseTokens:      sdk.NewRat(100),
+			BondedTokens:     sdk.NewRat(200),
+			Inflation:        sdk.NewRat(7, 100),
+			PrevBondedShares: sdk.ZeroRat(),
+		},
+		Params: ratParams{
+			InflationRateChange: sdk.NewRat(13, 100),
+			InflationMax:        sdk.NewRat(20, 100),
+			InflationMin:        sdk.NewRat(7, 100),
+			GoalBonded:          sdk.NewRat(67, 100),
+			UnbondingTime:       60,
+			MaxValidators:       100,
+			BondDenom:           "steak",
+		},
+		Validators: []ratValidator{{
+			Owner:           addr1,
+			PubKey:          pk1,
+			Status:          sdk.Bonded,
+			Tokens:          sdk.NewRat(200),
+			DelegatorShares: sdk.NewRat(200, 3),
+			Description:     NewDescription("moniker", "", "", ""),
+		}},
+		Bonds: []ratDelegation{{
+			DelegatorAddr: addr2,
+			ValidatorAddr: addr1,
+			Shares:        sdk.NewRat(200, 3),
+			Height:        5,
+		}},
+	}
+	bz, err := MsgCdc.MarshalJSON(old)
+	require.Nil(t, err)
+
+	// the new state can't decode the rats
+	var data GenesisState
+	require.NotNil(t, MsgCdc.UnmarshalJSON(bz, &data))
+
+	data, err = MigrateRatGenesisState(bz)
+	require.Nil(t, err)
+
+	pool := InitialPool()
+	pool.LooseTokens = sdk.NewDec(100)
+	pool.BondedTokens = sdk.NewDec(200)
+	require.True(t, pool.Equal(data.Pool))
+
+	params := DefaultParams()
+	params.UnbondingTime = 60
+	require.True(t, params.Equal(data.Params))
+
+	validator := NewValidator(addr1, pk1, NewDescription("moniker", "", "", ""))
+	validator.Status = sdk.Bonded
+	validator.Tokens = sdk.NewDec(200)
+	validator.DelegatorShares = sdk.MustNewDecFromStr("66.6666666667")
+	require.Len(t, data.Validators, 1)
+	require.True(t, validator.Equal(data.Validators[0]))
+
+	bond := Delegation{
+		DelegatorAddr: addr2,
+		ValidatorAddr: addr1,
+		Shares:        sdk.MustNewDecFromStr("66.6666666667"),
+		Height:        5,
+	}
+	require.Len(t, data.Bonds, 1)
+	require.True(t, bond.Equal(data.Bonds[0]))
+
+	// the migrated state roundtrips with the new encoding
+	bz, err = MsgCdc.MarshalJSON(data)
+	require.Nil(t, err)
+	var data2 GenesisState
+	require.Nil(t, MsgCdc.UnmarshalJSON(bz, &data2))
+	require.True(t, data.Pool.Equal(data2.Pool))
+	require.True(t, data.Validators[0].Equal(data2.Validators[0]))
+}
@@ -143,15 +143,15 @@ func TestMsgBeginRedelegate(t *testing.T) {
 		delegatorAddr    sdk.AccAddress
 		validatorSrcAddr sdk.AccAddress
 		validatorDstAddr sdk.AccAddress
-		sharesAmount     sdk.Rat
+		sharesAmount     sdk.Dec
 		expectPass       bool
 	}{
-		{"regular", addr1, addr2, addr3, sdk.NewRat(1, 10), true},
-		{"negative decimal", addr1, addr2, addr3, sdk.NewRat(-1, 10), false},
-		{"zero amount", addr1, addr2, addr3, sdk.ZeroRat(), false},
-		{"empty delegator", emptyAddr, addr1, addr3, sdk.NewRat(1, 10), false},
-		{"empty source validator", addr1, emptyAddr, addr3, sdk.NewRat(1, 10), false},
-		{"empty destination validator", addr1, addr2, emptyAddr, sdk.NewRat(1, 10), false},
+		{"regular", addr1, addr2, addr3, sdk.NewDecWithPrec(1, 1), true},
+		{"negative decimal", addr1, addr2, addr3, sdk.NewDecWithPrec(-1, 1), false},
+		{"zero amount", addr1, addr2, addr3, sdk.ZeroDec(), false},
+		{"empty delegator", emptyAddr, addr1, addr3, sdk.NewDecWithPrec(1, 1), false},
+		{"empty source validator", addr1, emptyAddr, addr3, sdk.NewDecWithPrec(1, 1), false},
+		{"empty destination validator", addr1, addr2, emptyAddr, sdk.NewDecWithPrec(1, 1), false},
 	}
 
 	for _, tc := range tests {
@@ -195,14 +195,14 @@ func TestMsgBeginUnbonding(t *testing.T) {
 		name          string
 		delegatorAddr sdk.AccAddress
 		validatorAddr sdk.AccAddress
-		sharesAmount  sdk.Rat
+		sharesAmount  sdk.Dec
 		expectPass    bool
 	}{
-		{"regular", addr1, addr2, sdk.NewRat(1, 10), true},
-		{"negative decimal", addr1, addr2, sdk.NewRat(-1, 10), false},
-		{"zero amount", addr1, addr2, sdk.ZeroRat(), false},
-		{"empty delegator", emptyAddr, addr1, sdk.NewRat(1, 10), false},
-		{"empty validator", addr1, emptyAddr, sdk.NewRat(1, 10), false},
+		{"regular", addr1, addr2, sdk.NewDecWithPrec(1, 1), true},
+		{"negative decimal", addr1, addr2, sdk.NewDecWithPrec(-1, 1), false},
+		{"zero amount", addr1, addr2, sdk.ZeroDec(), false},
+		{"empty delegator", emptyAddr, addr1, sdk.NewDecWithPrec(1, 1), false},
+		{"empty validator", addr1, emptyAddr, sdk.NewDecWithPrec(1, 1), false},
 	}
 
 	for _, tc := range tests {
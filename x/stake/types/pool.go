@@ -9,15 +9,15 @@ import (
 
 // Pool - dynamic parameters of the current state
 type Pool struct {
-	LooseTokens       sdk.Rat `json:"loose_tokens"`        // tokens which are not bonded in a validator
-	BondedTokens      sdk.Rat `json:"bonded_tokens"`       // reserve of bonded tokens
+	LooseTokens       sdk.Dec `json:"loose_tokens"`        // tokens which are not bonded in a validator
+	BondedTokens      sdk.Dec `json:"bonded_tokens"`       // reserve of bonded tokens
 	InflationLastTime int64   `json:"inflation_last_time"` // block which the last inflation was processed // TODO make time
-	Inflation         sdk.Rat `json:"inflation"`           // current annual inflation rate
+	Inflation         sdk.Dec `json:"inflation"`           // current annual inflation rate
 
 	DateLastCommissionReset int64 `json:"date_last_commission_reset"` // unix timestamp for last commission accounting reset (daily)
 
 	// Fee Related
-	PrevBondedShares sdk.Rat `json:"prev_bonded_shares"` // last recorded bonded shares - for fee calculations
+	PrevBondedShares sdk.Dec `json:"prev_bonded_shares"` // last recorded bonded shares - for fee calculations
 }
 
 // nolint
@@ -30,48 +30,48 @@ func (p Pool) Equal(p2 Pool) bool {
 // initial pool for testing
 func InitialPool() Pool {
 	return Pool{
-		LooseTokens:             sdk.ZeroRat(),
-		BondedTokens:            sdk.ZeroRat(),
+		LooseTokens:             sdk.ZeroDec(),
+		BondedTokens:            sdk.ZeroDec(),
 		InflationLastTime:       0,
-		Inflation:               sdk.NewRat(7, 100),
+		Inflation:               sdk.NewDecWithPrec(7, 2),
 		DateLastCommissionReset: 0,
-		PrevBondedShares:        sdk.ZeroRat(),
+		PrevBondedShares:        sdk.ZeroDec(),
 	}
 }
 
 //____________________________________________________________________
 
 // Sum total of all staking tokens in the pool
-func (p Pool) TokenSupply() sdk.Rat {
+func (p Pool) TokenSupply() sdk.Dec {
 	return p.LooseTokens.Add(p.BondedTokens)
 }
 
 //____________________________________________________________________
 
 // get the bond ratio of the global state
-func (p Pool) BondedRatio() sdk.Rat {
+func (p Pool) BondedRatio() sdk.Dec {
 	supply := p.TokenSupply()
-	if supply.GT(sdk.ZeroRat()) {
+	if supply.GT(sdk.ZeroDec()) {
 		return p.BondedTokens.Quo(supply)
 	}
-	return sdk.ZeroRat()
+	return sdk.ZeroDec()
 }
 
 //_______________________________________________________________________
 
-func (p Pool) looseTokensToBonded(bondedTokens sdk.Rat) Pool {
+func (p Pool) looseTokensToBonded(bondedTokens sdk.Dec) Pool {
 	p.BondedTokens = p.BondedTokens.Add(bondedTokens)
 	p.LooseTokens = p.LooseTokens.Sub(bondedTokens)
-	if p.LooseTokens.LT(sdk.ZeroRat()) {
+	if p.LooseTokens.LT(sdk.ZeroDec()) {
 		panic(fmt.Sprintf("sanity check: loose tokens negative, pool: %v", p))
 	}
 	return p
 }
 
-func (p Pool) bondedTokensToLoose(bondedTokens sdk.Rat) Pool {
+func (p Pool) bondedTokensToLoose(bondedTokens sdk.Dec) Pool {
 	p.BondedTokens = p.BondedTokens.Sub(bondedTokens)
 	p.LooseTokens = p.LooseTokens.Add(bondedTokens)
-	if p.BondedTokens.LT(sdk.ZeroRat()) {
+	if p.BondedTokens.LT(sdk.ZeroDec()) {
 		panic(fmt.Sprintf("sanity check: bonded tokens negative, pool: %v", p))
 	}
 	return p
@@ -80,13 +80,12 @@ func (p Pool) bondedTokensToLoose(bondedTokens sdk.Rat) Pool {
 //_______________________________________________________________________
 // Inflation
 
-const precision = 100000000000     // increased to this precision for accuracy
-var hrsPerYrRat = sdk.NewRat(8766) // as defined by a julian year of 365.25 days
+var hrsPerYrDec = sdk.NewDec(8766) // as defined by a julian year of 365.25 days
 
 // process provisions for an hour period
 func (p Pool) ProcessProvisions(params Params) Pool {
 	p.Inflation = p.NextInflation(params)
-	provisions := p.Inflation.Mul(p.TokenSupply()).Quo(hrsPerYrRat)
+	provisions := p.Inflation.Mul(p.TokenSupply()).Quo(hrsPerYrDec)
 
 	// TODO add to the fees provisions
 	p.LooseTokens = p.LooseTokens.Add(provisions)
@@ -94,7 +93,7 @@ func (p Pool) ProcessProvisions(params Params) Pool {
 }
 
 // get the next inflation rate for the hour
-func (p Pool) NextInflation(params Params) (inflation sdk.Rat) {
+func (p Pool) NextInflation(params Params) (inflation sdk.Dec) {
 
 	// The target annual inflation rate is recalculated for each previsions cycle. The
 	// inflation is also subject to a rate change (positive or negative) depending on
@@ -103,8 +102,8 @@ func (p Pool) NextInflation(params Params) (inflation sdk.Rat) {
 	// 7% and 20%.
 
 	// (1 - bondedRatio/GoalBonded) * InflationRateChange
-	inflationRateChangePerYear := sdk.OneRat().Sub(p.BondedRatio().Quo(params.GoalBonded)).Mul(params.InflationRateChange)
-	inflationRateChange := inflationRateChangePerYear.Quo(hrsPerYrRat)
+	inflationRateChangePerYear := sdk.OneDec().Sub(p.BondedRatio().Quo(params.GoalBonded)).Mul(params.InflationRateChange)
+	inflationRateChange := inflationRateChangePerYear.Quo(hrsPerYrDec)
 
 	// increase the new annual inflation for this next cycle
 	inflation = p.Inflation.Add(inflationRateChange)
@@ -115,5 +114,5 @@ func (p Pool) NextInflation(params Params) (inflation sdk.Rat) {
 		inflation = params.InflationMin
 	}
 
-	return inflation.Round(precision)
+	return inflation
 }
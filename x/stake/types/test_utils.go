@@ -25,13 +25,13 @@ var (
 // Operation reflects any operation that transforms staking state. It takes in
 // a RNG instance, pool, validator and returns an updated pool, updated
 // validator, delta tokens, and descriptive message.
-type Operation func(r *rand.Rand, pool Pool, c Validator) (Pool, Validator, sdk.Rat, string)
+type Operation func(r *rand.Rand, pool Pool, c Validator) (Pool, Validator, sdk.Dec, string)
 
 // OpBondOrUnbond implements an operation that bonds or unbonds a validator
 // depending on current status.
 // nolint: unparam
 // TODO split up into multiple operations
-func OpBondOrUnbond(r *rand.Rand, pool Pool, validator Validator) (Pool, Validator, sdk.Rat, string) {
+func OpBondOrUnbond(r *rand.Rand, pool Pool, validator Validator) (Pool, Validator, sdk.Dec, string) {
 	var (
 		msg       string
 		newStatus sdk.BondStatus
@@ -47,12 +47,12 @@ func OpBondOrUnbond(r *rand.Rand, pool Pool, validator Validator) (Pool, Validat
 	}
 
 	validator, pool = validator.UpdateStatus(pool, newStatus)
-	return pool, validator, sdk.ZeroRat(), msg
+	return pool, validator, sdk.ZeroDec(), msg
 }
 
 // OpAddTokens implements an operation that adds a random number of tokens to a
 // validator.
-func OpAddTokens(r *rand.Rand, pool Pool, validator Validator) (Pool, Validator, sdk.Rat, string) {
+func OpAddTokens(r *rand.Rand, pool Pool, validator Validator) (Pool, Validator, sdk.Dec, string) {
 	msg := fmt.Sprintf("validator %#v", validator)
 
 	tokens := int64(r.Int31n(1000))
@@ -60,15 +60,15 @@ func OpAddTokens(r *rand.Rand, pool Pool, validator Validator) (Pool, Validator,
 	msg = fmt.Sprintf("Added %d tokens to %s", tokens, msg)
 
 	// Tokens are removed so for accounting must be negative
-	return pool, validator, sdk.NewRat(-1 * tokens), msg
+	return pool, validator, sdk.NewDec(-1 * tokens), msg
 }
 
 // OpRemoveShares implements an operation that removes a random number of
 // delegatorshares from a validator.
-func OpRemoveShares(r *rand.Rand, pool Pool, validator Validator) (Pool, Validator, sdk.Rat, string) {
-	var shares sdk.Rat
+func OpRemoveShares(r *rand.Rand, pool Pool, validator Validator) (Pool, Validator, sdk.Dec, string) {
+	var shares sdk.Dec
 	for {
-		shares = sdk.NewRat(int64(r.Int31n(1000)))
+		shares = sdk.NewDec(int64(r.Int31n(1000)))
 		if shares.LT(validator.DelegatorShares) {
 			break
 		}
@@ -109,18 +109,18 @@ func AssertInvariants(t *testing.T, msg string,
 		pMod.BondedTokens, pMod.LooseTokens)
 
 	// Nonnegative bonded tokens
-	require.False(t, pMod.BondedTokens.LT(sdk.ZeroRat()),
+	require.False(t, pMod.BondedTokens.LT(sdk.ZeroDec()),
 		"Negative bonded shares - msg: %v\npOrig: %v\npMod: %v\n",
 		msg, pOrig, pMod)
 
 	// Nonnegative loose tokens
-	require.False(t, pMod.LooseTokens.LT(sdk.ZeroRat()),
+	require.False(t, pMod.LooseTokens.LT(sdk.ZeroDec()),
 		"Negative unbonded shares - msg: %v\npOrig: %v\npMod: %v\n",
 		msg, pOrig, pMod)
 
 	for _, vMod := range vMods {
 		// Nonnegative ex rate
-		require.False(t, vMod.DelegatorShareExRate().LT(sdk.ZeroRat()),
+		require.False(t, vMod.DelegatorShareExRate().LT(sdk.ZeroDec()),
 			"Applying operation \"%s\" resulted in negative validator.DelegatorShareExRate(): %v (validator.Owner: %s)",
 			msg,
 			vMod.DelegatorShareExRate(),
@@ -128,14 +128,14 @@ func AssertInvariants(t *testing.T, msg string,
 		)
 
 		// Nonnegative poolShares
-		require.False(t, vMod.BondedTokens().LT(sdk.ZeroRat()),
+		require.False(t, vMod.BondedTokens().LT(sdk.ZeroDec()),
 			"Applying operation \"%s\" resulted in negative validator.BondedTokens(): %#v",
 			msg,
 			vMod,
 		)
 
 		// Nonnegative delShares
-		require.False(t, vMod.DelegatorShares.LT(sdk.ZeroRat()),
+		require.False(t, vMod.DelegatorShares.LT(sdk.ZeroDec()),
 			"Applying operation \"%s\" resulted in negative validator.DelegatorShares: %#v",
 			msg,
 			vMod,
@@ -149,8 +149,8 @@ func AssertInvariants(t *testing.T, msg string,
 // nolint: unparam
 func randomValidator(r *rand.Rand, i int) Validator {
 
-	tokens := sdk.NewRat(int64(r.Int31n(10000)))
-	delShares := sdk.NewRat(int64(r.Int31n(10000)))
+	tokens := sdk.NewDec(int64(r.Int31n(10000)))
+	delShares := sdk.NewDec(int64(r.Int31n(10000)))
 
 	// TODO add more options here
 	status := sdk.Bonded
@@ -169,7 +169,7 @@ func randomValidator(r *rand.Rand, i int) Validator {
 // RandomSetup generates a random staking state.
 func RandomSetup(r *rand.Rand, numValidators int) (Pool, []Validator) {
 	pool := InitialPool()
-	pool.LooseTokens = sdk.NewRat(100000)
+	pool.LooseTokens = sdk.NewDec(100000)
 
 	validators := make([]Validator, numValidators)
 	for i := 0; i < numValidators; i++ {
@@ -63,19 +63,19 @@ func TestRemoveTokens(t *testing.T) {
 		Owner:           addr1,
 		PubKey:          pk1,
 		Status:          sdk.Bonded,
-		Tokens:          sdk.NewRat(100),
-		DelegatorShares: sdk.NewRat(100),
+		Tokens:          sdk.NewDec(100),
+		DelegatorShares: sdk.NewDec(100),
 	}
 
 	pool := InitialPool()
-	pool.LooseTokens = sdk.NewRat(10)
+	pool.LooseTokens = sdk.NewDec(10)
 	pool.BondedTokens = validator.BondedTokens()
 
 	validator, pool = validator.UpdateStatus(pool, sdk.Bonded)
 	require.Equal(t, sdk.Bonded, validator.Status)
 
 	// remove tokens and test check everything
-	validator, pool = validator.RemoveTokens(pool, sdk.NewRat(10))
+	validator, pool = validator.RemoveTokens(pool, sdk.NewDec(10))
 	require.Equal(t, int64(90), validator.Tokens.RoundInt64())
 	require.Equal(t, int64(90), pool.BondedTokens.RoundInt64())
 	require.Equal(t, int64(20), pool.LooseTokens.RoundInt64())
@@ -86,7 +86,7 @@ func TestRemoveTokens(t *testing.T) {
 	require.Equal(t, int64(0), pool.BondedTokens.RoundInt64())
 	require.Equal(t, int64(110), pool.LooseTokens.RoundInt64())
 
-	validator, pool = validator.RemoveTokens(pool, sdk.NewRat(10))
+	validator, pool = validator.RemoveTokens(pool, sdk.NewDec(10))
 	require.Equal(t, int64(80), validator.Tokens.RoundInt64())
 	require.Equal(t, int64(0), pool.BondedTokens.RoundInt64())
 	require.Equal(t, int64(110), pool.LooseTokens.RoundInt64())
@@ -94,43 +94,43 @@ func TestRemoveTokens(t *testing.T) {
 
 func TestAddTokensValidatorBonded(t *testing.T) {
 	pool := InitialPool()
-	pool.LooseTokens = sdk.NewRat(10)
+	pool.LooseTokens = sdk.NewDec(10)
 	validator := NewValidator(addr1, pk1, Description{})
 	validator, pool = validator.UpdateStatus(pool, sdk.Bonded)
 	validator, pool, delShares := validator.AddTokensFromDel(pool, 10)
 
-	require.Equal(t, sdk.OneRat(), validator.DelegatorShareExRate())
+	require.Equal(t, sdk.OneDec(), validator.DelegatorShareExRate())
 
-	assert.True(sdk.RatEq(t, sdk.NewRat(10), delShares))
-	assert.True(sdk.RatEq(t, sdk.NewRat(10), validator.BondedTokens()))
+	assert.True(sdk.DecEq(t, sdk.NewDec(10), delShares))
+	assert.True(sdk.DecEq(t, sdk.NewDec(10), validator.BondedTokens()))
 }
 
 func TestAddTokensValidatorUnbonding(t *testing.T) {
 	pool := InitialPool()
-	pool.LooseTokens = sdk.NewRat(10)
+	pool.LooseTokens = sdk.NewDec(10)
 	validator := NewValidator(addr1, pk1, Description{})
 	validator, pool = validator.UpdateStatus(pool, sdk.Unbonding)
 	validator, pool, delShares := validator.AddTokensFromDel(pool, 10)
 
-	require.Equal(t, sdk.OneRat(), validator.DelegatorShareExRate())
+	require.Equal(t, sdk.OneDec(), validator.DelegatorShareExRate())
 
-	assert.True(sdk.RatEq(t, sdk.NewRat(10), delShares))
+	assert.True(sdk.DecEq(t, sdk.NewDec(10), delShares))
 	assert.Equal(t, sdk.Unbonding, validator.Status)
-	assert.True(sdk.RatEq(t, sdk.NewRat(10), validator.Tokens))
+	assert.True(sdk.DecEq(t, sdk.NewDec(10), validator.Tokens))
 }
 
 func TestAddTokensValidatorUnbonded(t *testing.T) {
 	pool := InitialPool()
-	pool.LooseTokens = sdk.NewRat(10)
+	pool.LooseTokens = sdk.NewDec(10)
 	validator := NewValidator(addr1, pk1, Description{})
 	validator, pool = validator.UpdateStatus(pool, sdk.Unbonded)
 	validator, pool, delShares := validator.AddTokensFromDel(pool, 10)
 
-	require.Equal(t, sdk.OneRat(), validator.DelegatorShareExRate())
+	require.Equal(t, sdk.OneDec(), validator.DelegatorShareExRate())
 
-	assert.True(sdk.RatEq(t, sdk.NewRat(10), delShares))
+	assert.True(sdk.DecEq(t, sdk.NewDec(10), delShares))
 	assert.Equal(t, sdk.Unbonded, validator.Status)
-	assert.True(sdk.RatEq(t, sdk.NewRat(10), validator.Tokens))
+	assert.True(sdk.DecEq(t, sdk.NewDec(10), validator.Tokens))
 }
 
 // TODO refactor to make simpler like the AddToken tests above
@@ -139,16 +139,16 @@ func TestRemoveDelShares(t *testing.T) {
 		Owner:           addr1,
 		PubKey:          pk1,
 		Status:          sdk.Bonded,
-		Tokens:          sdk.NewRat(100),
-		DelegatorShares: sdk.NewRat(100),
+		Tokens:          sdk.NewDec(100),
+		DelegatorShares: sdk.NewDec(100),
 	}
 	poolA := InitialPool()
-	poolA.LooseTokens = sdk.NewRat(10)
+	poolA.LooseTokens = sdk.NewDec(10)
 	poolA.BondedTokens = valA.BondedTokens()
-	require.Equal(t, valA.DelegatorShareExRate(), sdk.OneRat())
+	require.Equal(t, valA.DelegatorShareExRate(), sdk.OneDec())
 
 	// Remove delegator shares
-	valB, poolB, coinsB := valA.RemoveDelShares(poolA, sdk.NewRat(10))
+	valB, poolB, coinsB := valA.RemoveDelShares(poolA, sdk.NewDec(10))
 	assert.Equal(t, int64(10), coinsB.RoundInt64())
 	assert.Equal(t, int64(90), valB.DelegatorShares.RoundInt64())
 	assert.Equal(t, int64(90), valB.BondedTokens().RoundInt64())
@@ -156,13 +156,13 @@ func TestRemoveDelShares(t *testing.T) {
 	assert.Equal(t, int64(20), poolB.LooseTokens.RoundInt64())
 
 	// conservation of tokens
-	require.True(sdk.RatEq(t,
+	require.True(sdk.DecEq(t,
 		poolB.LooseTokens.Add(poolB.BondedTokens),
 		poolA.LooseTokens.Add(poolA.BondedTokens)))
 
 	// specific case from random tests
-	poolTokens := sdk.NewRat(5102)
-	delShares := sdk.NewRat(115)
+	poolTokens := sdk.NewDec(5102)
+	delShares := sdk.NewDec(115)
 	validator := Validator{
 		Owner:           addr1,
 		PubKey:          pk1,
@@ -171,22 +171,22 @@ func TestRemoveDelShares(t *testing.T) {
 		DelegatorShares: delShares,
 	}
 	pool := Pool{
-		BondedTokens:      sdk.NewRat(248305),
-		LooseTokens:       sdk.NewRat(232147),
+		BondedTokens:      sdk.NewDec(248305),
+		LooseTokens:       sdk.NewDec(232147),
 		InflationLastTime: 0,
-		Inflation:         sdk.NewRat(7, 100),
+		Inflation:         sdk.NewDecWithPrec(7, 2),
 	}
-	shares := sdk.NewRat(29)
+	shares := sdk.NewDec(29)
 	_, newPool, tokens := validator.RemoveDelShares(pool, shares)
-	require.True(sdk.RatEq(t, sdk.NewRat(147958, 115), tokens))
-	require.True(sdk.RatEq(t,
+	require.True(sdk.DecEq(t, sdk.MustNewDecFromStr("1286.5913043477"), tokens))
+	require.True(sdk.DecEq(t,
 		newPool.LooseTokens.Add(newPool.BondedTokens),
 		pool.LooseTokens.Add(pool.BondedTokens)))
 }
 
 func TestUpdateStatus(t *testing.T) {
 	pool := InitialPool()
-	pool.LooseTokens = sdk.NewRat(100)
+	pool.LooseTokens = sdk.NewDec(100)
 
 	validator := NewValidator(addr1, pk1, Description{})
 	validator, pool, _ = validator.AddTokensFromDel(pool, 100)
@@ -209,8 +209,8 @@ func TestUpdateStatus(t *testing.T) {
 }
 
 func TestPossibleOverflow(t *testing.T) {
-	poolTokens := sdk.NewRat(2159)
-	delShares := sdk.NewRat(391432570689183511).Quo(sdk.NewRat(40113011844664))
+	poolTokens := sdk.NewDec(2159)
+	delShares := sdk.NewDec(391432570689183511).Quo(sdk.NewDec(40113011844664))
 	validator := Validator{
 		Owner:           addr1,
 		PubKey:          pk1,
@@ -219,17 +219,17 @@ func TestPossibleOverflow(t *testing.T) {
 		DelegatorShares: delShares,
 	}
 	pool := Pool{
-		LooseTokens:       sdk.NewRat(100),
+		LooseTokens:       sdk.NewDec(100),
 		BondedTokens:      poolTokens,
 		InflationLastTime: 0,
-		Inflation:         sdk.NewRat(7, 100),
+		Inflation:         sdk.NewDecWithPrec(7, 2),
 	}
 	tokens := int64(71)
 	msg := fmt.Sprintf("validator %#v", validator)
 	newValidator, _, _ := validator.AddTokensFromDel(pool, tokens)
 
 	msg = fmt.Sprintf("Added %d tokens to %s", tokens, msg)
-	require.False(t, newValidator.DelegatorShareExRate().LT(sdk.ZeroRat()),
+	require.False(t, newValidator.DelegatorShareExRate().LT(sdk.ZeroDec()),
 		"Applying operation \"%s\" resulted in negative DelegatorShareExRate(): %v",
 		msg, newValidator.DelegatorShareExRate())
 }